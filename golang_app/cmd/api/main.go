@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata"
 
 	slackapiadapter "request/internal/adapters/primaryadapters/slack_api_adapter"
 	"request/internal/adapters/secondaryadapters/dbadapter"
 	"request/internal/adapters/secondaryadapters/slackadapter"
+	"request/internal/app/ports/primaryports"
 	"request/internal/app/services"
+	"request/internal/domain"
 	"request/pkg/loghandlers"
 
 	"github.com/glebarez/sqlite"
@@ -41,6 +47,10 @@ func main() {
 	requestsReader := dbadapter.NewRequestsReader(db)
 	queuesWriter := dbadapter.NewQueuesWriter(db)
 	queuesReader := dbadapter.NewQueuesReader(db)
+	jobsWriter := dbadapter.NewJobsWriter(db)
+	jobsReader := dbadapter.NewJobsReader(db)
+	recurringWriter := dbadapter.NewRecurringRequestsWriter(db)
+	recurringReader := dbadapter.NewRecurringRequestsReader(db)
 
 	slackToken := os.Getenv("SLACK_BOT_TOKEN")
 	if slackToken == "" {
@@ -51,32 +61,99 @@ func main() {
 	slackViewRenderer := slackadapter.NewSlackViewRenderer(slackClient)
 	slackMessenger := slackadapter.NewSlackMessenger(slackClient)
 	slackMessageRenderer := slackadapter.NewMessageRenderer(slackClient)
+	slackChannelMembership := slackadapter.NewSlackChannelMembership(slackClient, 5*time.Minute)
+	slackHolidayImporter := slackadapter.NewSlackHolidayImporter(slackClient)
+	slackUserGroups := slackadapter.NewSlackUserGroups(slackClient, 5*time.Minute)
 
-	requestService := services.NewRequestService(slackViewRenderer, requestsWriter)
-	queueService := services.NewQueueService(queuesWriter, queuesReader)
+	workspaceSettings := domain.WorkspaceSettings{
+		OpenChannelRequests: os.Getenv("OPEN_CHANNEL_REQUESTS") == "true",
+	}
+
+	if days := os.Getenv("REQUEST_EXPIRY_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			log.Fatalf("Invalid REQUEST_EXPIRY_DAYS: %v", err)
+		}
+		policy, err := domain.NewExpiryPolicy(time.Duration(n) * 24 * time.Hour)
+		if err != nil {
+			log.Fatalf("Invalid REQUEST_EXPIRY_DAYS: %v", err)
+		}
+		workspaceSettings.RequestExpiry = policy
+	}
+
+	requestService := services.NewRequestService(slackViewRenderer, requestsWriter, queuesReader)
+	queueService := services.NewQueueService(queuesWriter, queuesReader, slackHolidayImporter)
+	queueBrowserService := services.NewQueueBrowserService(queuesReader, requestsReader)
 	requestResponseService := services.NewRequestResponseService(
 		requestsWriter,
 		requestsReader,
 		queuesReader,
+		queuesWriter,
 		slackMessenger,
+		slackMessageRenderer,
+		slackChannelMembership,
+		workspaceSettings,
 	)
+	requestRouter := services.NewRequestRouter(queuesReader, slackUserGroups)
 	formSubmissionService := services.NewFormSubmissionService(
 		requestsWriter,
+		requestsReader,
 		queuesWriter,
+		queuesReader,
+		slackMessenger,
+		slackMessageRenderer,
+		requestRouter,
+	)
+
+	slaService := services.NewSLAService(
+		requestsWriter,
+		requestsReader,
+		queuesReader,
 		slackMessenger,
 		slackMessageRenderer,
 	)
-	_ = requestResponseService
+
+	jobScheduler := services.NewJobScheduler(jobsWriter, jobsReader, workerId(), 5*time.Minute)
+
+	reminderService := services.NewReminderService(
+		requestsWriter,
+		requestsReader,
+		queuesReader,
+		slackMessenger,
+		slackMessageRenderer,
+		jobScheduler,
+	)
+
+	recurringRequestService := services.NewRecurringRequestService(
+		recurringWriter,
+		recurringReader,
+		queuesReader,
+		formSubmissionService,
+	)
+
+	if err := services.RegisterMaintenanceJobs(context.Background(), jobScheduler, requestResponseService, slaService, reminderService, recurringRequestService, requestResponseService); err != nil {
+		log.Fatalf("Failed to schedule maintenance jobs: %v", err)
+	}
 
 	slackHandler := slackapiadapter.NewSlackHandler(
 		requestService,
 		queueService,
 		formSubmissionService,
+		requestResponseService,
+		queueBrowserService,
+		jobScheduler,
+		reminderService,
+		recurringRequestService,
+		requestRouter,
 		slackViewRenderer,
+		slackMessenger,
 	)
 
+	go runJobs(jobScheduler, 5*time.Second)
+
 	http.HandleFunc("/slack/commands", slackHandler.HandleSlashCommand)
 	http.HandleFunc("/slack/interactions", slackHandler.HandleInteractions)
+	http.HandleFunc("/slack/events", slackHandler.HandleEvents)
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -89,3 +166,22 @@ func main() {
 		log.Fatalf("Server failed to start: %v", err)
 	}
 }
+
+func runJobs(runner primaryports.ForRunningJobs, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		if _, err := runner.RunDueJobs(context.Background(), now); err != nil {
+			slog.Error("Failed to run due jobs", slog.String("err", err.Error()))
+		}
+	}
+}
+
+func workerId() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
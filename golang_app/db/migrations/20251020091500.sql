@@ -0,0 +1,14 @@
+-- Add column "hold_question" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `hold_question` varchar NULL;
+-- Add column "hold_reply" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `hold_reply` varchar NULL;
+-- Add column "on_hold_since" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `on_hold_since` datetime NULL;
+-- Add column "on_hold_seconds" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `on_hold_seconds` integer NOT NULL DEFAULT 0;
+-- Add column "hold_channel_id" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `hold_channel_id` text NULL;
+-- Add column "hold_message_ts" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `hold_message_ts` text NULL;
+-- Create index "idx_requests_hold_message" to table: "requests"
+CREATE INDEX `idx_requests_hold_message` ON `requests` (`hold_channel_id`, `hold_message_ts`);
@@ -0,0 +1,13 @@
+-- Add column "notification_channel_id" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `notification_channel_id` text NULL;
+-- Add column "notification_message_ts" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `notification_message_ts` text NULL;
+-- Create "request_collaborators" table
+CREATE TABLE `request_collaborators` (
+  `request_id` varchar NOT NULL,
+  `user_id` varchar NOT NULL,
+  PRIMARY KEY (`request_id`, `user_id`),
+  CONSTRAINT `fk_requests_collaborators` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_request_collaborators_user_id" to table: "request_collaborators"
+CREATE INDEX `idx_request_collaborators_user_id` ON `request_collaborators` (`user_id`);
@@ -0,0 +1,11 @@
+-- Add column "labels" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `labels` json NULL;
+-- Create "request_labels" table
+CREATE TABLE `request_labels` (
+  `request_id` varchar NOT NULL,
+  `label` varchar NOT NULL,
+  PRIMARY KEY (`request_id`, `label`),
+  CONSTRAINT `fk_requests_labels` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_request_labels_label" to table: "request_labels"
+CREATE INDEX `idx_request_labels_label` ON `request_labels` (`label`);
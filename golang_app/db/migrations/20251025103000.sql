@@ -0,0 +1,4 @@
+-- Add column "intake_fields" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `intake_fields` json NULL;
+-- Add column "field_values" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `field_values` json NULL;
@@ -0,0 +1,2 @@
+-- Add column "templates" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `templates` json NULL;
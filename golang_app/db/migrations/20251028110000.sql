@@ -0,0 +1,13 @@
+-- Create "request_checklist_items" table
+CREATE TABLE `request_checklist_items` (
+  `request_id` varchar NOT NULL,
+  `id` varchar NOT NULL,
+  `position` integer NOT NULL,
+  `text` varchar NOT NULL,
+  `required` numeric NOT NULL DEFAULT false,
+  `done` numeric NOT NULL DEFAULT false,
+  `done_by_id` text NULL,
+  `done_at` datetime NULL,
+  PRIMARY KEY (`request_id`, `id`),
+  CONSTRAINT `fk_requests_checklist_items` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+-- Create "request_dependencies" table
+CREATE TABLE `request_dependencies` (
+  `request_id` varchar NOT NULL,
+  `blocked_by_id` varchar NOT NULL,
+  PRIMARY KEY (`request_id`, `blocked_by_id`),
+  CONSTRAINT `fk_request_dependencies_blocked_by` FOREIGN KEY (`blocked_by_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `fk_requests_dependencies` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_request_dependencies_blocked_by_id" to table: "request_dependencies"
+CREATE INDEX `idx_request_dependencies_blocked_by_id` ON `request_dependencies` (`blocked_by_id`);
@@ -0,0 +1,4 @@
+-- Add column "duplicate_of_id" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `duplicate_of_id` text NULL;
+-- Create index "idx_requests_duplicate_of_id" to table: "requests"
+CREATE INDEX `idx_requests_duplicate_of_id` ON `requests` (`duplicate_of_id`);
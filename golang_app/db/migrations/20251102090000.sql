@@ -0,0 +1,9 @@
+-- Create "request_watchers" table
+CREATE TABLE `request_watchers` (
+  `request_id` varchar NOT NULL,
+  `user_id` varchar NOT NULL,
+  PRIMARY KEY (`request_id`, `user_id`),
+  CONSTRAINT `fk_requests_watchers` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_request_watchers_user_id" to table: "request_watchers"
+CREATE INDEX `idx_request_watchers_user_id` ON `request_watchers` (`user_id`);
@@ -0,0 +1,6 @@
+-- Add column "workflow" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `workflow` json NULL;
+-- Add column "workflow" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `workflow` json NULL;
+-- Add column "workflow_status" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `workflow_status` text NULL;
@@ -0,0 +1,8 @@
+-- Add column "approver_ids" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `approver_ids` json NULL;
+-- Add column "approver_ids" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approver_ids` json NULL;
+-- Add column "approvals" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approvals` json NULL;
+-- Add column "approval_messages" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approval_messages` json NULL;
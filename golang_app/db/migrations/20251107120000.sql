@@ -0,0 +1,19 @@
+-- Add column "approval_chain" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `approval_chain` json NULL;
+-- Add column "approval_chain" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approval_chain` json NULL;
+-- Add column "approval_stage" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approval_stage` integer NOT NULL DEFAULT 0;
+-- Add column "approval_stage_started_at" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approval_stage_started_at` datetime NULL;
+-- Add column "approval_escalated" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `approval_escalated` numeric NOT NULL DEFAULT false;
+-- Carry approvers over to a single "any one" stage
+UPDATE `queues` SET `approval_chain` = json_array(json_object('name', 'Approval', 'approver_ids', json(`approver_ids`), 'rule', 'any'))
+WHERE `approver_ids` IS NOT NULL AND json_array_length(`approver_ids`) > 0;
+UPDATE `requests` SET `approval_chain` = json_array(json_object('name', 'Approval', 'approver_ids', json(`approver_ids`), 'rule', 'any')), `approval_stage_started_at` = `updated_at`
+WHERE `approver_ids` IS NOT NULL AND json_array_length(`approver_ids`) > 0;
+-- Drop column "approver_ids" from table: "queues"
+ALTER TABLE `queues` DROP COLUMN `approver_ids`;
+-- Drop column "approver_ids" from table: "requests"
+ALTER TABLE `requests` DROP COLUMN `approver_ids`;
@@ -0,0 +1,2 @@
+-- Add column "triager_ids" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `triager_ids` json NULL;
@@ -0,0 +1,6 @@
+-- Add column "assignment_strategy" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `assignment_strategy` text NOT NULL DEFAULT 'none';
+-- Add column "last_assignee_id" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `last_assignee_id` text NULL;
+-- Add column "declined_by_ids" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `declined_by_ids` json NULL;
@@ -0,0 +1,10 @@
+-- Add column "on_call_member_ids" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `on_call_member_ids` json NULL;
+-- Add column "on_call_starts_at" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `on_call_starts_at` datetime NULL;
+-- Add column "on_call_shift_days" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `on_call_shift_days` integer NULL;
+-- Add column "on_call_time_zone" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `on_call_time_zone` text NULL;
+-- Add column "on_call_overrides" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `on_call_overrides` json NULL;
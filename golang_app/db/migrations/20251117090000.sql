@@ -0,0 +1,6 @@
+-- Add column "business_time_zone" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `business_time_zone` text NULL;
+-- Add column "business_hours" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `business_hours` json NULL;
+-- Add column "holidays" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `holidays` json NULL;
@@ -0,0 +1,27 @@
+-- Add column "sla_accept_seconds" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `sla_accept_seconds` integer NOT NULL DEFAULT 0;
+-- Add column "sla_complete_seconds" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `sla_complete_seconds` integer NOT NULL DEFAULT 0;
+-- Add column "sla_at_risk_percent" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `sla_at_risk_percent` integer NOT NULL DEFAULT 0;
+-- Add column "hold_periods" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `hold_periods` json NULL;
+-- Add column "sla_state" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `sla_state` text NULL;
+-- Add column "accepted_at" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `accepted_at` datetime NULL;
+-- Add column "resolved_at" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `resolved_at` datetime NULL;
+-- Create "request_sla_breaches" table
+CREATE TABLE `request_sla_breaches` (
+  `request_id` varchar NOT NULL,
+  `target` varchar NOT NULL,
+  `breached_at` datetime NOT NULL,
+  PRIMARY KEY (`request_id`, `target`),
+  CONSTRAINT `fk_requests_sla_breaches` FOREIGN KEY (`request_id`) REFERENCES `requests` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_request_sla_breaches_breached_at" to table: "request_sla_breaches"
+CREATE INDEX `idx_request_sla_breaches_breached_at` ON `request_sla_breaches` (`breached_at`);
+-- Backfill transition timestamps of existing requests
+UPDATE `requests` SET `accepted_at` = `updated_at` WHERE `accepted_by_id` IS NOT NULL AND `accepted_by_id` != '' AND `status` IN ('accepted', 'on_hold', 'completed');
+UPDATE `requests` SET `resolved_at` = `updated_at` WHERE `status` IN ('completed', 'rejected');
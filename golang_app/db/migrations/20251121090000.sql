@@ -0,0 +1,27 @@
+-- Create "jobs" table
+CREATE TABLE `jobs` (
+  `id` varchar NOT NULL,
+  `name` varchar NOT NULL,
+  `key` varchar NOT NULL,
+  `payload` text NULL,
+  `schedule` varchar NULL,
+  `status` text NOT NULL,
+  `run_at` datetime NOT NULL,
+  `attempts` integer NOT NULL DEFAULT 0,
+  `max_attempts` integer NOT NULL DEFAULT 5,
+  `last_error` text NULL,
+  `locked_by` text NULL,
+  `locked_until` datetime NULL,
+  `last_started_at` datetime NULL,
+  `last_finished_at` datetime NULL,
+  `version` integer NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+);
+-- Create index "idx_jobs_name" to table: "jobs"
+CREATE INDEX `idx_jobs_name` ON `jobs` (`name`);
+-- Create index "idx_jobs_key" to table: "jobs"
+CREATE UNIQUE INDEX `idx_jobs_key` ON `jobs` (`key`);
+-- Create index "idx_jobs_status_run_at" to table: "jobs"
+CREATE INDEX `idx_jobs_status_run_at` ON `jobs` (`status`, `run_at`);
@@ -0,0 +1,6 @@
+-- Add column "reminder_rules" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `reminder_rules` json NULL;
+-- Add column "sent_reminders" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `sent_reminders` json NULL;
+-- Add column "reminders_snoozed_until" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `reminders_snoozed_until` datetime NULL;
@@ -0,0 +1,21 @@
+-- Create "recurring_requests" table
+CREATE TABLE `recurring_requests` (
+  `id` varchar NOT NULL,
+  `title` varchar NOT NULL,
+  `description` text NULL,
+  `recipient_id` varchar NOT NULL,
+  `recipient_type` text NOT NULL,
+  `schedule` varchar NOT NULL,
+  `created_by_id` varchar NOT NULL,
+  `paused` numeric NOT NULL DEFAULT false,
+  `next_run_at` datetime NULL,
+  `last_run_at` datetime NULL,
+  `last_error` text NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+);
+-- Create index "idx_recurring_requests_created_by_id" to table: "recurring_requests"
+CREATE INDEX `idx_recurring_requests_created_by_id` ON `recurring_requests` (`created_by_id`);
+-- Create index "idx_recurring_requests_paused_next_run_at" to table: "recurring_requests"
+CREATE INDEX `idx_recurring_requests_paused_next_run_at` ON `recurring_requests` (`paused`, `next_run_at`);
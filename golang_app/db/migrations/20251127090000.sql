@@ -0,0 +1,4 @@
+-- Add column "expire_after_seconds" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `expire_after_seconds` integer NOT NULL DEFAULT 0;
+-- Add column "expiry_warned_at" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `expiry_warned_at` datetime NULL;
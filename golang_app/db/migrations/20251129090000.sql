@@ -0,0 +1,4 @@
+-- Add column "wip_per_member" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `wip_per_member` integer NOT NULL DEFAULT 0;
+-- Add column "wip_per_queue" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `wip_per_queue` integer NOT NULL DEFAULT 0;
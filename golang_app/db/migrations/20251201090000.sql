@@ -0,0 +1,6 @@
+-- Add column "routing_rules" to table: "queues"
+ALTER TABLE `queues` ADD COLUMN `routing_rules` json NULL;
+-- Add column "routed_from_channel_id" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `routed_from_channel_id` text NULL;
+-- Add column "routing_reason" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `routing_reason` varchar NULL;
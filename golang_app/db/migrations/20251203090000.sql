@@ -0,0 +1,2 @@
+-- Add column "transfers" to table: "requests"
+ALTER TABLE `requests` ADD COLUMN `transfers` json NULL;
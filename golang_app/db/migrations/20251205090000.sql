@@ -0,0 +1,4 @@
+-- Add column "pending_schedule" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `pending_schedule` varchar NULL;
+-- Add column "pending_payload" to table: "jobs"
+ALTER TABLE `jobs` ADD COLUMN `pending_payload` text NULL;
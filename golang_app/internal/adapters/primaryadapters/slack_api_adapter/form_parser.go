@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"request/internal/app/ports/primaryports"
 	"request/internal/domain"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
 )
@@ -45,12 +48,19 @@ func (p *FormParser) ParseRequestForm(interaction slack.InteractionCallback) (pr
 		return primaryports.RequestFormData{}, fmt.Errorf("recipient is required")
 	}
 
+	labels := p.extractSelectedOptions(values, "request_labels_block", "request_labels_select")
+
 	return primaryports.RequestFormData{
-		Title:         title,
-		Description:   description,
-		RecipientID:   recipientId,
-		RecipientType: domain.RequestRecipientType(recipientType),
-		CreatedByID:   interaction.User.ID,
+		Title:             title,
+		Description:       description,
+		RecipientID:       recipientId,
+		RecipientType:     domain.RequestRecipientType(recipientType),
+		Labels:            labels,
+		FieldValues:       p.extractIntakeFieldValues(values),
+		PlaceholderValues: p.extractPrefixedValues(values, "template_placeholder_", "template_placeholder_input"),
+		WatcherIDs:        p.extractSelectedUsers(values, "request_watchers_block", "request_watchers_select"),
+		Checklist:         p.parseChecklist(p.extractValue(values, "request_checklist_block", "request_checklist_input")),
+		CreatedByID:       interaction.User.ID,
 	}, nil
 }
 
@@ -71,15 +81,740 @@ func (p *FormParser) ParseQueueForm(interaction slack.InteractionCallback) (prim
 
 	adminIds := p.extractSelectedUsers(values, "queue_admins_block", "queue_admins_select")
 
+	labels := p.splitList(p.extractValue(values, "queue_labels_block", "queue_labels_input"))
+
+	intakeFields, err := p.ParseIntakeFieldSpec(p.extractValue(values, "queue_intake_fields_block", "queue_intake_fields_input"))
+	if err != nil {
+		return primaryports.QueueFormData{}, err
+	}
+
+	workflow, err := p.ParseWorkflowSpec(p.extractValue(values, "queue_workflow_block", "queue_workflow_input"))
+	if err != nil {
+		return primaryports.QueueFormData{}, err
+	}
+
 	return primaryports.QueueFormData{
-		Name:        name,
-		Description: description,
-		AdminIds:    adminIds,
-		ChannelId:   channelId,
-		CreatedById: interaction.User.ID,
+		Name:               name,
+		Description:        description,
+		AdminIds:           adminIds,
+		TriagerIds:         p.extractSelectedUsers(values, "queue_triagers_block", "queue_triagers_select"),
+		ResponderIds:       p.extractSelectedUsers(values, "queue_responders_block", "queue_responders_select"),
+		Labels:             labels,
+		IntakeFields:       intakeFields,
+		Workflow:           workflow,
+		ApproverIds:        p.extractSelectedUsers(values, "queue_approvers_block", "queue_approvers_select"),
+		AssignmentStrategy: domain.AssignmentStrategy(p.extractValue(values, "queue_assignment_block", "queue_assignment_select")),
+		ChannelId:          channelId,
+		CreatedById:        interaction.User.ID,
 	}, nil
 }
 
+func (p *FormParser) ParseIntakeFieldSpec(spec string) ([]domain.IntakeField, error) {
+	fields := []domain.IntakeField{}
+
+	for _, line := range strings.Split(spec, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		label, definition, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("intake field %q must be written as Label: type", line)
+		}
+
+		fieldType, optionList, _ := strings.Cut(definition, "=")
+		fieldType = strings.ToLower(strings.TrimSpace(fieldType))
+		required := strings.HasSuffix(fieldType, "*")
+		fieldType = strings.TrimSpace(strings.TrimSuffix(fieldType, "*"))
+
+		var options []string
+		for _, option := range strings.Split(optionList, "|") {
+			if trimmed := strings.TrimSpace(option); trimmed != "" {
+				options = append(options, trimmed)
+			}
+		}
+
+		field, err := domain.NewIntakeField(label, domain.IntakeFieldType(fieldType), required, options)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func (p *FormParser) ParseWorkflowSpec(spec string) (*domain.Workflow, error) {
+	statuses := []domain.WorkflowStatus{}
+
+	for _, line := range strings.Split(spec, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		definition, nextList, _ := strings.Cut(line, "->")
+
+		name, category, ok := strings.Cut(definition, "(")
+		category, closed := strings.CutSuffix(strings.TrimSpace(category), ")")
+		if !ok || !closed {
+			return nil, fmt.Errorf("workflow status %q must be written as Status (category)", line)
+		}
+
+		category = strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(category)))
+
+		statuses = append(statuses, domain.NewWorkflowStatus(name, domain.StatusCategory(category), p.splitList(nextList)))
+	}
+
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	return domain.NewWorkflow(statuses)
+}
+
+func (p *FormParser) ValidateIntakeFields(fields []domain.IntakeField, values map[string]string) map[string]string {
+	fieldErrors := map[string]string{}
+	for _, field := range fields {
+		if err := field.Validate(values[field.Key]); err != nil {
+			fieldErrors["intake_field_"+field.Key] = err.Error()
+		}
+	}
+	return fieldErrors
+}
+
+func (p *FormParser) ParseTemplateForm(interaction slack.InteractionCallback) (string, domain.RequestTemplate, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "template_queue_block", "template_queue_select")
+	if queueId == "" {
+		return "", domain.RequestTemplate{}, fmt.Errorf("queue is required")
+	}
+
+	var recipient *domain.RequestRecipient
+	userId := p.extractSelectedUser(values, "template_user_block", "template_user_select")
+	channelId := p.extractSelectedChannel(values, "template_channel_block", "template_channel_select")
+	switch {
+	case userId != "" && channelId != "":
+		return "", domain.RequestTemplate{}, fmt.Errorf("choose either a user or a channel, not both")
+	case userId != "":
+		recipient = &domain.RequestRecipient{ID: userId, Type: domain.RequestRecipientUser}
+	case channelId != "":
+		recipient = &domain.RequestRecipient{ID: channelId, Type: domain.RequestRecipientChannel}
+	}
+
+	template, err := domain.NewRequestTemplate(
+		p.extractValue(values, "template_name_block", "template_name_input"),
+		p.extractValue(values, "template_title_block", "template_title_input"),
+		p.extractValue(values, "template_description_block", "template_description_input"),
+		recipient,
+	)
+	if err != nil {
+		return "", domain.RequestTemplate{}, err
+	}
+
+	return queueId, template, nil
+}
+
+func (p *FormParser) ParseRemoveTemplateForm(interaction slack.InteractionCallback) (string, string, error) {
+	selected := p.extractValue(interaction.View.State.Values, "remove_template_block", "remove_template_select")
+
+	queueId, templateKey, ok := strings.Cut(selected, "/")
+	if !ok || queueId == "" || templateKey == "" {
+		return "", "", fmt.Errorf("template is required")
+	}
+
+	return queueId, templateKey, nil
+}
+
+func (p *FormParser) ParseQueueLabelsForm(interaction slack.InteractionCallback) (string, []string, []string, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "labels_queue_block", "labels_queue_select")
+	if queueId == "" {
+		return "", nil, nil, fmt.Errorf("queue is required")
+	}
+
+	added := p.splitList(p.extractValue(values, "labels_add_block", "labels_add_input"))
+	removed := p.splitList(p.extractValue(values, "labels_remove_block", "labels_remove_input"))
+	if len(added) == 0 && len(removed) == 0 {
+		return "", nil, nil, fmt.Errorf("enter labels to add or remove")
+	}
+
+	return queueId, added, removed, nil
+}
+
+// ParseIntakeFieldsForm returns no fields when the spec is left empty, which
+// removes them from the queue.
+func (p *FormParser) ParseIntakeFieldsForm(interaction slack.InteractionCallback) (string, []domain.IntakeField, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "intake_fields_queue_block", "intake_fields_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	fields, err := p.ParseIntakeFieldSpec(p.extractValue(values, "intake_fields_spec_block", "intake_fields_spec_input"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return queueId, fields, nil
+}
+
+// ParseWorkflowForm returns a nil workflow when the spec is left empty, which
+// puts the queue back on the default Accept/Complete flow.
+func (p *FormParser) ParseWorkflowForm(interaction slack.InteractionCallback) (string, *domain.Workflow, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "workflow_queue_block", "workflow_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	workflow, err := p.ParseWorkflowSpec(p.extractValue(values, "workflow_spec_block", "workflow_spec_input"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return queueId, workflow, nil
+}
+
+func (p *FormParser) ParseApprovalChainForm(interaction slack.InteractionCallback) (string, []domain.ApprovalStage, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "approval_chain_queue_block", "approval_chain_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	stages := []domain.ApprovalStage{}
+	for i := 1; i <= domain.MaxApprovalStages; i++ {
+		blockId := func(field string) string {
+			return fmt.Sprintf("approval_stage_%d_%s", i, field)
+		}
+
+		approverIds := p.extractSelectedUsers(values, blockId("approvers"), "approval_stage_approvers_select")
+		if len(approverIds) == 0 {
+			continue
+		}
+
+		name := p.extractValue(values, blockId("name"), "approval_stage_name_input")
+		if name == "" {
+			name = fmt.Sprintf("Stage %d", i)
+		}
+
+		rule := domain.QuorumRule(p.extractValue(values, blockId("rule"), "approval_stage_rule_select"))
+		if rule == "" {
+			rule = domain.QuorumAny
+		}
+
+		quorum := 0
+		if value := p.extractValue(values, blockId("quorum"), "approval_stage_quorum_input"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("stage %d approvals needed must be a whole number", i)
+			}
+			quorum = parsed
+		}
+
+		var timeout time.Duration
+		if value := p.extractValue(values, blockId("timeout"), "approval_stage_timeout_input"); value != "" {
+			hours, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return "", nil, fmt.Errorf("stage %d escalation must be a number of hours", i)
+			}
+			timeout = time.Duration(hours * float64(time.Hour))
+		}
+
+		fallbackId := p.extractSelectedUser(values, blockId("fallback"), "approval_stage_fallback_select")
+
+		stage, err := domain.NewApprovalStage(name, approverIds, rule, quorum, timeout, fallbackId)
+		if err != nil {
+			return "", nil, err
+		}
+		stages = append(stages, stage)
+	}
+
+	return queueId, stages, nil
+}
+
+func (p *FormParser) ParseOnCallForm(interaction slack.InteractionCallback) (string, *domain.OnCallRotation, bool, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "oncall_queue_block", "oncall_queue_select")
+	if queueId == "" {
+		return "", nil, false, fmt.Errorf("queue is required")
+	}
+
+	shiftDays, err := strconv.Atoi(p.extractValue(values, "oncall_shift_days_block", "oncall_shift_days_input"))
+	if err != nil {
+		return "", nil, false, fmt.Errorf("shift length must be a whole number of days")
+	}
+
+	timeZone := p.extractValue(values, "oncall_time_zone_block", "oncall_time_zone_input")
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" {
+		return "", nil, false, fmt.Errorf("unknown time zone %q, use a name like Europe/London", timeZone)
+	}
+
+	date := p.extractSelectedDate(values, "oncall_start_date_block", "oncall_start_date_picker")
+	handoff := p.extractSelectedTime(values, "oncall_handoff_block", "oncall_handoff_picker")
+	startsAt, err := time.ParseInLocation("2006-01-02 15:04", date+" "+handoff, loc)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("first handoff date and time are required")
+	}
+
+	rotation, err := domain.NewOnCallRotation(
+		p.extractSelectedUsers(values, "oncall_members_block", "oncall_members_select"),
+		startsAt,
+		shiftDays,
+		timeZone,
+	)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	assignToOnCall := p.extractValue(values, "oncall_assignment_block", "oncall_assignment_select") == "assign"
+
+	return queueId, rotation, assignToOnCall, nil
+}
+
+func (p *FormParser) ParseOnCallOverrideForm(interaction slack.InteractionCallback) (string, domain.OnCallOverride, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "oncall_override_queue_block", "oncall_override_queue_select")
+	if queueId == "" {
+		return "", domain.OnCallOverride{}, fmt.Errorf("queue is required")
+	}
+
+	userId := p.extractSelectedUser(values, "oncall_override_user_block", "oncall_override_user_select")
+	if userId == "" {
+		userId = interaction.User.ID
+	}
+
+	start := p.extractSelectedDateTime(values, "oncall_override_start_block", "oncall_override_start_picker")
+	end := p.extractSelectedDateTime(values, "oncall_override_end_block", "oncall_override_end_picker")
+	if start.IsZero() || end.IsZero() {
+		return "", domain.OnCallOverride{}, fmt.Errorf("start and end of the swap are required")
+	}
+
+	return queueId, domain.OnCallOverride{UserID: userId, Start: start, End: end}, nil
+}
+
+func (p *FormParser) ParseBusinessHoursForm(interaction slack.InteractionCallback) (string, *domain.BusinessCalendar, string, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "business_hours_queue_block", "business_hours_queue_select")
+	if queueId == "" {
+		return "", nil, "", fmt.Errorf("queue is required")
+	}
+
+	spec := p.extractValue(values, "business_hours_block", "business_hours_input")
+	if strings.TrimSpace(spec) == "" {
+		return queueId, nil, "", nil
+	}
+
+	hours, err := p.parseWorkingHours(spec)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	calendar, err := domain.NewBusinessCalendar(p.extractValue(values, "business_hours_time_zone_block", "business_hours_time_zone_input"), hours)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	holidayFileId := ""
+	if files := p.extractFiles(values, "holiday_calendar_block", "holiday_calendar_input"); len(files) > 0 {
+		holidayFileId = files[0].ID
+	}
+
+	return queueId, calendar, holidayFileId, nil
+}
+
+func (p *FormParser) ParseSLAForm(interaction slack.InteractionCallback) (string, *domain.SLAPolicy, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "sla_queue_block", "sla_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	acceptWithin, err := p.parseSLATarget(p.extractValue(values, "sla_accept_block", "sla_accept_input"))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid time to accept: %w", err)
+	}
+
+	completeWithin, err := p.parseSLATarget(p.extractValue(values, "sla_complete_block", "sla_complete_input"))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid time to complete: %w", err)
+	}
+
+	if acceptWithin == 0 && completeWithin == 0 {
+		return queueId, nil, nil
+	}
+
+	atRiskPercent := 0
+	if value := strings.TrimSpace(strings.TrimSuffix(p.extractValue(values, "sla_at_risk_block", "sla_at_risk_input"), "%")); value != "" {
+		atRiskPercent, err = strconv.Atoi(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("at-risk threshold must be a whole percentage")
+		}
+	}
+
+	policy, err := domain.NewSLAPolicy(acceptWithin, completeWithin, atRiskPercent)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return queueId, policy, nil
+}
+
+func (p *FormParser) parseSLATarget(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.ReplaceAll(value, " ", ""))
+	if value == "" {
+		return 0, nil
+	}
+
+	target, err := time.ParseDuration(value)
+	if err != nil || target < time.Minute || target%time.Minute != 0 {
+		return 0, fmt.Errorf("%q is not a duration like 4h or 1h30m", value)
+	}
+	return target, nil
+}
+
+func (p *FormParser) ParseReminderRulesForm(interaction slack.InteractionCallback) (string, []domain.ReminderRule, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "reminder_rules_queue_block", "reminder_rules_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	rules := []domain.ReminderRule{}
+	for i := 1; i <= domain.MaxReminderRules; i++ {
+		blockId := func(field string) string {
+			return fmt.Sprintf("reminder_rule_%d_%s", i, field)
+		}
+
+		after, err := p.parseReminderDelay(p.extractValue(values, blockId("after"), "reminder_rule_after_input"))
+		if err != nil {
+			return "", nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		if after == 0 {
+			continue
+		}
+
+		condition := domain.ReminderCondition(p.extractValue(values, blockId("condition"), "reminder_rule_condition_select"))
+		if condition == "" {
+			condition = domain.ReminderPending
+		}
+
+		target := domain.ReminderTarget(p.extractValue(values, blockId("target"), "reminder_rule_target_select"))
+		if target == "" {
+			target = domain.ReminderTargetChannel
+		}
+
+		rule, err := domain.NewReminderRule(condition, after, target)
+		if err != nil {
+			return "", nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return queueId, rules, nil
+}
+
+// ParseExpiryForm returns a nil policy when the delay is left empty, which
+// turns expiry off for the queue.
+func (p *FormParser) ParseExpiryForm(interaction slack.InteractionCallback) (string, *domain.ExpiryPolicy, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "expiry_queue_block", "expiry_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	after, err := p.parseReminderDelay(p.extractValue(values, "expiry_after_block", "expiry_after_input"))
+	if err != nil {
+		return "", nil, err
+	}
+	if after == 0 {
+		return queueId, nil, nil
+	}
+
+	policy, err := domain.NewExpiryPolicy(after)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return queueId, policy, nil
+}
+
+// ParseWIPLimitsForm returns nil limits when both are left empty, which turns
+// them off for the queue.
+func (p *FormParser) ParseWIPLimitsForm(interaction slack.InteractionCallback) (string, *domain.WIPLimits, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "wip_queue_block", "wip_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	parseLimit := func(blockId, actionId string) (int, error) {
+		value := strings.TrimSpace(p.extractValue(values, blockId, actionId))
+		if value == "" {
+			return 0, nil
+		}
+
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return 0, fmt.Errorf("%q is not a whole number above zero", value)
+		}
+		return limit, nil
+	}
+
+	perMember, err := parseLimit("wip_per_member_block", "wip_per_member_input")
+	if err != nil {
+		return "", nil, err
+	}
+
+	perQueue, err := parseLimit("wip_per_queue_block", "wip_per_queue_input")
+	if err != nil {
+		return "", nil, err
+	}
+
+	limits, err := domain.NewWIPLimits(perMember, perQueue)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return queueId, limits, nil
+}
+
+// parseReminderDelay accepts Go durations plus whole days, e.g. 24h, 3d or
+// 1d12h.
+// ParseRoutingRulesForm skips rules without a channel, so clearing the channel
+// removes a rule.
+func (p *FormParser) ParseRoutingRulesForm(interaction slack.InteractionCallback) (string, []domain.RoutingRule, error) {
+	values := interaction.View.State.Values
+
+	queueId := p.extractValue(values, "routing_rules_queue_block", "routing_rules_queue_select")
+	if queueId == "" {
+		return "", nil, fmt.Errorf("queue is required")
+	}
+
+	rules := []domain.RoutingRule{}
+	for i := 1; i <= domain.MaxRoutingRules; i++ {
+		blockId := func(field string) string {
+			return fmt.Sprintf("routing_rule_%d_%s", i, field)
+		}
+
+		channelId := p.extractSelectedChannel(values, blockId("channel"), "routing_rule_channel_select")
+		if channelId == "" {
+			continue
+		}
+
+		rule, err := domain.NewRoutingRule(
+			channelId,
+			p.extractValue(values, blockId("match"), "routing_rule_match_input"),
+			p.extractValue(values, blockId("group"), "routing_rule_group_input"),
+		)
+		if err != nil {
+			return "", nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return queueId, rules, nil
+}
+
+func (p *FormParser) parseReminderDelay(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.ReplaceAll(value, " ", ""))
+	if value == "" {
+		return 0, nil
+	}
+
+	var delay time.Duration
+	rest := value
+	if days, after, ok := strings.Cut(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%q is not a delay like 24h or 3d", value)
+		}
+		delay = time.Duration(n) * 24 * time.Hour
+		rest = after
+	}
+
+	if rest != "" {
+		d, err := time.ParseDuration(rest)
+		if err != nil || d < 0 {
+			return 0, fmt.Errorf("%q is not a delay like 24h or 3d", value)
+		}
+		delay += d
+	}
+
+	return delay, nil
+}
+
+func (p *FormParser) ParseRecurringRequestForm(interaction slack.InteractionCallback) (primaryports.RecurringRequestData, error) {
+	values := interaction.View.State.Values
+
+	data := primaryports.RecurringRequestData{
+		Title:       p.extractValue(values, "recurring_title_block", "recurring_title_input"),
+		Description: p.extractValue(values, "recurring_description_block", "recurring_description_input"),
+		Schedule:    p.extractValue(values, "recurring_schedule_block", "recurring_schedule_input"),
+		CreatedByID: interaction.User.ID,
+	}
+
+	recipients := map[domain.RequestRecipientType]string{
+		domain.RequestRecipientQueue:   p.extractValue(values, "recurring_queue_block", "recurring_queue_select"),
+		domain.RequestRecipientUser:    p.extractSelectedUser(values, "recurring_user_block", "recurring_user_select"),
+		domain.RequestRecipientChannel: p.extractSelectedChannel(values, "recurring_channel_block", "recurring_channel_select"),
+	}
+	for recipientType, recipientId := range recipients {
+		if recipientId == "" {
+			continue
+		}
+		if data.RecipientID != "" {
+			return primaryports.RecurringRequestData{}, fmt.Errorf("choose only one of a queue, user or channel")
+		}
+		data.RecipientID = recipientId
+		data.RecipientType = recipientType
+	}
+
+	if data.RecipientID == "" {
+		return primaryports.RecurringRequestData{}, fmt.Errorf("choose a queue, user or channel to send requests to")
+	}
+
+	if strings.TrimSpace(data.Schedule) == "" {
+		return primaryports.RecurringRequestData{}, fmt.Errorf("schedule is required")
+	}
+
+	return data, nil
+}
+
+func (p *FormParser) ParseRequestLabelsForm(interaction slack.InteractionCallback) (string, []string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", nil, fmt.Errorf("request reference is missing")
+	}
+
+	labels := p.extractSelectedOptions(interaction.View.State.Values, "request_labels_block", "request_labels_select")
+
+	return requestId, labels, nil
+}
+
+func (p *FormParser) ParseRequestBlockersForm(interaction slack.InteractionCallback) (string, []string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", nil, fmt.Errorf("request reference is missing")
+	}
+
+	blockerIds := p.extractSelectedOptions(interaction.View.State.Values, "request_blockers_block", "request_blockers_select")
+
+	return requestId, blockerIds, nil
+}
+
+func (p *FormParser) ParseMarkDuplicateForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	canonicalId := p.extractValue(interaction.View.State.Values, "canonical_request_block", "canonical_request_select")
+	if canonicalId == "" {
+		return "", "", fmt.Errorf("canonical request is required")
+	}
+
+	return requestId, canonicalId, nil
+}
+
+func (p *FormParser) ParseTransferRequestForm(interaction slack.InteractionCallback) (string, string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", "", fmt.Errorf("request reference is missing")
+	}
+
+	queueId := p.extractValue(interaction.View.State.Values, "transfer_queue_block", "transfer_queue_select")
+	if queueId == "" {
+		return "", "", "", fmt.Errorf("queue is required")
+	}
+
+	reason := strings.TrimSpace(p.extractValue(interaction.View.State.Values, "transfer_reason_block", "transfer_reason_input"))
+	if reason == "" {
+		return "", "", "", fmt.Errorf("reason is required")
+	}
+
+	return requestId, queueId, reason, nil
+}
+
+func (p *FormParser) ParseAssignForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	assigneeId := p.extractSelectedUser(interaction.View.State.Values, "assignee_block", "assignee_select")
+	if assigneeId == "" {
+		return "", "", fmt.Errorf("assignee is required")
+	}
+
+	return requestId, assigneeId, nil
+}
+
+func (p *FormParser) ParseApprovalDecisionForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	comment := p.extractValue(interaction.View.State.Values, "approval_comment_block", "approval_comment_input")
+
+	return requestId, comment, nil
+}
+
+func (p *FormParser) ParseRejectionReasonForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	reason := p.extractValue(interaction.View.State.Values, "rejection_reason_block", "rejection_reason_input")
+	if reason == "" {
+		return "", "", fmt.Errorf("rejection reason is required")
+	}
+
+	return requestId, reason, nil
+}
+
+func (p *FormParser) ParseHoldQuestionForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	question := p.extractValue(interaction.View.State.Values, "hold_question_block", "hold_question_input")
+	if question == "" {
+		return "", "", fmt.Errorf("question is required")
+	}
+
+	return requestId, question, nil
+}
+
+func (p *FormParser) ParseHoldReplyForm(interaction slack.InteractionCallback) (string, string, error) {
+	requestId := interaction.View.PrivateMetadata
+	if requestId == "" {
+		return "", "", fmt.Errorf("request reference is missing")
+	}
+
+	reply := p.extractValue(interaction.View.State.Values, "hold_reply_block", "hold_reply_input")
+
+	return requestId, reply, nil
+}
+
 func (p *FormParser) extractValue(values map[string]map[string]slack.BlockAction, blockId, actionId string) string {
 	if block, ok := values[blockId]; ok {
 		if action, ok := block[actionId]; ok {
@@ -116,6 +851,42 @@ func (p *FormParser) extractSelectedChannel(values map[string]map[string]slack.B
 	return ""
 }
 
+func (p *FormParser) extractSelectedDate(values map[string]map[string]slack.BlockAction, blockId, actionId string) string {
+	if block, ok := values[blockId]; ok {
+		if action, ok := block[actionId]; ok {
+			return action.SelectedDate
+		}
+	}
+	return ""
+}
+
+func (p *FormParser) extractSelectedTime(values map[string]map[string]slack.BlockAction, blockId, actionId string) string {
+	if block, ok := values[blockId]; ok {
+		if action, ok := block[actionId]; ok {
+			return action.SelectedTime
+		}
+	}
+	return ""
+}
+
+func (p *FormParser) extractSelectedDateTime(values map[string]map[string]slack.BlockAction, blockId, actionId string) time.Time {
+	if block, ok := values[blockId]; ok {
+		if action, ok := block[actionId]; ok && action.SelectedDateTime > 0 {
+			return time.Unix(action.SelectedDateTime, 0)
+		}
+	}
+	return time.Time{}
+}
+
+func (p *FormParser) extractFiles(values map[string]map[string]slack.BlockAction, blockId, actionId string) []slack.File {
+	if block, ok := values[blockId]; ok {
+		if action, ok := block[actionId]; ok {
+			return action.Files
+		}
+	}
+	return nil
+}
+
 func (p *FormParser) extractSelectedUsers(values map[string]map[string]slack.BlockAction, blockId, actionId string) []string {
 	if block, ok := values[blockId]; ok {
 		if action, ok := block[actionId]; ok {
@@ -126,3 +897,178 @@ func (p *FormParser) extractSelectedUsers(values map[string]map[string]slack.Blo
 	}
 	return []string{}
 }
+
+func (p *FormParser) extractSelectedOptions(values map[string]map[string]slack.BlockAction, blockId, actionId string) []string {
+	if block, ok := values[blockId]; ok {
+		if action, ok := block[actionId]; ok {
+			selected := make([]string, len(action.SelectedOptions))
+			for i, option := range action.SelectedOptions {
+				selected[i] = option.Value
+			}
+			return selected
+		}
+	}
+	return []string{}
+}
+
+func (p *FormParser) splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
+func (p *FormParser) extractIntakeFieldValues(values map[string]map[string]slack.BlockAction) map[string]string {
+	fieldValues := map[string]string{}
+	for blockId, block := range values {
+		key, ok := strings.CutPrefix(blockId, "intake_field_")
+		if !ok {
+			continue
+		}
+
+		action, ok := block["intake_field_input"]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case action.Value != "":
+			fieldValues[key] = action.Value
+		case action.SelectedOption.Value != "":
+			fieldValues[key] = action.SelectedOption.Value
+		case action.SelectedUser != "":
+			fieldValues[key] = action.SelectedUser
+		case action.SelectedDate != "":
+			fieldValues[key] = action.SelectedDate
+		}
+	}
+	return fieldValues
+}
+
+var weekdaysByName = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+func (p *FormParser) parseWorkingHours(spec string) ([]domain.WorkingHours, error) {
+	hours := []domain.WorkingHours{}
+	for i, line := range strings.Split(spec, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		daysSpec, rangesSpec, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected days followed by hours, e.g. Mon-Fri 09:00-17:30", i+1)
+		}
+
+		days, err := p.parseWeekdays(daysSpec)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		for _, rangeSpec := range p.splitList(rangesSpec) {
+			openSpec, closeSpec, ok := strings.Cut(rangeSpec, "-")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected hours like 09:00-17:30, got %q", i+1, rangeSpec)
+			}
+
+			start, err := p.parseClockMinutes(openSpec)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			end, err := p.parseClockMinutes(closeSpec)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+
+			for _, day := range days {
+				window, err := domain.NewWorkingHours(day, start, end)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", i+1, err)
+				}
+				hours = append(hours, window)
+			}
+		}
+	}
+	return hours, nil
+}
+
+func (p *FormParser) parseWeekdays(spec string) ([]time.Weekday, error) {
+	lookup := func(name string) (time.Weekday, error) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if len(name) >= 3 {
+			if day, ok := weekdaysByName[name[:3]]; ok {
+				return day, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown day %q", name)
+	}
+
+	days := []time.Weekday{}
+	for _, part := range strings.Split(spec, ",") {
+		first, last, isRange := strings.Cut(part, "-")
+
+		from, err := lookup(first)
+		if err != nil {
+			return nil, err
+		}
+		if !isRange {
+			days = append(days, from)
+			continue
+		}
+
+		to, err := lookup(last)
+		if err != nil {
+			return nil, err
+		}
+		for day := from; ; day = (day + 1) % 7 {
+			days = append(days, day)
+			if day == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func (p *FormParser) parseClockMinutes(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use HH:MM", value)
+	}
+	return clock.Hour()*60 + clock.Minute(), nil
+}
+
+func (p *FormParser) parseChecklist(value string) []primaryports.ChecklistItemData {
+	items := []primaryports.ChecklistItemData{}
+	for _, line := range strings.Split(value, "\n") {
+		text := strings.TrimSpace(line)
+		required := strings.HasSuffix(text, "*")
+		text = strings.TrimSpace(strings.TrimSuffix(text, "*"))
+		if text == "" {
+			continue
+		}
+		items = append(items, primaryports.ChecklistItemData{Text: text, Required: required})
+	}
+	return items
+}
+
+func (p *FormParser) extractPrefixedValues(values map[string]map[string]slack.BlockAction, blockPrefix, actionId string) map[string]string {
+	prefixed := map[string]string{}
+	for blockId := range values {
+		if name, ok := strings.CutPrefix(blockId, blockPrefix); ok {
+			prefixed[name] = p.extractValue(values, blockId, actionId)
+		}
+	}
+	return prefixed
+}
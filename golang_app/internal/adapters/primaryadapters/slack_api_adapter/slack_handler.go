@@ -3,7 +3,9 @@ package slackapiadapter
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"request/internal/adapters/secondaryadapters/slackadapter"
@@ -11,28 +13,52 @@ import (
 	"request/internal/app/ports/secondaryports"
 	"request/internal/domain"
 	"request/pkg/loghandlers"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
 )
 
 type SlackHandler struct {
 	requestHandler        primaryports.ForHandlingRequests
 	queueManager          primaryports.ForManagingQueues
 	formSubmissionHandler primaryports.ForHandlingFormSubmissions
+	requestResponder      primaryports.ForRespondingToRequests
+	queueBrowser          primaryports.ForBrowsingQueues
+	jobInspector          primaryports.ForInspectingJobs
+	reminderSnoozer       primaryports.ForSnoozingReminders
+	recurringManager      primaryports.ForManagingRecurringRequests
+	requestRouter         primaryports.ForRoutingRequests
 	modalRenderer         secondaryports.ForRenderingModals
+	messenger             secondaryports.ForMessagingUsers
 }
 
 func NewSlackHandler(
 	requestHandler primaryports.ForHandlingRequests,
 	queueManager primaryports.ForManagingQueues,
 	formSubmissionHandler primaryports.ForHandlingFormSubmissions,
+	requestResponder primaryports.ForRespondingToRequests,
+	queueBrowser primaryports.ForBrowsingQueues,
+	jobInspector primaryports.ForInspectingJobs,
+	reminderSnoozer primaryports.ForSnoozingReminders,
+	recurringManager primaryports.ForManagingRecurringRequests,
+	requestRouter primaryports.ForRoutingRequests,
 	modalRenderer secondaryports.ForRenderingModals,
+	messenger secondaryports.ForMessagingUsers,
 ) *SlackHandler {
 	return &SlackHandler{
 		requestHandler:        requestHandler,
 		queueManager:          queueManager,
 		formSubmissionHandler: formSubmissionHandler,
+		requestResponder:      requestResponder,
+		queueBrowser:          queueBrowser,
+		jobInspector:          jobInspector,
+		reminderSnoozer:       reminderSnoozer,
+		recurringManager:      recurringManager,
+		requestRouter:         requestRouter,
 		modalRenderer:         modalRenderer,
+		messenger:             messenger,
 	}
 }
 
@@ -60,19 +86,57 @@ func (h *SlackHandler) HandleSlashCommand(w http.ResponseWriter, r *http.Request
 		slog.String("triggerId", cmd.TriggerID),
 	)
 
-	switch cmd.Text {
+	subcommand, args, _ := strings.Cut(strings.TrimSpace(cmd.Text), " ")
+
+	switch subcommand {
 	case "":
 		h.handleNoArgs(ctx, w, r, cmd)
-	case "new-request":
-		h.handleNewRequest(ctx, w, r, cmd)
+	case "new", "new-request":
+		h.handleNewRequest(ctx, w, r, cmd, strings.TrimSpace(args))
 	case "new-queue":
 		h.handleNewQueue(ctx, w, r, cmd)
+	case "new-template":
+		h.handleNewTemplate(ctx, w, r, cmd)
+	case "remove-template":
+		h.handleRemoveTemplate(ctx, w, r, cmd)
+	case "labels":
+		h.handleQueueLabels(ctx, w, r, cmd)
+	case "intake-fields":
+		h.handleIntakeFields(ctx, w, r, cmd)
+	case "workflow":
+		h.handleWorkflow(ctx, w, r, cmd)
+	case "approval-chain":
+		h.handleApprovalChain(ctx, w, r, cmd)
+	case "oncall":
+		h.handleOnCall(ctx, w, r, cmd, strings.TrimSpace(args))
+	case "oncall-schedule":
+		h.handleOnCallSchedule(ctx, w, r, cmd)
+	case "oncall-swap":
+		h.handleOnCallSwap(ctx, w, r, cmd)
+	case "business-hours":
+		h.handleBusinessHours(ctx, w, r, cmd)
+	case "sla":
+		h.handleSLA(ctx, w, r, cmd)
+	case "reminders":
+		h.handleReminders(ctx, w, r, cmd)
+	case "expiry":
+		h.handleExpiry(ctx, w, r, cmd)
+	case "wip-limits":
+		h.handleWIPLimits(ctx, w, r, cmd)
+	case "routing":
+		h.handleRouting(ctx, w, r, cmd)
+	case "route-test":
+		h.handleRouteTest(ctx, w, r, cmd, strings.TrimSpace(args))
+	case "recurring":
+		h.handleRecurring(ctx, w, r, cmd, strings.TrimSpace(args))
 	case "manage-queue":
-		h.handleNewRequest(ctx, w, r, cmd)
+		h.handleNewRequest(ctx, w, r, cmd, "")
 	case "list-queues":
 		h.handleListQueues(ctx, w, r, cmd)
 	case "delete-queues":
-		h.handleNewRequest(ctx, w, r, cmd)
+		h.handleNewRequest(ctx, w, r, cmd, "")
+	case "jobs":
+		h.handleJobs(ctx, w, r, cmd, strings.TrimSpace(args))
 	default:
 		w.Header().Set("Content-Type", "application/json")
 		response := map[string]string{
@@ -99,10 +163,313 @@ func (h *SlackHandler) handleNewQueue(ctx context.Context, w http.ResponseWriter
 	return
 }
 
+func (h *SlackHandler) handleNewTemplate(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling new template command")
+	h.openEditableQueuesForm(ctx, w, cmd, "template", h.modalRenderer.RenderTemplateForm)
+}
+
+func (h *SlackHandler) handleRemoveTemplate(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling remove template command")
+	h.openEditableQueuesForm(ctx, w, cmd, "remove template", h.modalRenderer.RenderRemoveTemplateForm)
+}
+
+func (h *SlackHandler) handleQueueLabels(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling queue labels command")
+	h.openEditableQueuesForm(ctx, w, cmd, "labels", h.modalRenderer.RenderQueueLabelsForm)
+}
+
+func (h *SlackHandler) handleIntakeFields(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling intake fields command")
+	h.openEditableQueuesForm(ctx, w, cmd, "intake fields", h.modalRenderer.RenderIntakeFieldsForm)
+}
+
+func (h *SlackHandler) handleWorkflow(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling workflow command")
+	h.openEditableQueuesForm(ctx, w, cmd, "workflow", h.modalRenderer.RenderWorkflowForm)
+}
+
+func (h *SlackHandler) handleApprovalChain(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling approval chain command")
+	h.openEditableQueuesForm(ctx, w, cmd, "approval chain", h.modalRenderer.RenderApprovalChainForm)
+}
+
+func (h *SlackHandler) handleBusinessHours(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling business hours command")
+	h.openEditableQueuesForm(ctx, w, cmd, "business hours", h.modalRenderer.RenderBusinessHoursForm)
+}
+
+func (h *SlackHandler) handleSLA(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling SLA command")
+	h.openEditableQueuesForm(ctx, w, cmd, "SLA", h.modalRenderer.RenderSLAForm)
+}
+
+func (h *SlackHandler) handleReminders(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling reminders command")
+	h.openEditableQueuesForm(ctx, w, cmd, "reminders", h.modalRenderer.RenderReminderRulesForm)
+}
+
+func (h *SlackHandler) handleExpiry(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling expiry command")
+	h.openEditableQueuesForm(ctx, w, cmd, "expiry", h.modalRenderer.RenderExpiryForm)
+}
+
+func (h *SlackHandler) handleWIPLimits(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling WIP limits command")
+	h.openEditableQueuesForm(ctx, w, cmd, "WIP limits", h.modalRenderer.RenderWIPLimitsForm)
+}
+
+func (h *SlackHandler) handleRecurring(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand, action string) {
+	slog.DebugContext(ctx, "Handling recurring command", slog.String("action", action))
+
+	if action == "new" {
+		queues, err := h.queueManager.ListQueues(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to list queues for recurring request form", slog.String("err", err.Error()))
+			h.respondWithFormFailure(w, "recurring request")
+			return
+		}
+
+		err = h.modalRenderer.RenderRecurringRequestForm(ctx, cmd.TriggerID, queues)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to open recurring request form", slog.String("err", err.Error()))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	view, err := h.recurringRequestListView(ctx, cmd.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list recurring requests", slog.String("err", err.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		response := map[string]string{
+			"text": "Failed to list recurring requests. Please try again.",
+		}
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.modalRenderer.RenderRecurringRequestList(ctx, cmd.TriggerID, view)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open recurring requests", slog.String("err", err.Error()))
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *SlackHandler) recurringRequestListView(ctx context.Context, userId string) (secondaryports.RecurringRequestListView, error) {
+	recurring, err := h.recurringManager.ListRecurringRequests(ctx, userId)
+	if err != nil {
+		return secondaryports.RecurringRequestListView{}, err
+	}
+
+	queues, err := h.queueManager.ListQueues(ctx)
+	if err != nil {
+		return secondaryports.RecurringRequestListView{}, err
+	}
+
+	queueNames := make(map[string]string, len(queues))
+	for _, queue := range queues {
+		queueNames[queue.ID] = queue.Name
+	}
+
+	return secondaryports.RecurringRequestListView{RecurringRequests: recurring, QueueNames: queueNames}, nil
+}
+
+func (h *SlackHandler) handleOnCall(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand, queueName string) {
+	slog.DebugContext(ctx, "Handling on-call command", slog.String("queue", queueName))
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if queueName == "" {
+		json.NewEncoder(w).Encode(map[string]string{
+			"text": "Usage: `/request oncall <queue>`",
+		})
+		return
+	}
+
+	queue, err := h.queueManager.FindQueueByName(ctx, queueName, cmd.ChannelID)
+	if err != nil {
+		text := fmt.Sprintf("Failed to find queue `%s`: %s", queueName, err.Error())
+		if errors.Is(err, domain.ErrQueueNotFound) {
+			text = fmt.Sprintf("No queue named `%s` was found.", queueName)
+		}
+		json.NewEncoder(w).Encode(map[string]string{"text": text})
+		return
+	}
+
+	current, next, err := h.queueManager.GetOnCall(ctx, queue.ID, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve on-call", slog.String("err", err.Error()), slog.String("queueId", queue.ID))
+
+		text := "Failed to look up who is on call. Please try again."
+		if errors.Is(err, domain.ErrNoOnCallRotation) {
+			text = fmt.Sprintf("*%s* has no on-call rotation. Queue admins can set one up with `/request oncall-schedule`.", queue.Name)
+		}
+		json.NewEncoder(w).Encode(map[string]string{"text": text})
+		return
+	}
+
+	lines := []string{fmt.Sprintf("*On call for %s*", queue.Name)}
+	if current != nil {
+		line := fmt.Sprintf("Now: <@%s> until %s", current.UserID, slackDate(current.End))
+		if current.Override {
+			line += " _(swap)_"
+		}
+		lines = append(lines, line)
+	} else {
+		lines = append(lines, "Now: _nobody, the rotation has not started yet_")
+	}
+	if next != nil {
+		lines = append(lines, fmt.Sprintf("Next: <@%s> from %s", next.UserID, slackDate(next.Start)))
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{"text": strings.Join(lines, "\n")})
+}
+
+func (h *SlackHandler) handleOnCallSchedule(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling on-call schedule command")
+	h.openEditableQueuesForm(ctx, w, cmd, "on-call", h.modalRenderer.RenderOnCallForm)
+}
+
+func (h *SlackHandler) handleOnCallSwap(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling on-call swap command")
+
+	queues, err := h.queueManager.ListQueues(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues for on-call swap form", slog.String("err", err.Error()))
+		h.respondWithFormFailure(w, "on-call swap")
+		return
+	}
+
+	rotationQueues := []*domain.Queue{}
+	for _, queue := range queues {
+		if queue.OnCall == nil {
+			continue
+		}
+		if queue.OnCall.Includes(cmd.UserID) || queue.Decide(cmd.UserID, domain.PermissionEditQueue).Allowed {
+			rotationQueues = append(rotationQueues, queue)
+		}
+	}
+
+	err = h.modalRenderer.RenderOnCallOverrideForm(ctx, cmd.TriggerID, rotationQueues)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open on-call swap form", slog.String("err", err.Error()))
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *SlackHandler) handleRouting(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
+	slog.DebugContext(ctx, "Handling routing command")
+	h.openEditableQueuesForm(ctx, w, cmd, "routing", h.modalRenderer.RenderRoutingRulesForm)
+}
+
+func (h *SlackHandler) handleRouteTest(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand, title string) {
+	slog.DebugContext(ctx, "Handling route test command", slog.String("channelId", cmd.ChannelID))
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if title == "" {
+		json.NewEncoder(w).Encode(map[string]string{"text": "Usage: `/request route-test <request title>`"})
+		return
+	}
+
+	match, ok, err := h.requestRouter.RouteRequest(ctx, domain.RoutingCandidate{
+		ChannelID: cmd.ChannelID,
+		CreatorID: cmd.UserID,
+		Title:     title,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to test routing", slog.String("err", err.Error()))
+		json.NewEncoder(w).Encode(map[string]string{"text": "Failed to test routing. Please try again."})
+		return
+	}
+
+	if !ok {
+		json.NewEncoder(w).Encode(map[string]string{
+			"text": fmt.Sprintf("_No routing rule matches, so this request would stay in <#%s>._", cmd.ChannelID),
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{
+		"text": fmt.Sprintf("↪️ This request would go to *%s* because %s.", match.Queue.Name, match.Reason),
+	})
+}
+
+func (h *SlackHandler) handleJobs(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand, status string) {
+	slog.DebugContext(ctx, "Handling jobs command", slog.String("status", status))
+
+	w.Header().Set("Content-Type", "application/json")
+
+	statuses := []domain.JobStatus{}
+	if status != "" {
+		statuses = append(statuses, domain.JobStatus(status))
+	}
+
+	jobs, err := h.jobInspector.ListJobs(ctx, statuses)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list jobs", slog.String("err", err.Error()))
+		json.NewEncoder(w).Encode(map[string]string{"text": "Failed to list background jobs. Please try again."})
+		return
+	}
+
+	if len(jobs) == 0 {
+		json.NewEncoder(w).Encode(map[string]string{"text": "_No background jobs found._"})
+		return
+	}
+
+	lines := []string{"*Background jobs*"}
+	for _, job := range jobs {
+		line := fmt.Sprintf("• `%s` · %s", job.Key, job.Status)
+		switch job.Status {
+		case domain.JobScheduled:
+			line += " · next run " + slackDate(job.RunAt)
+		case domain.JobRunning:
+			line += fmt.Sprintf(" on %s since %s", job.LockedBy, slackDate(job.LastStartedAt))
+		}
+		if job.IsRecurring() {
+			line += fmt.Sprintf(" · `%s`", job.Schedule)
+		}
+		if !job.LastFinishedAt.IsZero() {
+			line += " · last finished " + slackDate(job.LastFinishedAt)
+		}
+		if job.LastError != "" {
+			line += fmt.Sprintf("\n      ⚠️ attempt %d/%d: _%s_", job.Attempts, job.MaxAttempts, job.LastError)
+		}
+		lines = append(lines, line)
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{"text": strings.Join(lines, "\n")})
+}
+
+func slackDate(t time.Time) string {
+	return fmt.Sprintf("<!date^%d^{date_short_pretty} {time}|%s>", t.Unix(), t.Format(time.RFC1123))
+}
+
 func (h *SlackHandler) handleListQueues(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
 	slog.DebugContext(ctx, "Handling list queues command")
-	h.modalRenderer.RenderQueueForm(ctx, cmd.TriggerID, secondaryports.QueueFormView{})
-	return
+
+	summaries, err := h.queueBrowser.ListQueueSummaries(ctx, cmd.ChannelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues", slog.String("err", err.Error()))
+
+		w.Header().Set("Content-Type", "application/json")
+		response := map[string]string{
+			"text": "Failed to list queues. Please try again.",
+		}
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	err = h.modalRenderer.RenderQueueSelector(ctx, cmd.TriggerID, summaries)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open queue selector", slog.String("err", err.Error()))
+	}
+
+	w.WriteHeader(http.StatusOK)
 }
 
 func (h *SlackHandler) HandleInteractions(w http.ResponseWriter, r *http.Request) {
@@ -128,16 +495,23 @@ func (h *SlackHandler) HandleInteractions(w http.ResponseWriter, r *http.Request
 	}
 }
 
-func (h *SlackHandler) handleNewRequest(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand) {
-	slog.DebugContext(ctx, "Handling new request command")
+func (h *SlackHandler) handleNewRequest(ctx context.Context, w http.ResponseWriter, r *http.Request, cmd slack.SlashCommand, templateName string) {
+	slog.DebugContext(ctx, "Handling new request command", slog.String("template", templateName))
 
-	err := h.requestHandler.OpenNewRequestForm(ctx, cmd.TriggerID)
+	err := h.requestHandler.OpenNewRequestForm(ctx, cmd.TriggerID, templateName)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to open new request modal: %v", err)
+		slog.ErrorContext(ctx, "Failed to open new request modal", slog.String("err", err.Error()))
+
+		text := "Failed to open request form. Please try again."
+		if errors.Is(err, domain.ErrTemplateNotFound) {
+			text = fmt.Sprintf("No template named `%s` was found.", templateName)
+		} else if templateName != "" {
+			text = fmt.Sprintf("Failed to open template `%s`: %s", templateName, err.Error())
+		}
 
 		w.Header().Set("Content-Type", "application/json")
 		response := map[string]string{
-			"text": "Failed to open request form. Please try again.",
+			"text": text,
 		}
 		json.NewEncoder(w).Encode(response)
 		return
@@ -163,7 +537,7 @@ func (h *SlackHandler) handleBlockActions(w http.ResponseWriter, r *http.Request
 					slog.String("recipientType", recipientType),
 					slog.String("viewID", payload.View.ID))
 
-				err := h.modalRenderer.UpdateRequestForm(ctx, payload.View.ID, domain.RequestRecipientType(recipientType))
+				err := h.requestHandler.RefreshNewRequestForm(ctx, payload.View.ID, domain.RequestRecipientType(recipientType), "")
 				if err != nil {
 					slog.ErrorContext(ctx, "Failed to update request form",
 						slog.String("err", err.Error()))
@@ -171,7 +545,219 @@ func (h *SlackHandler) handleBlockActions(w http.ResponseWriter, r *http.Request
 					return
 				}
 			}
+		case slackadapter.ActionIDQueueSelect:
+			err := h.requestHandler.RefreshNewRequestForm(ctx, payload.View.ID, domain.RequestRecipientQueue, action.SelectedOption.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to update request form for queue",
+					slog.String("err", err.Error()),
+					slog.String("queueId", action.SelectedOption.Value))
+			}
+		case slackadapter.ActionIDTemplateSelect:
+			queueId, templateKey, _ := strings.Cut(action.SelectedOption.Value, slackadapter.TemplateOptionSeparator)
+			err := h.requestHandler.SelectRequestTemplate(ctx, payload.View.ID, queueId, templateKey)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to apply request template",
+					slog.String("err", err.Error()),
+					slog.String("template", action.SelectedOption.Value))
+			}
+		case slackadapter.ActionIDBrowseQueue:
+			h.showQueueRequests(ctx, payload.View.ID, action.SelectedOption.Value, payload.User.ID, "")
+		case slackadapter.ActionIDFilterRequestsLabel:
+			label := action.SelectedOption.Value
+			if label == slackadapter.AllLabelsOptionValue {
+				label = ""
+			}
+			h.showQueueRequests(ctx, payload.View.ID, payload.View.PrivateMetadata, payload.User.ID, label)
+		case slackadapter.ActionIDLabelRequest:
+			h.openRequestLabelsForm(ctx, payload.TriggerID, action.Value)
+		case slackadapter.ActionIDEditBlockers:
+			h.openRequestBlockersForm(ctx, payload.TriggerID, action.Value)
+		case slackadapter.ActionIDMarkDuplicate:
+			h.openMarkDuplicateForm(ctx, payload.TriggerID, action.Value)
+		case slackadapter.ActionIDTransferRequest:
+			h.openTransferRequestForm(ctx, payload.TriggerID, action.Value)
+		case slackadapter.ActionIDToggleWatch:
+			watching, err := h.requestResponder.ToggleWatch(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to toggle watch",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+				break
+			}
+			slog.InfoContext(ctx, "Watch toggled",
+				slog.String("requestId", action.Value),
+				slog.Bool("watching", watching))
+		case slackadapter.ActionIDAcceptRequest:
+			err := h.requestResponder.AcceptRequest(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to accept request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+				h.warnOfWIPLimit(ctx, payload, err)
+			}
+		case slackadapter.ActionIDCompleteRequest:
+			err := h.requestResponder.CompleteRequest(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to complete request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDRejectRequest:
+			err := h.modalRenderer.RenderRejectionReasonForm(ctx, payload.TriggerID, action.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to open rejection reason form",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+			}
+		case slackadapter.ActionIDJoinRequest:
+			err := h.requestResponder.JoinRequest(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to join request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDTickChecklist:
+			requestId := strings.TrimPrefix(action.BlockID, slackadapter.BlockIDChecklistPrefix)
+			doneItemIds := make([]string, len(action.SelectedOptions))
+			for i, option := range action.SelectedOptions {
+				doneItemIds[i] = option.Value
+			}
+
+			err := h.requestResponder.TickChecklistItems(ctx, requestId, payload.User.ID, doneItemIds)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to tick checklist items",
+					slog.String("err", err.Error()),
+					slog.String("requestId", requestId),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDApproveRequest, slackadapter.ActionIDDenyRequest:
+			approve := action.ActionID == slackadapter.ActionIDApproveRequest
+			err := h.modalRenderer.RenderApprovalDecisionForm(ctx, payload.TriggerID, action.Value, approve)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to open approval form",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+			}
+		case slackadapter.ActionIDDeclineRequest:
+			err := h.requestResponder.DeclineRequest(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to decline request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDAssignRequest:
+			err := h.modalRenderer.RenderAssignForm(ctx, payload.TriggerID, action.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to open assign form",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+			}
+		case slackadapter.ActionIDHoldRequest:
+			err := h.modalRenderer.RenderHoldQuestionForm(ctx, payload.TriggerID, action.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to open hold question form",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+			}
+		case slackadapter.ActionIDSnoozeReminders:
+			err := h.reminderSnoozer.SnoozeReminders(ctx, action.Value, payload.User.ID, payload.Channel.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to snooze reminders",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDRemindLater:
+			requestId := strings.TrimPrefix(action.BlockID, slackadapter.BlockIDReminderActionsPrefix)
+			delay, err := time.ParseDuration(action.SelectedOption.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Invalid reminder delay",
+					slog.String("err", err.Error()),
+					slog.String("delay", action.SelectedOption.Value))
+				break
+			}
+
+			err = h.reminderSnoozer.RemindLater(ctx, requestId, payload.User.ID, payload.Channel.ID, delay)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to schedule reminder",
+					slog.String("err", err.Error()),
+					slog.String("requestId", requestId),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDKeepRequestOpen:
+			err := h.requestResponder.KeepRequestOpen(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to keep request open",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDCancelRequest:
+			err := h.requestResponder.CancelRequest(ctx, action.Value, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to cancel request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value),
+					slog.String("userId", payload.User.ID))
+			}
+		case slackadapter.ActionIDPauseRecurringRequest, slackadapter.ActionIDResumeRecurringRequest, slackadapter.ActionIDDeleteRecurringRequest:
+			var err error
+			switch action.ActionID {
+			case slackadapter.ActionIDPauseRecurringRequest:
+				err = h.recurringManager.PauseRecurringRequest(ctx, action.Value, payload.User.ID)
+			case slackadapter.ActionIDResumeRecurringRequest:
+				err = h.recurringManager.ResumeRecurringRequest(ctx, action.Value, payload.User.ID)
+			default:
+				err = h.recurringManager.DeleteRecurringRequest(ctx, action.Value, payload.User.ID)
+			}
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to update recurring request",
+					slog.String("err", err.Error()),
+					slog.String("actionID", action.ActionID),
+					slog.String("recurringId", action.Value))
+			}
+
+			view, err := h.recurringRequestListView(ctx, payload.User.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to list recurring requests", slog.String("err", err.Error()))
+				break
+			}
+			if err := h.modalRenderer.UpdateRecurringRequestList(ctx, payload.View.ID, view); err != nil {
+				slog.ErrorContext(ctx, "Failed to refresh recurring requests", slog.String("err", err.Error()))
+			}
+		case slackadapter.ActionIDReplyToHold:
+			request, err := h.requestResponder.GetRequestDetails(ctx, action.Value)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to load request for hold reply",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+				break
+			}
+
+			err = h.modalRenderer.RenderHoldReplyForm(ctx, payload.TriggerID, request)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to open hold reply form",
+					slog.String("err", err.Error()),
+					slog.String("requestId", action.Value))
+			}
 		default:
+			if statusKey, ok := strings.CutPrefix(action.ActionID, slackadapter.ActionIDTransitionPrefix); ok {
+				err := h.requestResponder.TransitionRequest(ctx, action.Value, statusKey, payload.User.ID)
+				if err != nil {
+					slog.ErrorContext(ctx, "Failed to transition request",
+						slog.String("err", err.Error()),
+						slog.String("requestId", action.Value),
+						slog.String("to", statusKey),
+						slog.String("userId", payload.User.ID))
+					h.warnOfWIPLimit(ctx, payload, err)
+				}
+				break
+			}
 			slog.DebugContext(ctx, "Unhandled action", slog.String("actionID", action.ActionID))
 		}
 	}
@@ -179,65 +765,764 @@ func (h *SlackHandler) handleBlockActions(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusOK)
 }
 
-func (h *SlackHandler) handleViewSubmission(w http.ResponseWriter, r *http.Request, payload *slack.InteractionCallback) {
-	ctx := r.Context()
-	parser := NewFormParser()
+func (h *SlackHandler) warnOfWIPLimit(ctx context.Context, payload *slack.InteractionCallback, err error) {
+	if !errors.Is(err, domain.ErrWIPLimitReached) {
+		return
+	}
 
-	switch payload.View.CallbackID {
-	case slackadapter.CallbackIDRequestForm:
-		formData, err := parser.ParseRequestForm(*payload)
-		if err != nil {
-			slog.ErrorContext(ctx, "Failed to parse request form",
-				slog.String("err", err.Error()))
-			h.respondWithError(w, err)
-			return
-		}
+	cause := err
+	if unwrapped := errors.Unwrap(err); unwrapped != nil {
+		cause = unwrapped
+	}
 
-		if err := h.formSubmissionHandler.HandleRequestFormSubmission(ctx, formData); err != nil {
-			slog.ErrorContext(ctx, "Failed to handle request form submission",
-				slog.String("err", err.Error()))
-			h.respondWithError(w, err)
-			return
-		}
+	message := fmt.Sprintf("🚧 You can't take on this request: %s. Finish or hand over something you're working on first, or ask a queue admin to assign it to you.", cause)
+	if err := h.messenger.SendEphemeralMessage(ctx, payload.Channel.ID, payload.User.ID, message); err != nil {
+		slog.ErrorContext(ctx, "Failed to send work in progress limit warning",
+			slog.String("err", err.Error()),
+			slog.String("userId", payload.User.ID))
+	}
+}
 
-		slog.InfoContext(ctx, "Request created successfully",
-			slog.String("createdBy", formData.CreatedByID))
+func (h *SlackHandler) warnOfRoutingOverlaps(ctx context.Context, userId string, overlaps []domain.RoutingOverlap) {
+	if len(overlaps) == 0 {
+		return
+	}
 
-	case slackadapter.CallbackIDQueueForm:
-		formData, err := parser.ParseQueueForm(*payload)
-		if err != nil {
-			slog.ErrorContext(ctx, "Failed to parse queue form",
-				slog.String("err", err.Error()))
-			h.respondWithError(w, err)
-			return
+	lines := []string{"⚠️ Your routing rules are saved, but other queues also route requests from the same channels:"}
+	for _, overlap := range overlaps {
+		order := "tried after yours"
+		if overlap.TriedFirst {
+			order = "tried before yours, so they win when both match"
 		}
+		lines = append(lines, fmt.Sprintf("• <#%s>: *%s*, whose rules are %s", overlap.ChannelID, overlap.Queue.Name, order))
+	}
 
-		if err := h.formSubmissionHandler.HandleQueueFormSubmission(ctx, formData); err != nil {
-			slog.ErrorContext(ctx, "Failed to handle queue form submission",
-				slog.String("err", err.Error()))
-			h.respondWithError(w, err)
-			return
-		}
+	if _, _, err := h.messenger.SendDirectMessage(ctx, userId, strings.Join(lines, "\n")); err != nil {
+		slog.ErrorContext(ctx, "Failed to send routing overlap warning",
+			slog.String("err", err.Error()),
+			slog.String("userId", userId))
+	}
+}
 
-		slog.InfoContext(ctx, "Queue created successfully",
-			slog.String("createdBy", formData.CreatedById),
-			slog.String("channelId", formData.ChannelId))
+func (h *SlackHandler) showQueueRequests(ctx context.Context, viewId, queueId, userId, label string) {
+	queue, err := h.queueManager.GetQueue(ctx, queueId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load queue for request list",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return
+	}
 
-	default:
-		slog.WarnContext(ctx, "Unknown view submission callback",
-			slog.String("callbackId", payload.View.CallbackID))
+	var labels []string
+	if label != "" {
+		labels = []string{label}
 	}
 
-	w.WriteHeader(http.StatusOK)
+	requests, err := h.queueBrowser.GetQueueRequests(ctx, queueId, userId, domain.OpenRequestStatuses(), labels)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load queue requests",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return
+	}
+
+	load := domain.NewWIPLoad(requests)
+	if label != "" {
+		inProgress, err := h.queueBrowser.GetQueueRequests(ctx, queueId, userId, domain.InProgressStatuses(), nil)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to load queue workload",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+		} else {
+			load = domain.NewWIPLoad(inProgress)
+		}
+	}
+
+	err = h.modalRenderer.RenderRequestList(ctx, viewId, secondaryports.RequestListView{
+		Queue:         queue,
+		SelectedLabel: label,
+		Requests:      requests,
+		Load:          load,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to render request list",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+	}
 }
 
-func (h *SlackHandler) respondWithError(w http.ResponseWriter, err error) {
-	w.Header().Set("Content-Type", "application/json")
-	response := map[string]interface{}{
-		"response_action": "errors",
+func (h *SlackHandler) openRequestLabelsForm(ctx context.Context, triggerId, requestId string) {
+	request, err := h.requestResponder.GetRequestDetails(ctx, requestId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load request for labelling",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	queue, err := h.queueManager.GetQueue(ctx, request.Recipient.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load queue for labelling",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	err = h.modalRenderer.RenderRequestLabelsForm(ctx, triggerId, request, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open request labels form",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+}
+
+func (h *SlackHandler) openRequestBlockersForm(ctx context.Context, triggerId, requestId string) {
+	request, candidates, err := h.loadRelatedRequests(ctx, requestId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load requests for blockers",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	err = h.modalRenderer.RenderRequestBlockersForm(ctx, triggerId, request, candidates)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open request blockers form",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+}
+
+func (h *SlackHandler) openMarkDuplicateForm(ctx context.Context, triggerId, requestId string) {
+	request, candidates, err := h.loadRelatedRequests(ctx, requestId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load requests for merge",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	err = h.modalRenderer.RenderMarkDuplicateForm(ctx, triggerId, request, candidates)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open mark duplicate form",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+}
+
+func (h *SlackHandler) openTransferRequestForm(ctx context.Context, triggerId, requestId string) {
+	request, err := h.requestResponder.GetRequestDetails(ctx, requestId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get request for transfer",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	queues, err := h.queueManager.ListQueues(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues for transfer",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	err = h.modalRenderer.RenderTransferRequestForm(ctx, triggerId, request, queues)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open transfer request form",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+}
+
+func (h *SlackHandler) loadRelatedRequests(ctx context.Context, requestId string) (*domain.Request, []*domain.Request, error) {
+	request, err := h.requestResponder.GetRequestDetails(ctx, requestId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requests, err := h.requestResponder.ListRecipientRequests(ctx, request.Recipient.ID, request.Recipient.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	related := []*domain.Request{}
+	for _, candidate := range requests {
+		if candidate.IsOpen() && candidate.ID != request.ID {
+			related = append(related, candidate)
+		}
+	}
+
+	return request, related, nil
+}
+
+func (h *SlackHandler) handleViewSubmission(w http.ResponseWriter, r *http.Request, payload *slack.InteractionCallback) {
+	ctx := r.Context()
+	parser := NewFormParser()
+
+	switch payload.View.CallbackID {
+	case slackadapter.CallbackIDRequestForm:
+		formData, err := parser.ParseRequestForm(*payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to parse request form",
+				slog.String("err", err.Error()))
+			h.respondWithError(w, err)
+			return
+		}
+
+		if formData.RecipientType == domain.RequestRecipientQueue {
+			queue, err := h.queueManager.GetQueue(ctx, formData.RecipientID)
+			if err != nil {
+				h.respondWithError(w, err)
+				return
+			}
+
+			if fieldErrors := parser.ValidateIntakeFields(queue.IntakeFields, formData.FieldValues); len(fieldErrors) > 0 {
+				h.respondWithFieldErrors(w, fieldErrors)
+				return
+			}
+		}
+
+		if err := h.formSubmissionHandler.HandleRequestFormSubmission(ctx, formData); err != nil {
+			slog.ErrorContext(ctx, "Failed to handle request form submission",
+				slog.String("err", err.Error()))
+			h.respondWithError(w, err)
+			return
+		}
+
+		slog.InfoContext(ctx, "Request created successfully",
+			slog.String("createdBy", formData.CreatedByID))
+
+	case slackadapter.CallbackIDQueueForm:
+		formData, err := parser.ParseQueueForm(*payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to parse queue form",
+				slog.String("err", err.Error()))
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.formSubmissionHandler.HandleQueueFormSubmission(ctx, formData); err != nil {
+			slog.ErrorContext(ctx, "Failed to handle queue form submission",
+				slog.String("err", err.Error()))
+			h.respondWithError(w, err)
+			return
+		}
+
+		slog.InfoContext(ctx, "Queue created successfully",
+			slog.String("createdBy", formData.CreatedById),
+			slog.String("channelId", formData.ChannelId))
+
+	case slackadapter.CallbackIDTemplateForm:
+		queueId, template, err := parser.ParseTemplateForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.AddQueueTemplate(ctx, queueId, template, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to add queue template",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDRemoveTemplateForm:
+		queueId, templateKey, err := parser.ParseRemoveTemplateForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.RemoveQueueTemplate(ctx, queueId, templateKey, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to remove queue template",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId),
+				slog.String("template", templateKey))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDQueueLabelsForm:
+		queueId, added, removed, err := parser.ParseQueueLabelsForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		for _, label := range added {
+			if err := h.queueManager.AddQueueLabel(ctx, queueId, label, payload.User.ID); err != nil {
+				slog.ErrorContext(ctx, "Failed to add queue label",
+					slog.String("err", err.Error()),
+					slog.String("queueId", queueId))
+				h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDLabelsAdd: fmt.Sprintf("%s: %s", label, err.Error())})
+				return
+			}
+		}
+
+		for _, label := range removed {
+			if err := h.queueManager.RemoveQueueLabel(ctx, queueId, label, payload.User.ID); err != nil {
+				slog.ErrorContext(ctx, "Failed to remove queue label",
+					slog.String("err", err.Error()),
+					slog.String("queueId", queueId))
+				h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDLabelsRemove: fmt.Sprintf("%s: %s", label, err.Error())})
+				return
+			}
+		}
+
+	case slackadapter.CallbackIDIntakeFieldsForm:
+		queueId, fields, err := parser.ParseIntakeFieldsForm(*payload)
+		if err != nil {
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDIntakeFieldsSpec: err.Error()})
+			return
+		}
+
+		if err := h.queueManager.SetQueueIntakeFields(ctx, queueId, fields, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue intake fields",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDWorkflowForm:
+		queueId, workflow, err := parser.ParseWorkflowForm(*payload)
+		if err != nil {
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDWorkflowSpec: err.Error()})
+			return
+		}
+
+		if err := h.queueManager.SetQueueWorkflow(ctx, queueId, workflow, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue workflow",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDApprovalChainForm:
+		queueId, stages, err := parser.ParseApprovalChainForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueApprovalChain(ctx, queueId, stages, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue approval chain",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDOnCallForm:
+		queueId, rotation, assignToOnCall, err := parser.ParseOnCallForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueOnCallRotation(ctx, queueId, rotation, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue on-call rotation",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+		if assignToOnCall {
+			if err := h.queueManager.SetQueueAssignmentStrategy(ctx, queueId, domain.AssignmentOnCall, payload.User.ID); err != nil {
+				slog.ErrorContext(ctx, "Failed to assign queue requests to on-call",
+					slog.String("err", err.Error()),
+					slog.String("queueId", queueId))
+				h.respondWithError(w, err)
+				return
+			}
+		}
+
+	case slackadapter.CallbackIDBusinessHoursForm:
+		queueId, calendar, holidayFileId, err := parser.ParseBusinessHoursForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueBusinessHours(ctx, queueId, calendar, holidayFileId, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue business hours",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDSLAForm:
+		queueId, policy, err := parser.ParseSLAForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueSLAPolicy(ctx, queueId, policy, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue SLA policy",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDReminderRulesForm:
+		queueId, rules, err := parser.ParseReminderRulesForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueReminderRules(ctx, queueId, rules, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue reminder rules",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDExpiryForm:
+		queueId, policy, err := parser.ParseExpiryForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueExpiryPolicy(ctx, queueId, policy, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue expiry policy",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDWIPLimitsForm:
+		queueId, limits, err := parser.ParseWIPLimitsForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.SetQueueWIPLimits(ctx, queueId, limits, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue WIP limits",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDRoutingRulesForm:
+		queueId, rules, err := parser.ParseRoutingRulesForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		overlaps, err := h.queueManager.SetQueueRoutingRules(ctx, queueId, rules, payload.User.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to set queue routing rules",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+		h.warnOfRoutingOverlaps(ctx, payload.User.ID, overlaps)
+
+	case slackadapter.CallbackIDRecurringRequestForm:
+		data, err := parser.ParseRecurringRequestForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if _, err := h.recurringManager.CreateRecurringRequest(ctx, data); err != nil {
+			slog.ErrorContext(ctx, "Failed to create recurring request",
+				slog.String("err", err.Error()),
+				slog.String("userId", payload.User.ID))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDOnCallOverrideForm:
+		queueId, override, err := parser.ParseOnCallOverrideForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.queueManager.AddQueueOnCallOverride(ctx, queueId, override, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to add on-call override",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queueId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDRequestLabels:
+		requestId, labels, err := parser.ParseRequestLabelsForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.LabelRequest(ctx, requestId, payload.User.ID, labels); err != nil {
+			slog.ErrorContext(ctx, "Failed to label request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDRequestBlockers:
+		requestId, blockerIds, err := parser.ParseRequestBlockersForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.SetRequestBlockers(ctx, requestId, payload.User.ID, blockerIds); err != nil {
+			slog.ErrorContext(ctx, "Failed to set request blockers",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDRequestBlockers: err.Error()})
+			return
+		}
+
+	case slackadapter.CallbackIDMarkDuplicate:
+		requestId, canonicalId, err := parser.ParseMarkDuplicateForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.MarkRequestDuplicate(ctx, requestId, canonicalId, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to mark request as duplicate",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId),
+				slog.String("canonicalId", canonicalId))
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDCanonicalRequest: err.Error()})
+			return
+		}
+
+	case slackadapter.CallbackIDTransferRequest:
+		requestId, queueId, reason, err := parser.ParseTransferRequestForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.TransferRequest(ctx, requestId, queueId, payload.User.ID, reason); err != nil {
+			slog.ErrorContext(ctx, "Failed to transfer request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId),
+				slog.String("queueId", queueId))
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDTransferQueue: err.Error()})
+			return
+		}
+
+	case slackadapter.CallbackIDApproveRequest, slackadapter.CallbackIDDenyRequest:
+		requestId, comment, err := parser.ParseApprovalDecisionForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if payload.View.CallbackID == slackadapter.CallbackIDApproveRequest {
+			err = h.requestResponder.ApproveRequest(ctx, requestId, payload.User.ID, comment)
+		} else {
+			err = h.requestResponder.DenyRequest(ctx, requestId, payload.User.ID, comment)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to record approval decision",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDApprovalComment: err.Error()})
+			return
+		}
+
+	case slackadapter.CallbackIDAssignRequest:
+		requestId, assigneeId, err := parser.ParseAssignForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.AssignRequest(ctx, requestId, assigneeId, payload.User.ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to assign request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithFieldErrors(w, map[string]string{slackadapter.BlockIDAssignee: err.Error()})
+			return
+		}
+
+	case slackadapter.CallbackIDRejectionReason:
+		requestId, reason, err := parser.ParseRejectionReasonForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.RejectRequest(ctx, requestId, payload.User.ID, reason); err != nil {
+			slog.ErrorContext(ctx, "Failed to reject request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDHoldQuestion:
+		requestId, question, err := parser.ParseHoldQuestionForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.PutRequestOnHold(ctx, requestId, payload.User.ID, question); err != nil {
+			slog.ErrorContext(ctx, "Failed to put request on hold",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	case slackadapter.CallbackIDHoldReply:
+		requestId, reply, err := parser.ParseHoldReplyForm(*payload)
+		if err != nil {
+			h.respondWithError(w, err)
+			return
+		}
+
+		if err := h.requestResponder.ResumeRequest(ctx, requestId, payload.User.ID, reply); err != nil {
+			slog.ErrorContext(ctx, "Failed to resume request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+			h.respondWithError(w, err)
+			return
+		}
+
+	default:
+		slog.WarnContext(ctx, "Unknown view submission callback",
+			slog.String("callbackId", payload.View.CallbackID))
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *SlackHandler) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	event, err := slackevents.ParseEvent(json.RawMessage(body), slackevents.OptionNoVerifyToken())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to parse event", slog.String("err", err.Error()))
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	switch event.Type {
+	case slackevents.URLVerification:
+		var challenge slackevents.ChallengeResponse
+		if err := json.Unmarshal(body, &challenge); err != nil {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(challenge.Challenge))
+		return
+	case slackevents.CallbackEvent:
+		h.handleCallbackEvent(r.Context(), event.InnerEvent)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *SlackHandler) handleCallbackEvent(ctx context.Context, innerEvent slackevents.EventsAPIInnerEvent) {
+	switch ev := innerEvent.Data.(type) {
+	case *slackevents.MessageEvent:
+		if ev.ThreadTimeStamp == "" || ev.BotID != "" || ev.SubType != "" {
+			return
+		}
+
+		err := h.requestResponder.ResumeRequestFromThread(ctx, ev.Channel, ev.ThreadTimeStamp, ev.User, ev.Text)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to resume request from thread reply",
+				slog.String("err", err.Error()),
+				slog.String("channelId", ev.Channel),
+				slog.String("threadTs", ev.ThreadTimeStamp))
+		}
+	default:
+		slog.DebugContext(ctx, "Unhandled event", slog.String("eventType", innerEvent.Type))
+	}
+}
+
+func (h *SlackHandler) openEditableQueuesForm(ctx context.Context, w http.ResponseWriter, cmd slack.SlashCommand, form string, render func(ctx context.Context, triggerId string, queues []*domain.Queue) error) {
+	queues, err := h.editableQueues(ctx, cmd.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to list queues for %s form", form), slog.String("err", err.Error()))
+		h.respondWithFormFailure(w, form)
+		return
+	}
+
+	if err := render(ctx, cmd.TriggerID, queues); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("Failed to open %s form", form), slog.String("err", err.Error()))
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *SlackHandler) editableQueues(ctx context.Context, userId string) ([]*domain.Queue, error) {
+	queues, err := h.queueManager.ListQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	editable := []*domain.Queue{}
+	for _, queue := range queues {
+		if queue.Decide(userId, domain.PermissionEditQueue).Allowed {
+			editable = append(editable, queue)
+		}
+	}
+	return editable, nil
+}
+
+func (h *SlackHandler) respondWithFormFailure(w http.ResponseWriter, form string) {
+	w.Header().Set("Content-Type", "application/json")
+	response := map[string]string{
+		"text": fmt.Sprintf("Failed to open %s form. Please try again.", form),
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *SlackHandler) respondWithError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	response := map[string]interface{}{
+		"response_action": "errors",
 		"errors": map[string]string{
 			"general": err.Error(),
 		},
 	}
 	json.NewEncoder(w).Encode(response)
 }
+
+func (h *SlackHandler) respondWithFieldErrors(w http.ResponseWriter, fieldErrors map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	response := map[string]interface{}{
+		"response_action": "errors",
+		"errors":          fieldErrors,
+	}
+	json.NewEncoder(w).Encode(response)
+}
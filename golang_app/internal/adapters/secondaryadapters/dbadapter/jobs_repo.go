@@ -0,0 +1,232 @@
+package dbadapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobDTO struct {
+	ID              string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Name            string `gorm:"not null;index;type:varchar;size:100"`
+	Key             string `gorm:"not null;uniqueIndex;type:varchar;size:255"`
+	Payload         string
+	Schedule        string `gorm:"type:varchar;size:100"`
+	PendingSchedule string `gorm:"type:varchar;size:100"`
+	PendingPayload  string
+	Status          string    `gorm:"not null;index:idx_jobs_status_run_at"`
+	RunAt           time.Time `gorm:"not null;index:idx_jobs_status_run_at"`
+	Attempts        int       `gorm:"not null;default:0"`
+	MaxAttempts     int       `gorm:"not null;default:5"`
+	LastError       string
+	LockedBy        string
+	LockedUntil     *time.Time
+	LastStartedAt   *time.Time
+	LastFinishedAt  *time.Time
+	Version         int       `gorm:"not null;default:0"`
+	CreatedAt       time.Time `gorm:"not null"`
+	UpdatedAt       time.Time `gorm:"not null"`
+}
+
+func (JobDTO) TableName() string {
+	return "jobs"
+}
+
+func (dto *JobDTO) ToDomain() *domain.Job {
+	job := &domain.Job{
+		ID:              dto.ID,
+		Name:            dto.Name,
+		Key:             dto.Key,
+		Payload:         dto.Payload,
+		Schedule:        dto.Schedule,
+		PendingSchedule: dto.PendingSchedule,
+		PendingPayload:  dto.PendingPayload,
+		Status:          domain.JobStatus(dto.Status),
+		RunAt:           dto.RunAt,
+		Attempts:        dto.Attempts,
+		MaxAttempts:     dto.MaxAttempts,
+		LastError:       dto.LastError,
+		LockedBy:        dto.LockedBy,
+		Version:         dto.Version,
+		CreatedAt:       dto.CreatedAt,
+		UpdatedAt:       dto.UpdatedAt,
+	}
+
+	if dto.LockedUntil != nil {
+		job.LockedUntil = *dto.LockedUntil
+	}
+
+	if dto.LastStartedAt != nil {
+		job.LastStartedAt = *dto.LastStartedAt
+	}
+
+	if dto.LastFinishedAt != nil {
+		job.LastFinishedAt = *dto.LastFinishedAt
+	}
+
+	return job
+}
+
+func NewJobDTO(job *domain.Job) *JobDTO {
+	dto := &JobDTO{
+		ID:              job.ID,
+		Name:            job.Name,
+		Key:             job.Key,
+		Payload:         job.Payload,
+		Schedule:        job.Schedule,
+		PendingSchedule: job.PendingSchedule,
+		PendingPayload:  job.PendingPayload,
+		Status:          string(job.Status),
+		RunAt:           job.RunAt.UTC(),
+		Attempts:        job.Attempts,
+		MaxAttempts:     job.MaxAttempts,
+		LastError:       job.LastError,
+		LockedBy:        job.LockedBy,
+		Version:         job.Version,
+		CreatedAt:       job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+	}
+
+	if !job.LockedUntil.IsZero() {
+		lockedUntil := job.LockedUntil.UTC()
+		dto.LockedUntil = &lockedUntil
+	}
+
+	if !job.LastStartedAt.IsZero() {
+		lastStartedAt := job.LastStartedAt
+		dto.LastStartedAt = &lastStartedAt
+	}
+
+	if !job.LastFinishedAt.IsZero() {
+		lastFinishedAt := job.LastFinishedAt
+		dto.LastFinishedAt = &lastFinishedAt
+	}
+
+	return dto
+}
+
+type JobsWriter struct {
+	db *gorm.DB
+}
+
+func NewJobsWriter(db *gorm.DB) *JobsWriter {
+	return &JobsWriter{db: db}
+}
+
+func (w *JobsWriter) Save(ctx context.Context, job *domain.Job) error {
+	dto := NewJobDTO(job)
+	dto.Version = job.Version + 1
+
+	if job.Version == 0 {
+		result := w.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(dto)
+		if result.Error != nil {
+			return fmt.Errorf("failed to create job: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrJobExists
+		}
+		job.Version = dto.Version
+		return nil
+	}
+
+	result := w.db.WithContext(ctx).
+		Model(&JobDTO{}).
+		Where("id = ? AND version = ?", job.ID, job.Version).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(dto)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrJobConflict
+	}
+
+	job.Version = dto.Version
+	return nil
+}
+
+type JobsReader struct {
+	db *gorm.DB
+}
+
+func NewJobsReader(db *gorm.DB) *JobsReader {
+	return &JobsReader{db: db}
+}
+
+func (r *JobsReader) GetById(ctx context.Context, jobId string) (*domain.Job, error) {
+	var dto JobDTO
+	if err := r.db.WithContext(ctx).First(&dto, "id = ?", jobId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("job not found: %s", jobId)
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	return dto.ToDomain(), nil
+}
+
+func (r *JobsReader) FindByKey(ctx context.Context, key string) (*domain.Job, error) {
+	var dtos []JobDTO
+	if err := r.db.WithContext(ctx).Limit(1).Find(&dtos, "`key` = ?", key).Error; err != nil {
+		return nil, fmt.Errorf("failed to find job by key: %w", err)
+	}
+
+	if len(dtos) == 0 {
+		return nil, nil
+	}
+	return dtos[0].ToDomain(), nil
+}
+
+func (r *JobsReader) FindByStatuses(ctx context.Context, statuses []domain.JobStatus) ([]*domain.Job, error) {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+
+	query := r.db.WithContext(ctx).Order("run_at")
+	if len(values) > 0 {
+		query = query.Where("status IN ?", values)
+	}
+
+	var dtos []JobDTO
+	if err := query.Find(&dtos).Error; err != nil {
+		return nil, fmt.Errorf("failed to find jobs by statuses: %w", err)
+	}
+
+	jobs := make([]*domain.Job, len(dtos))
+	for i, dto := range dtos {
+		jobs[i] = dto.ToDomain()
+	}
+	return jobs, nil
+}
+
+// FindDue returns jobs that are due to run and running jobs whose lease has
+// expired, oldest first. Times are compared in UTC, as they are stored.
+func (r *JobsReader) FindDue(ctx context.Context, at time.Time, limit int) ([]*domain.Job, error) {
+	var dtos []JobDTO
+	err := r.db.WithContext(ctx).
+		Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+			string(domain.JobScheduled), at.UTC(), string(domain.JobRunning), at.UTC()).
+		Order("run_at").
+		Limit(limit).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due jobs: %w", err)
+	}
+
+	jobs := make([]*domain.Job, len(dtos))
+	for i, dto := range dtos {
+		jobs[i] = dto.ToDomain()
+	}
+	return jobs, nil
+}
+
+var _ secondaryports.ForStoringJobs = (*JobsWriter)(nil)
+var _ secondaryports.ForReadingJobs = (*JobsReader)(nil)
@@ -0,0 +1,81 @@
+//go:build integration
+// +build integration
+
+package dbadapter_test
+
+import (
+	"context"
+	"errors"
+	"request/internal/adapters/secondaryadapters/dbadapter"
+	"request/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestJobsRepo(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	testId, testKey := "test-job-id", "test-job-key"
+	t.Cleanup(func() {
+		db.Delete(dbadapter.JobDTO{ID: testId})
+		db.Where("`key` = ?", testKey).Delete(&dbadapter.JobDTO{})
+	})
+
+	ctx := context.Background()
+	jw := dbadapter.NewJobsWriter(db)
+	jr := dbadapter.NewJobsReader(db)
+
+	now := time.Now()
+	job, err := domain.NewJob(testId, "test-job", testKey, "", now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("Failed to create a job: %v", err)
+	}
+
+	if err := jw.Save(ctx, job); err != nil {
+		t.Fatalf("Failed to save a new job: %v", err)
+	}
+
+	duplicate, _ := domain.NewJob("test-job-duplicate", "test-job", testKey, "", now)
+	if err := jw.Save(ctx, duplicate); !errors.Is(err, domain.ErrJobExists) {
+		t.Fatalf("Expected ErrJobExists for a duplicate key, got %v", err)
+	}
+
+	due, err := jr.FindDue(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("Failed to find due jobs: %v", err)
+	}
+	found := false
+	for _, j := range due {
+		found = found || j.ID == testId
+	}
+	if !found {
+		t.Fatalf("Expected the job to be due")
+	}
+
+	first, _ := jr.GetById(ctx, testId)
+	second, _ := jr.GetById(ctx, testId)
+
+	first.Claim("worker-1", now, time.Minute)
+	if err := jw.Save(ctx, first); err != nil {
+		t.Fatalf("Failed to claim the job: %v", err)
+	}
+
+	second.Claim("worker-2", now, time.Minute)
+	if err := jw.Save(ctx, second); !errors.Is(err, domain.ErrJobConflict) {
+		t.Fatalf("Expected ErrJobConflict for a stale claim, got %v", err)
+	}
+
+	stored, err := jr.FindByKey(ctx, testKey)
+	if err != nil {
+		t.Fatalf("Failed to read the job: %v", err)
+	}
+	AssertEquals(t, domain.JobRunning, stored.Status)
+	AssertEquals(t, "worker-1", stored.LockedBy)
+	AssertEquals(t, 1, stored.Attempts)
+}
@@ -0,0 +1,347 @@
+package dbadapter
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"request/internal/domain"
+)
+
+type JSONList[T any] []T
+
+func (l JSONList[T]) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	return json.Marshal(l)
+}
+
+func (l *JSONList[T]) Scan(value interface{}) error {
+	if value == nil {
+		*l = JSONList[T]{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for JSONList: %T", value)
+	}
+
+	return json.Unmarshal(data, l)
+}
+
+type IntakeFieldRecord struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+}
+
+type FieldValueRecord struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type TemplateRecord struct {
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	Title         string `json:"title"`
+	Description   string `json:"description,omitempty"`
+	RecipientType string `json:"recipient_type,omitempty"`
+	RecipientID   string `json:"recipient_id,omitempty"`
+}
+
+type ApprovalStageRecord struct {
+	Name               string   `json:"name"`
+	ApproverIDs        []string `json:"approver_ids"`
+	Rule               string   `json:"rule"`
+	Quorum             int      `json:"quorum,omitempty"`
+	TimeoutSeconds     int64    `json:"timeout_seconds,omitempty"`
+	FallbackApproverID string   `json:"fallback_approver_id,omitempty"`
+}
+
+type ApprovalRecord struct {
+	Stage      int       `json:"stage"`
+	ApproverID string    `json:"approver_id"`
+	Approved   bool      `json:"approved"`
+	Comment    string    `json:"comment,omitempty"`
+	DecidedAt  time.Time `json:"decided_at"`
+}
+
+type ApprovalMessageRecord struct {
+	ApproverID string `json:"approver_id"`
+	ChannelID  string `json:"channel_id"`
+	Ts         string `json:"ts"`
+}
+
+type OnCallOverrideRecord struct {
+	UserID string    `json:"user_id"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+type HoldPeriodRecord struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type QueueTransferRecord struct {
+	FromQueueID   string    `json:"from_queue_id"`
+	FromQueueName string    `json:"from_queue_name"`
+	ToQueueID     string    `json:"to_queue_id"`
+	ToQueueName   string    `json:"to_queue_name"`
+	ToChannelID   string    `json:"to_channel_id"`
+	ByID          string    `json:"by_id"`
+	Reason        string    `json:"reason"`
+	At            time.Time `json:"at"`
+}
+
+type SentReminderRecord struct {
+	RuleKey string    `json:"rule_key"`
+	Since   time.Time `json:"since"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+type ReminderRuleRecord struct {
+	Condition    string `json:"condition"`
+	AfterSeconds int64  `json:"after_seconds"`
+	Target       string `json:"target"`
+}
+
+type RoutingRuleRecord struct {
+	ChannelID string `json:"channel_id"`
+	Match     string `json:"match,omitempty"`
+	UserGroup string `json:"user_group,omitempty"`
+}
+
+type WorkingHoursRecord struct {
+	Weekday int `json:"weekday"`
+	Start   int `json:"start"`
+	End     int `json:"end"`
+}
+
+type HolidayRecord struct {
+	Date string `json:"date"`
+	Name string `json:"name,omitempty"`
+}
+
+type WorkflowStatusRecord struct {
+	Key      string   `json:"key"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Next     []string `json:"next,omitempty"`
+}
+
+func newWorkflowRecords(workflow *domain.Workflow) JSONList[WorkflowStatusRecord] {
+	if workflow == nil {
+		return nil
+	}
+
+	records := make(JSONList[WorkflowStatusRecord], len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		records[i] = WorkflowStatusRecord{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: string(status.Category),
+			Next:     status.Next,
+		}
+	}
+	return records
+}
+
+func workflowFromRecords(records JSONList[WorkflowStatusRecord]) *domain.Workflow {
+	if len(records) == 0 {
+		return nil
+	}
+
+	workflow := &domain.Workflow{Statuses: make([]domain.WorkflowStatus, len(records))}
+	for i, record := range records {
+		workflow.Statuses[i] = domain.WorkflowStatus{
+			Key:      record.Key,
+			Name:     record.Name,
+			Category: domain.StatusCategory(record.Category),
+			Next:     record.Next,
+		}
+	}
+	return workflow
+}
+
+func newApprovalChainRecords(stages []domain.ApprovalStage) JSONList[ApprovalStageRecord] {
+	records := make(JSONList[ApprovalStageRecord], len(stages))
+	for i, stage := range stages {
+		records[i] = ApprovalStageRecord{
+			Name:               stage.Name,
+			ApproverIDs:        stage.ApproverIDs,
+			Rule:               string(stage.Rule),
+			Quorum:             stage.Quorum,
+			TimeoutSeconds:     int64(stage.Timeout / time.Second),
+			FallbackApproverID: stage.FallbackApproverID,
+		}
+	}
+	return records
+}
+
+func approvalChainFromRecords(records JSONList[ApprovalStageRecord]) []domain.ApprovalStage {
+	if len(records) == 0 {
+		return nil
+	}
+
+	stages := make([]domain.ApprovalStage, len(records))
+	for i, record := range records {
+		stages[i] = domain.ApprovalStage{
+			Name:               record.Name,
+			ApproverIDs:        record.ApproverIDs,
+			Rule:               domain.QuorumRule(record.Rule),
+			Quorum:             record.Quorum,
+			Timeout:            time.Duration(record.TimeoutSeconds) * time.Second,
+			FallbackApproverID: record.FallbackApproverID,
+		}
+	}
+	return stages
+}
+
+func onCallFromColumns(dto *QueueDTO) *domain.OnCallRotation {
+	if len(dto.OnCallMemberIds) == 0 || dto.OnCallStartsAt == nil {
+		return nil
+	}
+
+	rotation := &domain.OnCallRotation{
+		MemberIds: []string(dto.OnCallMemberIds),
+		StartsAt:  *dto.OnCallStartsAt,
+		ShiftDays: dto.OnCallShiftDays,
+		TimeZone:  dto.OnCallTimeZone,
+	}
+	for _, record := range dto.OnCallOverrides {
+		rotation.Overrides = append(rotation.Overrides, domain.OnCallOverride{
+			UserID: record.UserID,
+			Start:  record.Start,
+			End:    record.End,
+		})
+	}
+	return rotation
+}
+
+func businessCalendarFromColumns(dto *QueueDTO) *domain.BusinessCalendar {
+	if len(dto.BusinessHours) == 0 {
+		return nil
+	}
+
+	calendar := &domain.BusinessCalendar{TimeZone: dto.BusinessTimeZone}
+	for _, record := range dto.BusinessHours {
+		calendar.Hours = append(calendar.Hours, domain.WorkingHours{
+			Weekday: time.Weekday(record.Weekday),
+			Start:   record.Start,
+			End:     record.End,
+		})
+	}
+	for _, record := range dto.Holidays {
+		calendar.Holidays = append(calendar.Holidays, domain.Holiday{Date: record.Date, Name: record.Name})
+	}
+	return calendar
+}
+
+func slaPolicyFromColumns(dto *QueueDTO) *domain.SLAPolicy {
+	if dto.SLAAcceptSeconds == 0 && dto.SLACompleteSeconds == 0 {
+		return nil
+	}
+
+	return &domain.SLAPolicy{
+		AcceptWithin:   time.Duration(dto.SLAAcceptSeconds) * time.Second,
+		CompleteWithin: time.Duration(dto.SLACompleteSeconds) * time.Second,
+		AtRiskPercent:  dto.SLAAtRiskPercent,
+	}
+}
+
+func expiryPolicyFromColumns(dto *QueueDTO) *domain.ExpiryPolicy {
+	if dto.ExpireAfterSeconds == 0 {
+		return nil
+	}
+
+	return &domain.ExpiryPolicy{After: time.Duration(dto.ExpireAfterSeconds) * time.Second}
+}
+
+func newRoutingRuleRecords(rules []domain.RoutingRule) JSONList[RoutingRuleRecord] {
+	records := make(JSONList[RoutingRuleRecord], len(rules))
+	for i, rule := range rules {
+		records[i] = RoutingRuleRecord{
+			ChannelID: rule.ChannelID,
+			Match:     rule.Match,
+			UserGroup: rule.UserGroup,
+		}
+	}
+	return records
+}
+
+func routingRulesFromRecords(records JSONList[RoutingRuleRecord]) []domain.RoutingRule {
+	if len(records) == 0 {
+		return nil
+	}
+
+	rules := make([]domain.RoutingRule, len(records))
+	for i, record := range records {
+		rules[i] = domain.RoutingRule{
+			ChannelID: record.ChannelID,
+			Match:     record.Match,
+			UserGroup: record.UserGroup,
+		}
+	}
+	return rules
+}
+
+func wipLimitsFromColumns(dto *QueueDTO) *domain.WIPLimits {
+	if dto.WIPPerMember == 0 && dto.WIPPerQueue == 0 {
+		return nil
+	}
+
+	return &domain.WIPLimits{PerMember: dto.WIPPerMember, PerQueue: dto.WIPPerQueue}
+}
+
+func newReminderRuleRecords(rules []domain.ReminderRule) JSONList[ReminderRuleRecord] {
+	records := make(JSONList[ReminderRuleRecord], len(rules))
+	for i, rule := range rules {
+		records[i] = ReminderRuleRecord{
+			Condition:    string(rule.Condition),
+			AfterSeconds: int64(rule.After / time.Second),
+			Target:       string(rule.Target),
+		}
+	}
+	return records
+}
+
+func newSentReminderRecords(sent []domain.SentReminder) JSONList[SentReminderRecord] {
+	records := make(JSONList[SentReminderRecord], len(sent))
+	for i, reminder := range sent {
+		records[i] = SentReminderRecord{
+			RuleKey: reminder.RuleKey,
+			Since:   reminder.Since,
+			SentAt:  reminder.SentAt,
+		}
+	}
+	return records
+}
+
+func reminderRulesFromRecords(records JSONList[ReminderRuleRecord]) []domain.ReminderRule {
+	if len(records) == 0 {
+		return nil
+	}
+
+	rules := make([]domain.ReminderRule, len(records))
+	for i, record := range records {
+		rules[i] = domain.ReminderRule{
+			Condition: domain.ReminderCondition(record.Condition),
+			After:     time.Duration(record.AfterSeconds) * time.Second,
+			Target:    domain.ReminderTarget(record.Target),
+		}
+	}
+	return rules
+}
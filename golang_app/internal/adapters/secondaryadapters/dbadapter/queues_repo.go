@@ -41,15 +41,39 @@ func (s *StringSlice) Scan(value interface{}) error {
 }
 
 type QueueDTO struct {
-	ID          string      `gorm:"not null;primaryKey;type:varchar;size:50"`
-	ChannelId   string      `gorm:"index;type:varchar;size:50"`
-	Name        string      `gorm:"not null;type:varchar;size:255"`
-	Description string      `gorm:"type:varchar;size:255"`
-	CreatedById string      `gorm:"not null;index"`
-	AdminIds    StringSlice `gorm:"type:json"`
-	MemberIds   StringSlice `gorm:"type:json"`
-	CreatedAt   time.Time   `gorm:"not null"`
-	UpdatedAt   time.Time   `gorm:"not null"`
+	ID                 string                         `gorm:"not null;primaryKey;type:varchar;size:50"`
+	ChannelId          string                         `gorm:"index;type:varchar;size:50"`
+	Name               string                         `gorm:"not null;type:varchar;size:255"`
+	Description        string                         `gorm:"type:varchar;size:255"`
+	CreatedById        string                         `gorm:"not null;index"`
+	AdminIds           StringSlice                    `gorm:"type:json"`
+	MemberIds          StringSlice                    `gorm:"type:json"`
+	TriagerIds         StringSlice                    `gorm:"type:json"`
+	Labels             StringSlice                    `gorm:"type:json"`
+	IntakeFields       JSONList[IntakeFieldRecord]    `gorm:"type:json"`
+	Templates          JSONList[TemplateRecord]       `gorm:"type:json"`
+	Workflow           JSONList[WorkflowStatusRecord] `gorm:"type:json"`
+	ApprovalChain      JSONList[ApprovalStageRecord]  `gorm:"type:json"`
+	AssignmentStrategy string                         `gorm:"not null;default:'none'"`
+	LastAssigneeID     string
+	OnCallMemberIds    StringSlice `gorm:"type:json"`
+	OnCallStartsAt     *time.Time
+	OnCallShiftDays    int
+	OnCallTimeZone     string
+	OnCallOverrides    JSONList[OnCallOverrideRecord] `gorm:"type:json"`
+	BusinessTimeZone   string
+	BusinessHours      JSONList[WorkingHoursRecord] `gorm:"type:json"`
+	Holidays           JSONList[HolidayRecord]      `gorm:"type:json"`
+	SLAAcceptSeconds   int64                        `gorm:"not null;default:0"`
+	SLACompleteSeconds int64                        `gorm:"not null;default:0"`
+	SLAAtRiskPercent   int                          `gorm:"not null;default:0"`
+	ReminderRules      JSONList[ReminderRuleRecord] `gorm:"type:json"`
+	ExpireAfterSeconds int64                        `gorm:"not null;default:0"`
+	WIPPerMember       int                          `gorm:"column:wip_per_member;not null;default:0"`
+	WIPPerQueue        int                          `gorm:"column:wip_per_queue;not null;default:0"`
+	RoutingRules       JSONList[RoutingRuleRecord]  `gorm:"type:json"`
+	CreatedAt          time.Time                    `gorm:"not null"`
+	UpdatedAt          time.Time                    `gorm:"not null"`
 }
 
 func (QueueDTO) TableName() string {
@@ -57,31 +81,142 @@ func (QueueDTO) TableName() string {
 }
 
 func (dto *QueueDTO) ToDomain() *domain.Queue {
-	return &domain.Queue{
-		ID:          dto.ID,
-		ChannelId:   dto.ChannelId,
-		Name:        dto.Name,
-		Description: dto.Description,
-		CreatedById: dto.CreatedById,
-		AdminIds:    []string(dto.AdminIds),
-		MemberIds:   []string(dto.MemberIds),
-		CreatedAt:   dto.CreatedAt,
-		UpdatedAt:   dto.UpdatedAt,
+	queue := &domain.Queue{
+		ID:                 dto.ID,
+		ChannelId:          dto.ChannelId,
+		Name:               dto.Name,
+		Description:        dto.Description,
+		CreatedById:        dto.CreatedById,
+		AdminIds:           []string(dto.AdminIds),
+		MemberIds:          []string(dto.MemberIds),
+		TriagerIds:         []string(dto.TriagerIds),
+		Labels:             []string(dto.Labels),
+		Workflow:           workflowFromRecords(dto.Workflow),
+		ApprovalChain:      approvalChainFromRecords(dto.ApprovalChain),
+		AssignmentStrategy: domain.AssignmentStrategy(dto.AssignmentStrategy),
+		LastAssigneeID:     dto.LastAssigneeID,
+		OnCall:             onCallFromColumns(dto),
+		BusinessHours:      businessCalendarFromColumns(dto),
+		SLA:                slaPolicyFromColumns(dto),
+		ReminderRules:      reminderRulesFromRecords(dto.ReminderRules),
+		Expiry:             expiryPolicyFromColumns(dto),
+		WIP:                wipLimitsFromColumns(dto),
+		RoutingRules:       routingRulesFromRecords(dto.RoutingRules),
+		CreatedAt:          dto.CreatedAt,
+		UpdatedAt:          dto.UpdatedAt,
 	}
+
+	for _, field := range dto.IntakeFields {
+		queue.IntakeFields = append(queue.IntakeFields, domain.IntakeField{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     domain.IntakeFieldType(field.Type),
+			Required: field.Required,
+			Options:  field.Options,
+		})
+	}
+
+	for _, template := range dto.Templates {
+		queue.Templates = append(queue.Templates, domain.RequestTemplate{
+			Key:           template.Key,
+			Name:          template.Name,
+			Title:         template.Title,
+			Description:   template.Description,
+			RecipientType: domain.RequestRecipientType(template.RecipientType),
+			RecipientID:   template.RecipientID,
+		})
+	}
+
+	return queue
 }
 
 func NewQueueDTO(queue *domain.Queue) *QueueDTO {
-	return &QueueDTO{
-		ID:          queue.ID,
-		ChannelId:   queue.ChannelId,
-		Name:        queue.Name,
-		Description: queue.Description,
-		CreatedById: queue.CreatedById,
-		AdminIds:    StringSlice(queue.AdminIds),
-		MemberIds:   StringSlice(queue.MemberIds),
-		CreatedAt:   queue.CreatedAt,
-		UpdatedAt:   queue.UpdatedAt,
+	dto := &QueueDTO{
+		ID:                 queue.ID,
+		ChannelId:          queue.ChannelId,
+		Name:               queue.Name,
+		Description:        queue.Description,
+		CreatedById:        queue.CreatedById,
+		AdminIds:           StringSlice(queue.AdminIds),
+		MemberIds:          StringSlice(queue.MemberIds),
+		TriagerIds:         StringSlice(queue.TriagerIds),
+		Labels:             StringSlice(queue.Labels),
+		Workflow:           newWorkflowRecords(queue.Workflow),
+		ApprovalChain:      newApprovalChainRecords(queue.ApprovalChain),
+		ReminderRules:      newReminderRuleRecords(queue.ReminderRules),
+		RoutingRules:       newRoutingRuleRecords(queue.RoutingRules),
+		AssignmentStrategy: string(queue.AssignmentStrategy),
+		LastAssigneeID:     queue.LastAssigneeID,
+		CreatedAt:          queue.CreatedAt,
+		UpdatedAt:          queue.UpdatedAt,
+	}
+
+	if calendar := queue.BusinessHours; calendar != nil {
+		dto.BusinessTimeZone = calendar.TimeZone
+		for _, hours := range calendar.Hours {
+			dto.BusinessHours = append(dto.BusinessHours, WorkingHoursRecord{
+				Weekday: int(hours.Weekday),
+				Start:   hours.Start,
+				End:     hours.End,
+			})
+		}
+		for _, holiday := range calendar.Holidays {
+			dto.Holidays = append(dto.Holidays, HolidayRecord{Date: holiday.Date, Name: holiday.Name})
+		}
+	}
+
+	if policy := queue.SLA; policy != nil {
+		dto.SLAAcceptSeconds = int64(policy.AcceptWithin / time.Second)
+		dto.SLACompleteSeconds = int64(policy.CompleteWithin / time.Second)
+		dto.SLAAtRiskPercent = policy.AtRiskPercent
+	}
+
+	if policy := queue.Expiry; policy != nil {
+		dto.ExpireAfterSeconds = int64(policy.After / time.Second)
+	}
+
+	if limits := queue.WIP; limits != nil {
+		dto.WIPPerMember = limits.PerMember
+		dto.WIPPerQueue = limits.PerQueue
+	}
+
+	if rotation := queue.OnCall; rotation != nil {
+		startsAt := rotation.StartsAt
+		dto.OnCallMemberIds = StringSlice(rotation.MemberIds)
+		dto.OnCallStartsAt = &startsAt
+		dto.OnCallShiftDays = rotation.ShiftDays
+		dto.OnCallTimeZone = rotation.TimeZone
+		for _, override := range rotation.Overrides {
+			dto.OnCallOverrides = append(dto.OnCallOverrides, OnCallOverrideRecord{
+				UserID: override.UserID,
+				Start:  override.Start,
+				End:    override.End,
+			})
+		}
+	}
+
+	for _, field := range queue.IntakeFields {
+		dto.IntakeFields = append(dto.IntakeFields, IntakeFieldRecord{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     string(field.Type),
+			Required: field.Required,
+			Options:  field.Options,
+		})
+	}
+
+	for _, template := range queue.Templates {
+		dto.Templates = append(dto.Templates, TemplateRecord{
+			Key:           template.Key,
+			Name:          template.Name,
+			Title:         template.Title,
+			Description:   template.Description,
+			RecipientType: string(template.RecipientType),
+			RecipientID:   template.RecipientID,
+		})
 	}
+
+	return dto
 }
 
 type QueuesWriter struct {
@@ -94,12 +229,25 @@ func NewQueuesWriter(db *gorm.DB) *QueuesWriter {
 
 func (w *QueuesWriter) Save(ctx context.Context, queue *domain.Queue) error {
 	dto := NewQueueDTO(queue)
-	if err := w.db.WithContext(ctx).Save(dto).Error; err != nil {
+	if err := w.db.WithContext(ctx).Omit("last_assignee_id").Save(dto).Error; err != nil {
 		return fmt.Errorf("failed to save queue: %w", err)
 	}
 	return nil
 }
 
+func (w *QueuesWriter) RecordLastAssignee(ctx context.Context, queueId, previousId, userId string) error {
+	result := w.db.WithContext(ctx).Model(&QueueDTO{}).
+		Where("id = ? AND COALESCE(last_assignee_id, '') = ?", queueId, previousId).
+		UpdateColumn("last_assignee_id", userId)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save last assignee: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAssignmentConflict
+	}
+	return nil
+}
+
 type QueuesReader struct {
 	db *gorm.DB
 }
@@ -147,7 +295,7 @@ func (r *QueuesReader) FindByChannelId(ctx context.Context, channelId string) ([
 
 func (r *QueuesReader) FindAll(ctx context.Context) ([]*domain.Queue, error) {
 	var dtos []QueueDTO
-	if err := r.db.WithContext(ctx).Find(&dtos).Error; err != nil {
+	if err := r.db.WithContext(ctx).Order("created_at, id").Find(&dtos).Error; err != nil {
 		return nil, fmt.Errorf("failed to find all queues: %w", err)
 	}
 
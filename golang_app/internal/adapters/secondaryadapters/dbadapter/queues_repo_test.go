@@ -5,6 +5,7 @@ package dbadapter_test
 
 import (
 	"context"
+	"errors"
 	"request/internal/adapters/secondaryadapters/dbadapter"
 	"request/internal/domain"
 	"testing"
@@ -43,9 +44,11 @@ func TestQueueWriter(t *testing.T) {
 	cleanupQueueIds = append(cleanupQueueIds, testId)
 
 	qw := dbadapter.NewQueuesWriter(db)
-	q := domain.NewQueue(testId, testName)
+	q := domain.NewQueue(testId, testName, testCreatedBy)
 	q.CreatedById = testCreatedBy
 	q.Description = "Test Description"
+	q.SetWIPLimits(&domain.WIPLimits{PerMember: 3, PerQueue: 10})
+	q.SetRoutingRules([]domain.RoutingRule{{ChannelID: "C-general", Match: "deploy", UserGroup: "platform"}})
 
 	err = qw.Save(context.Background(), &q)
 	if err != nil {
@@ -59,12 +62,55 @@ func TestQueueWriter(t *testing.T) {
 	AssertEquals(t, testName, qdto.Name)
 	AssertEquals(t, testCreatedBy, qdto.CreatedById)
 	AssertEquals(t, "Test Description", qdto.Description)
+	AssertEquals(t, 3, qdto.WIPPerMember)
+	AssertEquals(t, 10, qdto.WIPPerQueue)
+	AssertEquals(t, 1, len(qdto.RoutingRules))
+	AssertEquals(t, "deploy", qdto.RoutingRules[0].Match)
 
 	if qdto.CreatedAt.IsZero() || qdto.UpdatedAt.IsZero() {
 		t.Fatalf("Timestamps have not been set on the persisted queue")
 	}
 }
 
+func TestQueueWriterRecordLastAssignee(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, id := range cleanupQueueIds {
+			db.Delete(dbadapter.QueueDTO{ID: id})
+		}
+	})
+
+	testId := "test-round-robin-queue"
+	SeedQueues(t, db, []*dbadapter.QueueDTO{{ID: testId, Name: "Round robin", CreatedById: "creator-id"}})
+	db.Exec("UPDATE queues SET last_assignee_id = NULL WHERE id = ?", testId)
+
+	qw := dbadapter.NewQueuesWriter(db)
+
+	if err := qw.RecordLastAssignee(context.Background(), testId, "", "alice"); err != nil {
+		t.Fatalf("Failed to record the first assignee: %v", err)
+	}
+	if err := qw.RecordLastAssignee(context.Background(), testId, "", "bob"); !errors.Is(err, domain.ErrAssignmentConflict) {
+		t.Fatalf("Expected ErrAssignmentConflict for a stale previous assignee, got %v", err)
+	}
+	if err := qw.RecordLastAssignee(context.Background(), testId, "alice", "bob"); err != nil {
+		t.Fatalf("Failed to record the next assignee: %v", err)
+	}
+
+	stale := &domain.Queue{ID: testId, Name: "Renamed", CreatedById: "creator-id", LastAssigneeID: "alice"}
+	if err := qw.Save(context.Background(), stale); err != nil {
+		t.Fatalf("Failed to save the queue: %v", err)
+	}
+
+	var qdto dbadapter.QueueDTO
+	db.First(&qdto, "id = ?", testId)
+
+	AssertEquals(t, "bob", qdto.LastAssigneeID)
+}
+
 func TestQueueReader(t *testing.T) {
 	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
 	if err != nil {
@@ -0,0 +1,197 @@
+package dbadapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type RecurringRequestDTO struct {
+	ID            string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Title         string `gorm:"not null;type:varchar;size:255"`
+	Description   string
+	RecipientID   string     `gorm:"not null"`
+	RecipientType string     `gorm:"not null"`
+	Schedule      string     `gorm:"not null;type:varchar;size:255"`
+	CreatedByID   string     `gorm:"not null;index"`
+	Paused        bool       `gorm:"not null;default:false;index:idx_recurring_requests_paused_next_run_at"`
+	NextRunAt     *time.Time `gorm:"index:idx_recurring_requests_paused_next_run_at"`
+	LastRunAt     *time.Time
+	LastError     string
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+}
+
+func (RecurringRequestDTO) TableName() string {
+	return "recurring_requests"
+}
+
+func (dto *RecurringRequestDTO) ToDomain() *domain.RecurringRequest {
+	recurring := &domain.RecurringRequest{
+		ID:          dto.ID,
+		Title:       dto.Title,
+		Description: dto.Description,
+		Recipient: &domain.RequestRecipient{
+			ID:   dto.RecipientID,
+			Type: domain.RequestRecipientType(dto.RecipientType),
+		},
+		Schedule:    dto.Schedule,
+		CreatedByID: dto.CreatedByID,
+		Paused:      dto.Paused,
+		LastError:   dto.LastError,
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}
+
+	if dto.NextRunAt != nil {
+		recurring.NextRunAt = *dto.NextRunAt
+	}
+
+	if dto.LastRunAt != nil {
+		recurring.LastRunAt = *dto.LastRunAt
+	}
+
+	return recurring
+}
+
+func NewRecurringRequestDTO(recurring *domain.RecurringRequest) *RecurringRequestDTO {
+	dto := &RecurringRequestDTO{
+		ID:            recurring.ID,
+		Title:         recurring.Title,
+		Description:   recurring.Description,
+		RecipientID:   recurring.Recipient.ID,
+		RecipientType: string(recurring.Recipient.Type),
+		Schedule:      recurring.Schedule,
+		CreatedByID:   recurring.CreatedByID,
+		Paused:        recurring.Paused,
+		LastError:     recurring.LastError,
+		CreatedAt:     recurring.CreatedAt,
+		UpdatedAt:     recurring.UpdatedAt,
+	}
+
+	if !recurring.NextRunAt.IsZero() {
+		nextRunAt := recurring.NextRunAt.UTC()
+		dto.NextRunAt = &nextRunAt
+	}
+
+	if !recurring.LastRunAt.IsZero() {
+		lastRunAt := recurring.LastRunAt.UTC()
+		dto.LastRunAt = &lastRunAt
+	}
+
+	return dto
+}
+
+type RecurringRequestsWriter struct {
+	db *gorm.DB
+}
+
+func NewRecurringRequestsWriter(db *gorm.DB) *RecurringRequestsWriter {
+	return &RecurringRequestsWriter{db: db}
+}
+
+func (w *RecurringRequestsWriter) Save(ctx context.Context, recurring *domain.RecurringRequest) error {
+	dto := NewRecurringRequestDTO(recurring)
+	if err := w.db.WithContext(ctx).Save(dto).Error; err != nil {
+		return fmt.Errorf("failed to save recurring request: %w", err)
+	}
+	return nil
+}
+
+func (w *RecurringRequestsWriter) ClaimOccurrence(ctx context.Context, recurring *domain.RecurringRequest, occurrence time.Time) error {
+	dto := NewRecurringRequestDTO(recurring)
+	result := w.db.WithContext(ctx).Model(&RecurringRequestDTO{}).
+		Where("id = ? AND paused = ? AND next_run_at = ?", recurring.ID, false, occurrence.UTC()).
+		UpdateColumns(map[string]interface{}{
+			"next_run_at": dto.NextRunAt,
+			"last_run_at": dto.LastRunAt,
+			"last_error":  dto.LastError,
+			"updated_at":  dto.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to advance recurring request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrOccurrenceClaimed
+	}
+	return nil
+}
+
+func (w *RecurringRequestsWriter) RecordLastError(ctx context.Context, recurringId, lastError string) error {
+	result := w.db.WithContext(ctx).Model(&RecurringRequestDTO{}).Where("id = ?", recurringId).
+		UpdateColumn("last_error", lastError)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save recurring request error: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("recurring request not found: %s", recurringId)
+	}
+	return nil
+}
+
+func (w *RecurringRequestsWriter) Delete(ctx context.Context, recurringId string) error {
+	if err := w.db.WithContext(ctx).Delete(&RecurringRequestDTO{ID: recurringId}).Error; err != nil {
+		return fmt.Errorf("failed to delete recurring request: %w", err)
+	}
+	return nil
+}
+
+type RecurringRequestsReader struct {
+	db *gorm.DB
+}
+
+func NewRecurringRequestsReader(db *gorm.DB) *RecurringRequestsReader {
+	return &RecurringRequestsReader{db: db}
+}
+
+func (r *RecurringRequestsReader) GetById(ctx context.Context, recurringId string) (*domain.RecurringRequest, error) {
+	var dto RecurringRequestDTO
+	if err := r.db.WithContext(ctx).First(&dto, "id = ?", recurringId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("recurring request not found: %s", recurringId)
+		}
+		return nil, fmt.Errorf("failed to get recurring request: %w", err)
+	}
+	return dto.ToDomain(), nil
+}
+
+func (r *RecurringRequestsReader) FindAll(ctx context.Context) ([]*domain.RecurringRequest, error) {
+	var dtos []RecurringRequestDTO
+	if err := r.db.WithContext(ctx).Order("created_at").Find(&dtos).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all recurring requests: %w", err)
+	}
+
+	recurring := make([]*domain.RecurringRequest, len(dtos))
+	for i, dto := range dtos {
+		recurring[i] = dto.ToDomain()
+	}
+	return recurring, nil
+}
+
+// FindDue returns running recurring requests whose next occurrence is at or
+// before the given time. Times are compared in UTC, as they are stored.
+func (r *RecurringRequestsReader) FindDue(ctx context.Context, at time.Time) ([]*domain.RecurringRequest, error) {
+	var dtos []RecurringRequestDTO
+	err := r.db.WithContext(ctx).
+		Where("paused = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", false, at.UTC()).
+		Order("next_run_at").
+		Find(&dtos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due recurring requests: %w", err)
+	}
+
+	recurring := make([]*domain.RecurringRequest, len(dtos))
+	for i, dto := range dtos {
+		recurring[i] = dto.ToDomain()
+	}
+	return recurring, nil
+}
+
+var _ secondaryports.ForStoringRecurringRequests = (*RecurringRequestsWriter)(nil)
+var _ secondaryports.ForReadingRecurringRequests = (*RecurringRequestsReader)(nil)
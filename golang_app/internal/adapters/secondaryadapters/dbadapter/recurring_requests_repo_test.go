@@ -0,0 +1,112 @@
+//go:build integration
+// +build integration
+
+package dbadapter_test
+
+import (
+	"context"
+	"errors"
+	"request/internal/adapters/secondaryadapters/dbadapter"
+	"request/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestRecurringRequestsRepo(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	testId := "test-recurring-id"
+	t.Cleanup(func() {
+		db.Delete(dbadapter.RecurringRequestDTO{ID: testId})
+	})
+
+	ctx := context.Background()
+	rw := dbadapter.NewRecurringRequestsWriter(db)
+	rr := dbadapter.NewRecurringRequestsReader(db)
+
+	now := time.Now()
+	recipient := &domain.RequestRecipient{ID: "U123", Type: domain.RequestRecipientUser}
+	recurring, err := domain.NewRecurringRequest(testId, "Weekly check {{week}}", "", recipient, "@every 1m", "creator-id", now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to create a recurring request: %v", err)
+	}
+
+	if err := rw.Save(ctx, recurring); err != nil {
+		t.Fatalf("Failed to save recurring request: %v", err)
+	}
+
+	saved, err := rr.GetById(ctx, testId)
+	if err != nil {
+		t.Fatalf("Failed to get recurring request: %v", err)
+	}
+	if saved.Title != recurring.Title || saved.Recipient.ID != "U123" || !saved.NextRunAt.Equal(recurring.NextRunAt) {
+		t.Errorf("Expected the saved recurring request to match, got %+v", saved)
+	}
+
+	isDue := func() bool {
+		due, err := rr.FindDue(ctx, now)
+		if err != nil {
+			t.Fatalf("Failed to find due recurring requests: %v", err)
+		}
+		for _, d := range due {
+			if d.ID == testId {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !isDue() {
+		t.Fatalf("Expected the recurring request to be due")
+	}
+
+	if err := recurring.Pause(now); err != nil {
+		t.Fatalf("Failed to pause: %v", err)
+	}
+	if err := rw.Save(ctx, recurring); err != nil {
+		t.Fatalf("Failed to save paused recurring request: %v", err)
+	}
+	if isDue() {
+		t.Errorf("Expected a paused recurring request not to be due")
+	}
+
+	if err := recurring.Resume(now); err != nil {
+		t.Fatalf("Failed to resume: %v", err)
+	}
+	if err := rw.Save(ctx, recurring); err != nil {
+		t.Fatalf("Failed to save resumed recurring request: %v", err)
+	}
+
+	occurrence := recurring.NextRunAt
+	recurring.Advance(occurrence, nil)
+	if err := rw.ClaimOccurrence(ctx, recurring, occurrence); err != nil {
+		t.Fatalf("Failed to claim the occurrence: %v", err)
+	}
+	if err := rw.ClaimOccurrence(ctx, recurring, occurrence); !errors.Is(err, domain.ErrOccurrenceClaimed) {
+		t.Fatalf("Expected ErrOccurrenceClaimed for an occurrence already claimed, got %v", err)
+	}
+	if err := rw.RecordLastError(ctx, testId, "queue not found"); err != nil {
+		t.Fatalf("Failed to record the last error: %v", err)
+	}
+
+	claimed, err := rr.GetById(ctx, testId)
+	if err != nil {
+		t.Fatalf("Failed to get recurring request: %v", err)
+	}
+	if !claimed.NextRunAt.Equal(recurring.NextRunAt) || !claimed.LastRunAt.Equal(occurrence) || claimed.LastError != "queue not found" {
+		t.Errorf("Expected the claimed occurrence to be recorded, got %+v", claimed)
+	}
+
+	if err := rw.Delete(ctx, testId); err != nil {
+		t.Fatalf("Failed to delete recurring request: %v", err)
+	}
+	if _, err := rr.GetById(ctx, testId); err == nil {
+		t.Errorf("Expected the recurring request to be deleted")
+	}
+}
@@ -9,21 +9,60 @@ import (
 	"request/internal/domain"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// Determines table structure and changes will generate migrations via atlas
+// Determines table structure and changes will generate migrations via atlas.
+// UpdatedAt is set by the domain only, so background saves such as SLA state
+// and reminders don't count as an update to the request.
 type RequestDTO struct {
-	ID              string    `gorm:"not null;primaryKey;type:varchar;size:50"`
-	Title           string    `gorm:"not null;type:varchar;size:255"`
-	Description     string    `gorm:"type:varchar;size:500"`
-	AcceptedByID    string    `gorm:"index"`
-	CreatedByID     string    `gorm:"not null;index"`
-	RecipientID     string    `gorm:"not null;index"`
-	RecipientType   string    `gorm:"not null"`
-	Status          string    `gorm:"not null;index"`
-	RejectionReason string    `gorm:"type:varchar;size:500"`
-	CreatedAt       time.Time `gorm:"not null"`
-	UpdatedAt       time.Time `gorm:"not null"`
+	ID                     string                         `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Title                  string                         `gorm:"not null;type:varchar;size:255"`
+	Description            string                         `gorm:"type:varchar;size:500"`
+	AcceptedByID           string                         `gorm:"index"`
+	CreatedByID            string                         `gorm:"not null;index"`
+	RecipientID            string                         `gorm:"not null;index"`
+	RecipientType          string                         `gorm:"not null"`
+	Status                 string                         `gorm:"not null;index"`
+	DeclinedByIDs          StringSlice                    `gorm:"type:json"`
+	Workflow               JSONList[WorkflowStatusRecord] `gorm:"type:json"`
+	WorkflowStatus         string
+	ApprovalChain          JSONList[ApprovalStageRecord] `gorm:"type:json"`
+	ApprovalStage          int                           `gorm:"not null;default:0"`
+	ApprovalStageStartedAt *time.Time
+	ApprovalEscalated      bool                            `gorm:"not null;default:false"`
+	Approvals              JSONList[ApprovalRecord]        `gorm:"type:json"`
+	ApprovalMessages       JSONList[ApprovalMessageRecord] `gorm:"type:json"`
+	DuplicateOfID          string                          `gorm:"index"`
+	DuplicateOf            *RequestDTO                     `gorm:"foreignKey:DuplicateOfID"`
+	FieldValues            JSONList[FieldValueRecord]      `gorm:"type:json"`
+	RejectionReason        string                          `gorm:"type:varchar;size:500"`
+	HoldQuestion           string                          `gorm:"type:varchar;size:500"`
+	HoldReply              string                          `gorm:"type:varchar;size:500"`
+	OnHoldSince            *time.Time
+	OnHoldSeconds          int64                      `gorm:"not null;default:0"`
+	HoldPeriods            JSONList[HoldPeriodRecord] `gorm:"type:json"`
+	HoldChannelID          string                     `gorm:"index:idx_requests_hold_message"`
+	HoldMessageTs          string                     `gorm:"index:idx_requests_hold_message"`
+	NotificationChannelID  string
+	NotificationMessageTs  string
+	SLAState               string
+	AcceptedAt             *time.Time
+	ResolvedAt             *time.Time
+	SentReminders          JSONList[SentReminderRecord] `gorm:"type:json"`
+	RemindersSnoozedUntil  *time.Time
+	ExpiryWarnedAt         *time.Time
+	RoutedFromChannelID    string
+	RoutingReason          string                        `gorm:"type:varchar;size:500"`
+	Transfers              JSONList[QueueTransferRecord] `gorm:"type:json"`
+	Collaborators          []RequestCollaboratorDTO      `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	Labels                 []RequestLabelDTO             `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	ChecklistItems         []RequestChecklistItemDTO     `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	Dependencies           []RequestDependencyDTO        `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	Watchers               []RequestWatcherDTO           `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	SLABreaches            []RequestSLABreachDTO         `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	CreatedAt              time.Time                     `gorm:"not null"`
+	UpdatedAt              time.Time                     `gorm:"not null;autoUpdateTime:false"`
 }
 
 // Used to set the table name by gorm + atlas
@@ -31,38 +70,406 @@ func (RequestDTO) TableName() string {
 	return "requests"
 }
 
+type RequestCollaboratorDTO struct {
+	RequestID string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	UserID    string `gorm:"not null;primaryKey;type:varchar;size:50;index"`
+}
+
+func (RequestCollaboratorDTO) TableName() string {
+	return "request_collaborators"
+}
+
+type RequestWatcherDTO struct {
+	RequestID string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	UserID    string `gorm:"not null;primaryKey;type:varchar;size:50;index"`
+}
+
+func (RequestWatcherDTO) TableName() string {
+	return "request_watchers"
+}
+
+type RequestSLABreachDTO struct {
+	RequestID  string    `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Target     string    `gorm:"not null;primaryKey;type:varchar;size:20"`
+	BreachedAt time.Time `gorm:"not null;index"`
+}
+
+func (RequestSLABreachDTO) TableName() string {
+	return "request_sla_breaches"
+}
+
+type RequestLabelDTO struct {
+	RequestID string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Label     string `gorm:"not null;primaryKey;type:varchar;size:50;index"`
+}
+
+func (RequestLabelDTO) TableName() string {
+	return "request_labels"
+}
+
+type RequestChecklistItemDTO struct {
+	RequestID string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	ID        string `gorm:"not null;primaryKey;type:varchar;size:50"`
+	Position  int    `gorm:"not null"`
+	Text      string `gorm:"not null;type:varchar;size:255"`
+	Required  bool   `gorm:"not null;default:false"`
+	Done      bool   `gorm:"not null;default:false"`
+	DoneByID  string
+	DoneAt    *time.Time
+}
+
+func (RequestChecklistItemDTO) TableName() string {
+	return "request_checklist_items"
+}
+
+type RequestDependencyDTO struct {
+	RequestID   string      `gorm:"not null;primaryKey;type:varchar;size:50"`
+	BlockedByID string      `gorm:"not null;primaryKey;type:varchar;size:50;index"`
+	BlockedBy   *RequestDTO `gorm:"foreignKey:BlockedByID;constraint:OnDelete:CASCADE"`
+}
+
+func (RequestDependencyDTO) TableName() string {
+	return "request_dependencies"
+}
+
 func (dto *RequestDTO) ToDomain() *domain.Request {
-	return &domain.Request{
-		ID:              dto.ID,
-		Title:           dto.Title,
-		Description:     dto.Description,
-		AcceptedByID:    dto.AcceptedByID,
-		CreatedByID:     dto.CreatedByID,
-		Recipient:       &domain.RequestRecipient{
+	request := &domain.Request{
+		ID:           dto.ID,
+		Title:        dto.Title,
+		Description:  dto.Description,
+		AcceptedByID: dto.AcceptedByID,
+		CreatedByID:  dto.CreatedByID,
+		Recipient: &domain.RequestRecipient{
 			ID:   dto.RecipientID,
 			Type: domain.RequestRecipientType(dto.RecipientType),
 		},
-		Status:          domain.RequestStatus(dto.Status),
-		RejectionReason: dto.RejectionReason,
-		CreatedAt:       dto.CreatedAt,
-		UpdatedAt:       dto.UpdatedAt,
+		Status:              domain.RequestStatus(dto.Status),
+		DeclinedByIDs:       []string(dto.DeclinedByIDs),
+		Workflow:            workflowFromRecords(dto.Workflow),
+		WorkflowStatus:      dto.WorkflowStatus,
+		ApprovalChain:       approvalChainFromRecords(dto.ApprovalChain),
+		ApprovalStage:       dto.ApprovalStage,
+		ApprovalEscalated:   dto.ApprovalEscalated,
+		DuplicateOfID:       dto.DuplicateOfID,
+		RejectionReason:     dto.RejectionReason,
+		HoldQuestion:        dto.HoldQuestion,
+		HoldReply:           dto.HoldReply,
+		OnHoldDuration:      time.Duration(dto.OnHoldSeconds) * time.Second,
+		SLAState:            domain.SLAState(dto.SLAState),
+		RoutedFromChannelID: dto.RoutedFromChannelID,
+		RoutingReason:       dto.RoutingReason,
+		CreatedAt:           dto.CreatedAt,
+		UpdatedAt:           dto.UpdatedAt,
+	}
+
+	if dto.OnHoldSince != nil {
+		request.OnHoldSince = *dto.OnHoldSince
+	}
+
+	if dto.ApprovalStageStartedAt != nil {
+		request.ApprovalStageStartedAt = *dto.ApprovalStageStartedAt
+	}
+
+	if dto.AcceptedAt != nil {
+		request.AcceptedAt = *dto.AcceptedAt
+	}
+
+	if dto.ResolvedAt != nil {
+		request.ResolvedAt = *dto.ResolvedAt
+	}
+
+	for _, hold := range dto.HoldPeriods {
+		request.HoldPeriods = append(request.HoldPeriods, domain.HoldPeriod{Start: hold.Start, End: hold.End})
+	}
+
+	if dto.RemindersSnoozedUntil != nil {
+		request.RemindersSnoozedUntil = *dto.RemindersSnoozedUntil
+	}
+
+	if dto.ExpiryWarnedAt != nil {
+		request.ExpiryWarnedAt = *dto.ExpiryWarnedAt
+	}
+
+	for _, transfer := range dto.Transfers {
+		request.Transfers = append(request.Transfers, domain.QueueTransfer{
+			FromQueueID:   transfer.FromQueueID,
+			FromQueueName: transfer.FromQueueName,
+			ToQueueID:     transfer.ToQueueID,
+			ToQueueName:   transfer.ToQueueName,
+			ToChannelID:   transfer.ToChannelID,
+			ByID:          transfer.ByID,
+			Reason:        transfer.Reason,
+			At:            transfer.At,
+		})
+	}
+
+	for _, sent := range dto.SentReminders {
+		request.SentReminders = append(request.SentReminders, domain.SentReminder{
+			RuleKey: sent.RuleKey,
+			Since:   sent.Since,
+			SentAt:  sent.SentAt,
+		})
+	}
+
+	for _, breach := range dto.SLABreaches {
+		request.SLABreaches = append(request.SLABreaches, domain.SLABreach{
+			Target:     domain.SLATarget(breach.Target),
+			BreachedAt: breach.BreachedAt.UTC(),
+		})
+	}
+
+	if dto.HoldChannelID != "" && dto.HoldMessageTs != "" {
+		request.HoldMessage = &domain.MessageRef{
+			ChannelID: dto.HoldChannelID,
+			Ts:        dto.HoldMessageTs,
+		}
+	}
+
+	if dto.NotificationChannelID != "" && dto.NotificationMessageTs != "" {
+		request.Notification = &domain.MessageRef{
+			ChannelID: dto.NotificationChannelID,
+			Ts:        dto.NotificationMessageTs,
+		}
+	}
+
+	for _, collaborator := range dto.Collaborators {
+		request.CollaboratorIDs = append(request.CollaboratorIDs, collaborator.UserID)
+	}
+
+	for _, label := range dto.Labels {
+		request.Labels = append(request.Labels, label.Label)
+	}
+
+	for _, watcher := range dto.Watchers {
+		request.WatcherIDs = append(request.WatcherIDs, watcher.UserID)
+	}
+
+	if dto.DuplicateOf != nil {
+		request.DuplicateOfTitle = dto.DuplicateOf.Title
+	}
+
+	for _, item := range dto.ChecklistItems {
+		checklistItem := domain.ChecklistItem{
+			ID:       item.ID,
+			Text:     item.Text,
+			Required: item.Required,
+			Done:     item.Done,
+			DoneByID: item.DoneByID,
+		}
+		if item.DoneAt != nil {
+			checklistItem.DoneAt = *item.DoneAt
+		}
+		request.Checklist = append(request.Checklist, checklistItem)
+	}
+
+	for _, dependency := range dto.Dependencies {
+		blocker := domain.Blocker{RequestID: dependency.BlockedByID}
+		if dependency.BlockedBy != nil {
+			blocker.Title = dependency.BlockedBy.Title
+			blocker.Status = domain.RequestStatus(dependency.BlockedBy.Status)
+		}
+		request.BlockedBy = append(request.BlockedBy, blocker)
+	}
+
+	for _, approval := range dto.Approvals {
+		request.Approvals = append(request.Approvals, domain.ApprovalDecision{
+			Stage:      approval.Stage,
+			ApproverID: approval.ApproverID,
+			Approved:   approval.Approved,
+			Comment:    approval.Comment,
+			DecidedAt:  approval.DecidedAt,
+		})
+	}
+
+	for _, message := range dto.ApprovalMessages {
+		request.ApprovalMessages = append(request.ApprovalMessages, domain.ApprovalMessage{
+			ApproverID: message.ApproverID,
+			Message:    domain.MessageRef{ChannelID: message.ChannelID, Ts: message.Ts},
+		})
+	}
+
+	for _, field := range dto.FieldValues {
+		request.Fields = append(request.Fields, domain.FieldValue{
+			Key:   field.Key,
+			Label: field.Label,
+			Type:  domain.IntakeFieldType(field.Type),
+			Value: field.Value,
+		})
 	}
+
+	return request
 }
 
 func NewRequestDTO(request *domain.Request) *RequestDTO {
-	return &RequestDTO{
-		ID:              request.ID,
-		Title:           request.Title,
-		Description:     request.Description,
-		AcceptedByID:    request.AcceptedByID,
-		CreatedByID:     request.CreatedByID,
-		RecipientID:     request.Recipient.ID,
-		RecipientType:   string(request.Recipient.Type),
-		Status:          string(request.Status),
-		RejectionReason: request.RejectionReason,
-		CreatedAt:       request.CreatedAt,
-		UpdatedAt:       request.UpdatedAt,
+	dto := &RequestDTO{
+		ID:                  request.ID,
+		Title:               request.Title,
+		Description:         request.Description,
+		AcceptedByID:        request.AcceptedByID,
+		CreatedByID:         request.CreatedByID,
+		RecipientID:         request.Recipient.ID,
+		RecipientType:       string(request.Recipient.Type),
+		Status:              string(request.Status),
+		DeclinedByIDs:       StringSlice(request.DeclinedByIDs),
+		Workflow:            newWorkflowRecords(request.Workflow),
+		WorkflowStatus:      request.WorkflowStatus,
+		ApprovalChain:       newApprovalChainRecords(request.ApprovalChain),
+		ApprovalStage:       request.ApprovalStage,
+		ApprovalEscalated:   request.ApprovalEscalated,
+		DuplicateOfID:       request.DuplicateOfID,
+		RejectionReason:     request.RejectionReason,
+		HoldQuestion:        request.HoldQuestion,
+		HoldReply:           request.HoldReply,
+		OnHoldSeconds:       int64(request.OnHoldDuration / time.Second),
+		SLAState:            string(request.SLAState),
+		RoutedFromChannelID: request.RoutedFromChannelID,
+		RoutingReason:       request.RoutingReason,
+		CreatedAt:           request.CreatedAt,
+		UpdatedAt:           request.UpdatedAt,
+	}
+
+	if !request.OnHoldSince.IsZero() {
+		onHoldSince := request.OnHoldSince
+		dto.OnHoldSince = &onHoldSince
+	}
+
+	if !request.ApprovalStageStartedAt.IsZero() {
+		stageStartedAt := request.ApprovalStageStartedAt
+		dto.ApprovalStageStartedAt = &stageStartedAt
+	}
+
+	if !request.AcceptedAt.IsZero() {
+		acceptedAt := request.AcceptedAt
+		dto.AcceptedAt = &acceptedAt
+	}
+
+	if !request.ResolvedAt.IsZero() {
+		resolvedAt := request.ResolvedAt.UTC()
+		dto.ResolvedAt = &resolvedAt
+	}
+
+	for _, hold := range request.HoldPeriods {
+		dto.HoldPeriods = append(dto.HoldPeriods, HoldPeriodRecord{Start: hold.Start, End: hold.End})
+	}
+
+	for _, transfer := range request.Transfers {
+		dto.Transfers = append(dto.Transfers, QueueTransferRecord{
+			FromQueueID:   transfer.FromQueueID,
+			FromQueueName: transfer.FromQueueName,
+			ToQueueID:     transfer.ToQueueID,
+			ToQueueName:   transfer.ToQueueName,
+			ToChannelID:   transfer.ToChannelID,
+			ByID:          transfer.ByID,
+			Reason:        transfer.Reason,
+			At:            transfer.At,
+		})
+	}
+
+	if !request.RemindersSnoozedUntil.IsZero() {
+		snoozedUntil := request.RemindersSnoozedUntil
+		dto.RemindersSnoozedUntil = &snoozedUntil
+	}
+
+	if !request.ExpiryWarnedAt.IsZero() {
+		expiryWarnedAt := request.ExpiryWarnedAt
+		dto.ExpiryWarnedAt = &expiryWarnedAt
+	}
+
+	if len(request.SentReminders) > 0 {
+		dto.SentReminders = newSentReminderRecords(request.SentReminders)
+	}
+
+	for _, breach := range request.SLABreaches {
+		dto.SLABreaches = append(dto.SLABreaches, RequestSLABreachDTO{
+			RequestID:  request.ID,
+			Target:     string(breach.Target),
+			BreachedAt: breach.BreachedAt.UTC(),
+		})
+	}
+
+	if request.HoldMessage != nil {
+		dto.HoldChannelID = request.HoldMessage.ChannelID
+		dto.HoldMessageTs = request.HoldMessage.Ts
+	}
+
+	if request.Notification != nil {
+		dto.NotificationChannelID = request.Notification.ChannelID
+		dto.NotificationMessageTs = request.Notification.Ts
+	}
+
+	for _, userId := range request.CollaboratorIDs {
+		dto.Collaborators = append(dto.Collaborators, RequestCollaboratorDTO{
+			RequestID: request.ID,
+			UserID:    userId,
+		})
+	}
+
+	for _, approval := range request.Approvals {
+		dto.Approvals = append(dto.Approvals, ApprovalRecord{
+			Stage:      approval.Stage,
+			ApproverID: approval.ApproverID,
+			Approved:   approval.Approved,
+			Comment:    approval.Comment,
+			DecidedAt:  approval.DecidedAt,
+		})
+	}
+
+	for _, message := range request.ApprovalMessages {
+		dto.ApprovalMessages = append(dto.ApprovalMessages, ApprovalMessageRecord{
+			ApproverID: message.ApproverID,
+			ChannelID:  message.Message.ChannelID,
+			Ts:         message.Message.Ts,
+		})
+	}
+
+	for _, field := range request.Fields {
+		dto.FieldValues = append(dto.FieldValues, FieldValueRecord{
+			Key:   field.Key,
+			Label: field.Label,
+			Type:  string(field.Type),
+			Value: field.Value,
+		})
+	}
+
+	for _, label := range request.Labels {
+		dto.Labels = append(dto.Labels, RequestLabelDTO{
+			RequestID: request.ID,
+			Label:     label,
+		})
+	}
+
+	for _, userId := range request.WatcherIDs {
+		dto.Watchers = append(dto.Watchers, RequestWatcherDTO{
+			RequestID: request.ID,
+			UserID:    userId,
+		})
 	}
+
+	for _, blocker := range request.BlockedBy {
+		dto.Dependencies = append(dto.Dependencies, RequestDependencyDTO{
+			RequestID:   request.ID,
+			BlockedByID: blocker.RequestID,
+		})
+	}
+
+	for i, item := range request.Checklist {
+		itemDTO := RequestChecklistItemDTO{
+			RequestID: request.ID,
+			ID:        item.ID,
+			Position:  i,
+			Text:      item.Text,
+			Required:  item.Required,
+			Done:      item.Done,
+			DoneByID:  item.DoneByID,
+		}
+		if !item.DoneAt.IsZero() {
+			doneAt := item.DoneAt
+			itemDTO.DoneAt = &doneAt
+		}
+		dto.ChecklistItems = append(dto.ChecklistItems, itemDTO)
+	}
+
+	return dto
 }
 
 type RequestsWriter struct {
@@ -75,8 +482,157 @@ func NewRequestsWriter(db *gorm.DB) *RequestsWriter {
 
 func (w *RequestsWriter) Save(ctx context.Context, request *domain.Request) error {
 	dto := NewRequestDTO(request)
-	if err := w.db.WithContext(ctx).Save(dto).Error; err != nil {
-		return fmt.Errorf("failed to save request: %w", err)
+
+	return w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("DuplicateOf", "Collaborators", "Labels", "ChecklistItems", "Dependencies", "Watchers", "SLABreaches").Save(dto).Error; err != nil {
+			return fmt.Errorf("failed to save request: %w", err)
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestCollaboratorDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request collaborators: %w", err)
+		}
+
+		if len(dto.Collaborators) > 0 {
+			if err := tx.Create(&dto.Collaborators).Error; err != nil {
+				return fmt.Errorf("failed to save request collaborators: %w", err)
+			}
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestLabelDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request labels: %w", err)
+		}
+
+		if len(dto.Labels) > 0 {
+			if err := tx.Create(&dto.Labels).Error; err != nil {
+				return fmt.Errorf("failed to save request labels: %w", err)
+			}
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestChecklistItemDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request checklist: %w", err)
+		}
+
+		if len(dto.ChecklistItems) > 0 {
+			if err := tx.Create(&dto.ChecklistItems).Error; err != nil {
+				return fmt.Errorf("failed to save request checklist: %w", err)
+			}
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestWatcherDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request watchers: %w", err)
+		}
+
+		if len(dto.Watchers) > 0 {
+			if err := tx.Create(&dto.Watchers).Error; err != nil {
+				return fmt.Errorf("failed to save request watchers: %w", err)
+			}
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestDependencyDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request dependencies: %w", err)
+		}
+
+		if len(dto.Dependencies) > 0 {
+			if err := tx.Omit("BlockedBy").Create(&dto.Dependencies).Error; err != nil {
+				return fmt.Errorf("failed to save request dependencies: %w", err)
+			}
+		}
+
+		if err := tx.Where("request_id = ?", dto.ID).Delete(&RequestSLABreachDTO{}).Error; err != nil {
+			return fmt.Errorf("failed to clear request SLA breaches: %w", err)
+		}
+
+		if len(dto.SLABreaches) > 0 {
+			if err := tx.Create(&dto.SLABreaches).Error; err != nil {
+				return fmt.Errorf("failed to save request SLA breaches: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (w *RequestsWriter) RecordSLAState(ctx context.Context, requestId string, state domain.SLAState, breaches []domain.SLABreach) error {
+	return w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RequestDTO{}).Where("id = ?", requestId).UpdateColumn("sla_state", string(state))
+		if result.Error != nil {
+			return fmt.Errorf("failed to save SLA state: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("request not found: %s", requestId)
+		}
+
+		if len(breaches) == 0 {
+			return nil
+		}
+
+		dtos := make([]RequestSLABreachDTO, len(breaches))
+		for i, breach := range breaches {
+			dtos[i] = RequestSLABreachDTO{
+				RequestID:  requestId,
+				Target:     string(breach.Target),
+				BreachedAt: breach.BreachedAt.UTC(),
+			}
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dtos).Error; err != nil {
+			return fmt.Errorf("failed to save request SLA breaches: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (w *RequestsWriter) RecordSentReminders(ctx context.Context, requestId string, sent []domain.SentReminder) error {
+	result := w.db.WithContext(ctx).Model(&RequestDTO{}).Where("id = ?", requestId).
+		UpdateColumn("sent_reminders", newSentReminderRecords(sent))
+	if result.Error != nil {
+		return fmt.Errorf("failed to save sent reminders: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("request not found: %s", requestId)
+	}
+	return nil
+}
+
+func (w *RequestsWriter) RecordRemindersSnoozed(ctx context.Context, requestId string, until time.Time) error {
+	result := w.db.WithContext(ctx).Model(&RequestDTO{}).Where("id = ?", requestId).
+		UpdateColumn("reminders_snoozed_until", until)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save reminder snooze: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("request not found: %s", requestId)
+	}
+	return nil
+}
+
+func (w *RequestsWriter) RecordExpiry(ctx context.Context, request *domain.Request, lastUpdatedAt time.Time) error {
+	result := w.db.WithContext(ctx).Model(&RequestDTO{}).
+		Where("id = ? AND status = ? AND updated_at = ?", request.ID, string(domain.RequestPending), lastUpdatedAt).
+		UpdateColumns(map[string]interface{}{
+			"status":      string(request.Status),
+			"resolved_at": request.ResolvedAt.UTC(),
+			"updated_at":  request.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to save expired request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrRequestTouched
+	}
+	return nil
+}
+
+func (w *RequestsWriter) RecordExpiryWarning(ctx context.Context, requestId string, at time.Time) error {
+	result := w.db.WithContext(ctx).Model(&RequestDTO{}).
+		Where("id = ? AND status = ?", requestId, string(domain.RequestPending)).
+		UpdateColumn("expiry_warned_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save expiry warning: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrRequestTouched
 	}
 	return nil
 }
@@ -89,9 +645,22 @@ func NewRequestsReader(db *gorm.DB) *RequestsReader {
 	return &RequestsReader{db: db}
 }
 
+func (r *RequestsReader) withAssociations(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Preload("Collaborators").
+		Preload("Labels").
+		Preload("ChecklistItems", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Dependencies.BlockedBy").
+		Preload("Watchers").
+		Preload("SLABreaches").
+		Preload("DuplicateOf")
+}
+
 func (r *RequestsReader) GetById(ctx context.Context, requestId string) (*domain.Request, error) {
 	var dto RequestDTO
-	if err := r.db.WithContext(ctx).First(&dto, "id = ?", requestId).Error; err != nil {
+	if err := r.withAssociations(ctx).First(&dto, "id = ?", requestId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("request not found: %s", requestId)
 		}
@@ -102,7 +671,7 @@ func (r *RequestsReader) GetById(ctx context.Context, requestId string) (*domain
 
 func (r *RequestsReader) FindByCreatedById(ctx context.Context, createdById string) ([]*domain.Request, error) {
 	var dtos []RequestDTO
-	if err := r.db.WithContext(ctx).Find(&dtos, "created_by_id = ?", createdById).Error; err != nil {
+	if err := r.withAssociations(ctx).Find(&dtos, "created_by_id = ?", createdById).Error; err != nil {
 		return nil, fmt.Errorf("failed to find requests by created_by_id: %w", err)
 	}
 
@@ -115,7 +684,7 @@ func (r *RequestsReader) FindByCreatedById(ctx context.Context, createdById stri
 
 func (r *RequestsReader) FindByAcceptedById(ctx context.Context, acceptedById string) ([]*domain.Request, error) {
 	var dtos []RequestDTO
-	if err := r.db.WithContext(ctx).Find(&dtos, "accepted_by_id = ?", acceptedById).Error; err != nil {
+	if err := r.withAssociations(ctx).Find(&dtos, "accepted_by_id = ?", acceptedById).Error; err != nil {
 		return nil, fmt.Errorf("failed to find requests by accepted_by_id: %w", err)
 	}
 
@@ -126,9 +695,26 @@ func (r *RequestsReader) FindByAcceptedById(ctx context.Context, acceptedById st
 	return requests, nil
 }
 
+func (r *RequestsReader) FindByCollaboratorId(ctx context.Context, userId string) ([]*domain.Request, error) {
+	var dtos []RequestDTO
+	collaborations := r.db.Model(&RequestCollaboratorDTO{}).Select("request_id").Where("user_id = ?", userId)
+	err := r.withAssociations(ctx).
+		Where("id IN (?)", collaborations).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find requests by collaborator: %w", err)
+	}
+
+	requests := make([]*domain.Request, len(dtos))
+	for i, dto := range dtos {
+		requests[i] = dto.ToDomain()
+	}
+	return requests, nil
+}
+
 func (r *RequestsReader) FindByRecipient(ctx context.Context, recipient domain.RequestRecipient) ([]*domain.Request, error) {
 	var dtos []RequestDTO
-	if err := r.db.WithContext(ctx).Find(&dtos, "recipient_id = ? AND recipient_type = ?", recipient.ID, string(recipient.Type)).Error; err != nil {
+	if err := r.withAssociations(ctx).Find(&dtos, "recipient_id = ? AND recipient_type = ?", recipient.ID, string(recipient.Type)).Error; err != nil {
 		return nil, fmt.Errorf("failed to find requests by recipient: %w", err)
 	}
 
@@ -139,22 +725,50 @@ func (r *RequestsReader) FindByRecipient(ctx context.Context, recipient domain.R
 	return requests, nil
 }
 
+func (r *RequestsReader) FindByHoldMessage(ctx context.Context, channelId, messageTs string) (*domain.Request, error) {
+	var dtos []RequestDTO
+	if err := r.withAssociations(ctx).Limit(1).Find(&dtos, "hold_channel_id = ? AND hold_message_ts = ?", channelId, messageTs).Error; err != nil {
+		return nil, fmt.Errorf("failed to find request by hold message: %w", err)
+	}
+
+	if len(dtos) == 0 {
+		return nil, nil
+	}
+	return dtos[0].ToDomain(), nil
+}
+
+func (r *RequestsReader) FindByStatuses(ctx context.Context, statuses []domain.RequestStatus) ([]*domain.Request, error) {
+	var dtos []RequestDTO
+
+	if err := r.withAssociations(ctx).Where("status IN ?", statusStrings(statuses)).Find(&dtos).Error; err != nil {
+		return nil, fmt.Errorf("failed to find requests by statuses: %w", err)
+	}
+
+	requests := make([]*domain.Request, len(dtos))
+	for i, dto := range dtos {
+		requests[i] = dto.ToDomain()
+	}
+	return requests, nil
+}
+
 func (r *RequestsReader) FindByRecipientAndStatuses(
 	ctx context.Context,
 	recipientId string,
 	recipientType domain.RequestRecipientType,
 	statuses []domain.RequestStatus,
+	labels []string,
 ) ([]*domain.Request, error) {
 	var dtos []RequestDTO
 
-	query := r.db.WithContext(ctx).Where("recipient_id = ? AND recipient_type = ?", recipientId, string(recipientType))
+	query := r.withAssociations(ctx).Where("recipient_id = ? AND recipient_type = ?", recipientId, string(recipientType))
 
 	if len(statuses) > 0 {
-		statusStrings := make([]string, len(statuses))
-		for i, status := range statuses {
-			statusStrings[i] = string(status)
-		}
-		query = query.Where("status IN ?", statusStrings)
+		query = query.Where("status IN ?", statusStrings(statuses))
+	}
+
+	for _, label := range labels {
+		labelled := r.db.Model(&RequestLabelDTO{}).Select("request_id").Where("label = ?", label)
+		query = query.Where("id IN (?)", labelled)
 	}
 
 	if err := query.Find(&dtos).Error; err != nil {
@@ -168,5 +782,142 @@ func (r *RequestsReader) FindByRecipientAndStatuses(
 	return requests, nil
 }
 
+func (r *RequestsReader) CountLabelsByRecipient(
+	ctx context.Context,
+	recipientId string,
+	recipientType domain.RequestRecipientType,
+	statuses []domain.RequestStatus,
+) (map[string]int, error) {
+	var rows []struct {
+		Label string
+		Count int
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&RequestLabelDTO{}).
+		Select("request_labels.label AS label, COUNT(*) AS count").
+		Joins("JOIN requests ON requests.id = request_labels.request_id").
+		Where("requests.recipient_id = ? AND requests.recipient_type = ?", recipientId, string(recipientType))
+
+	if len(statuses) > 0 {
+		query = query.Where("requests.status IN ?", statusStrings(statuses))
+	}
+
+	if err := query.Group("request_labels.label").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count labels by recipient: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Label] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *RequestsReader) FindByRecipientResolvedSince(
+	ctx context.Context,
+	recipientId string,
+	recipientType domain.RequestRecipientType,
+	since time.Time,
+) ([]*domain.Request, error) {
+	var dtos []RequestDTO
+
+	err := r.withAssociations(ctx).
+		Where("recipient_id = ? AND recipient_type = ? AND resolved_at >= ?", recipientId, string(recipientType), since.UTC()).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recently resolved requests: %w", err)
+	}
+
+	requests := make([]*domain.Request, len(dtos))
+	for i, dto := range dtos {
+		requests[i] = dto.ToDomain()
+	}
+	return requests, nil
+}
+
+func (r *RequestsReader) CountSLABreachesByRecipient(
+	ctx context.Context,
+	recipientId string,
+	recipientType domain.RequestRecipientType,
+	since time.Time,
+) (map[domain.SLATarget]int, error) {
+	var rows []struct {
+		Target string
+		Count  int
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&RequestSLABreachDTO{}).
+		Select("request_sla_breaches.target AS target, COUNT(*) AS count").
+		Joins("JOIN requests ON requests.id = request_sla_breaches.request_id").
+		Where("requests.recipient_id = ? AND requests.recipient_type = ?", recipientId, string(recipientType)).
+		Where("request_sla_breaches.breached_at >= ?", since.UTC()).
+		Group("request_sla_breaches.target").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count SLA breaches by recipient: %w", err)
+	}
+
+	counts := make(map[domain.SLATarget]int, len(rows))
+	for _, row := range rows {
+		counts[domain.SLATarget(row.Target)] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *RequestsReader) FindBlockedBy(ctx context.Context, blockerId string) ([]*domain.Request, error) {
+	var dtos []RequestDTO
+	dependents := r.db.Model(&RequestDependencyDTO{}).Select("request_id").Where("blocked_by_id = ?", blockerId)
+	err := r.withAssociations(ctx).
+		Where("id IN (?)", dependents).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find requests blocked by %s: %w", blockerId, err)
+	}
+
+	requests := make([]*domain.Request, len(dtos))
+	for i, dto := range dtos {
+		requests[i] = dto.ToDomain()
+	}
+	return requests, nil
+}
+
+// LoadDependencyGraph loads the dependencies reachable from the given
+// requests, which is all a cycle check starting from them needs.
+func (r *RequestsReader) LoadDependencyGraph(ctx context.Context, requestIds []string) (domain.DependencyGraph, error) {
+	graph := domain.DependencyGraph{}
+	if len(requestIds) == 0 {
+		return graph, nil
+	}
+
+	var dependencies []RequestDependencyDTO
+	err := r.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE reachable(id) AS (
+			SELECT id FROM requests WHERE id IN ?
+			UNION
+			SELECT request_dependencies.blocked_by_id FROM request_dependencies
+			JOIN reachable ON request_dependencies.request_id = reachable.id
+		)
+		SELECT * FROM request_dependencies WHERE request_id IN (SELECT id FROM reachable)`, requestIds).
+		Scan(&dependencies).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load request dependencies: %w", err)
+	}
+
+	for _, dependency := range dependencies {
+		graph[dependency.RequestID] = append(graph[dependency.RequestID], dependency.BlockedByID)
+	}
+	return graph, nil
+}
+
+func statusStrings(statuses []domain.RequestStatus) []string {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return values
+}
+
 var _ secondaryports.ForStoringRequests = (*RequestsWriter)(nil)
 var _ secondaryports.ForReadingRequests = (*RequestsReader)(nil)
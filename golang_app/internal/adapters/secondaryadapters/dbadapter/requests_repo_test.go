@@ -5,9 +5,11 @@ package dbadapter_test
 
 import (
 	"context"
+	"errors"
 	"request/internal/adapters/secondaryadapters/dbadapter"
 	"request/internal/domain"
 	"testing"
+	"time"
 
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
@@ -79,6 +81,200 @@ func TestRequestWriter(t *testing.T) {
 	}
 }
 
+func TestRequestWriterRecordSLAState(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	testId := "test-sla-state"
+	t.Cleanup(func() {
+		db.Where("request_id = ?", testId).Delete(&dbadapter.RequestSLABreachDTO{})
+		db.Delete(dbadapter.RequestDTO{ID: testId})
+	})
+
+	SeedRequests(t, db, []*dbadapter.RequestDTO{
+		{ID: testId, Title: "SLA", CreatedByID: "tests", AcceptedByID: "acceptor", RecipientID: "test-r", RecipientType: "queue", Status: "accepted"},
+	})
+
+	rw := dbadapter.NewRequestsWriter(db)
+	breach := domain.SLABreach{Target: domain.SLATargetAccept, BreachedAt: time.Now()}
+	for i := 0; i < 2; i++ {
+		if err := rw.RecordSLAState(context.Background(), testId, domain.SLABreached, []domain.SLABreach{breach}); err != nil {
+			t.Fatalf("Failed to record SLA state: %v", err)
+		}
+	}
+
+	var rdto dbadapter.RequestDTO
+	db.Preload("SLABreaches").First(&rdto, "id = ?", testId)
+
+	AssertEquals(t, string(domain.SLABreached), rdto.SLAState)
+	AssertEquals(t, "accepted", rdto.Status)
+	AssertEquals(t, "acceptor", rdto.AcceptedByID)
+	AssertEquals(t, 1, len(rdto.SLABreaches))
+}
+
+func TestRequestWriterRecordSentReminders(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	testId := "test-sent-reminders"
+	t.Cleanup(func() {
+		db.Delete(dbadapter.RequestDTO{ID: testId})
+	})
+
+	SeedRequests(t, db, []*dbadapter.RequestDTO{
+		{ID: testId, Title: "Reminders", CreatedByID: "tests", AcceptedByID: "acceptor", RecipientID: "test-r", RecipientType: "queue", Status: "accepted"},
+	})
+
+	rw := dbadapter.NewRequestsWriter(db)
+	sent := []domain.SentReminder{{RuleKey: "no_update:3600:assignee", Since: time.Now().Add(-time.Hour), SentAt: time.Now()}}
+	if err := rw.RecordSentReminders(context.Background(), testId, sent); err != nil {
+		t.Fatalf("Failed to record sent reminders: %v", err)
+	}
+
+	var rdto dbadapter.RequestDTO
+	db.First(&rdto, "id = ?", testId)
+
+	AssertEquals(t, "accepted", rdto.Status)
+	AssertEquals(t, "acceptor", rdto.AcceptedByID)
+	AssertEquals(t, 1, len(rdto.SentReminders))
+	AssertEquals(t, "no_update:3600:assignee", rdto.SentReminders[0].RuleKey)
+}
+
+func TestRequestWriterRecordRemindersSnoozed(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	testId := "test-snoozed-reminders"
+	t.Cleanup(func() {
+		db.Delete(dbadapter.RequestDTO{ID: testId})
+	})
+
+	SeedRequests(t, db, []*dbadapter.RequestDTO{
+		{ID: testId, Title: "Snoozed", CreatedByID: "tests", AcceptedByID: "acceptor", RecipientID: "test-r", RecipientType: "queue", Status: "accepted"},
+	})
+
+	rw := dbadapter.NewRequestsWriter(db)
+	until := time.Now().Add(domain.ReminderSnoozeDuration)
+	if err := rw.RecordRemindersSnoozed(context.Background(), testId, until); err != nil {
+		t.Fatalf("Failed to record the snooze: %v", err)
+	}
+
+	var rdto dbadapter.RequestDTO
+	db.First(&rdto, "id = ?", testId)
+
+	AssertEquals(t, "accepted", rdto.Status)
+	AssertEquals(t, "acceptor", rdto.AcceptedByID)
+	AssertEquals(t, true, rdto.RemindersSnoozedUntil != nil && rdto.RemindersSnoozedUntil.Equal(until))
+}
+
+func TestRequestWriterRecordExpiry(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	untouchedId, touchedId := "test-expiry-untouched", "test-expiry-touched"
+	t.Cleanup(func() {
+		db.Delete(dbadapter.RequestDTO{ID: untouchedId})
+		db.Delete(dbadapter.RequestDTO{ID: touchedId})
+	})
+
+	updatedAt := time.Now().Add(-10 * 24 * time.Hour)
+	SeedRequests(t, db, []*dbadapter.RequestDTO{
+		{ID: untouchedId, Title: "Untouched", CreatedByID: "tests", RecipientID: "test-r", RecipientType: "queue", Status: "pending", UpdatedAt: updatedAt},
+		{ID: touchedId, Title: "Touched", CreatedByID: "tests", RecipientID: "test-r", RecipientType: "queue", Status: "pending", UpdatedAt: updatedAt},
+	})
+
+	rr := dbadapter.NewRequestsReader(db)
+	rw := dbadapter.NewRequestsWriter(db)
+
+	t.Run("should expire a request nobody touched since it was read", func(t *testing.T) {
+		request, err := rr.GetById(context.Background(), untouchedId)
+		if err != nil {
+			t.Fatalf("Failed to load request: %v", err)
+		}
+
+		lastUpdatedAt := request.UpdatedAt
+		if err := request.Expire(time.Now()); err != nil {
+			t.Fatalf("Failed to expire request: %v", err)
+		}
+		if err := rw.RecordExpiry(context.Background(), request, lastUpdatedAt); err != nil {
+			t.Fatalf("Failed to record expiry: %v", err)
+		}
+
+		var rdto dbadapter.RequestDTO
+		db.First(&rdto, "id = ?", untouchedId)
+		AssertEquals(t, "expired", rdto.Status)
+	})
+
+	t.Run("should not expire a request that had activity since it was read", func(t *testing.T) {
+		request, err := rr.GetById(context.Background(), touchedId)
+		if err != nil {
+			t.Fatalf("Failed to load request: %v", err)
+		}
+
+		db.Model(&dbadapter.RequestDTO{}).Where("id = ?", touchedId).
+			Updates(map[string]interface{}{"status": "accepted", "accepted_by_id": "acceptor", "updated_at": time.Now()})
+
+		lastUpdatedAt := request.UpdatedAt
+		if err := request.Expire(time.Now()); err != nil {
+			t.Fatalf("Failed to expire request: %v", err)
+		}
+		if err := rw.RecordExpiry(context.Background(), request, lastUpdatedAt); !errors.Is(err, domain.ErrRequestTouched) {
+			t.Fatalf("Expected ErrRequestTouched, got %v", err)
+		}
+		if err := rw.RecordExpiryWarning(context.Background(), touchedId, time.Now()); !errors.Is(err, domain.ErrRequestTouched) {
+			t.Fatalf("Expected ErrRequestTouched for the warning, got %v", err)
+		}
+
+		var rdto dbadapter.RequestDTO
+		db.First(&rdto, "id = ?", touchedId)
+		AssertEquals(t, "accepted", rdto.Status)
+		AssertEquals(t, "acceptor", rdto.AcceptedByID)
+	})
+}
+
+func TestRequestReaderLoadDependencyGraph(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
+	if err != nil {
+		t.Fatalf("Failed to initialise db connection: %v", err)
+	}
+
+	ids := []string{"test-graph-a", "test-graph-b", "test-graph-c", "test-graph-d"}
+	t.Cleanup(func() {
+		for _, id := range ids {
+			db.Delete(dbadapter.RequestDTO{ID: id})
+		}
+	})
+
+	seeded := make([]*dbadapter.RequestDTO, len(ids))
+	for i, id := range ids {
+		seeded[i] = &dbadapter.RequestDTO{ID: id, Title: id, CreatedByID: "tests", RecipientID: "test-r", RecipientType: "user", Status: "pending"}
+	}
+	SeedRequests(t, db, seeded)
+	db.Create(&[]dbadapter.RequestDependencyDTO{
+		{RequestID: "test-graph-b", BlockedByID: "test-graph-a"},
+		{RequestID: "test-graph-c", BlockedByID: "test-graph-b"},
+		{RequestID: "test-graph-d", BlockedByID: "test-graph-c"},
+	})
+
+	rr := dbadapter.NewRequestsReader(db)
+	graph, err := rr.LoadDependencyGraph(context.Background(), []string{"test-graph-c"})
+	if err != nil {
+		t.Fatalf("Failed to load dependency graph: %v", err)
+	}
+
+	AssertEquals(t, 2, len(graph))
+	AssertEquals(t, "test-graph-b", graph["test-graph-c"][0])
+	AssertEquals(t, "test-graph-a", graph["test-graph-b"][0])
+}
+
 func TestRequestReader(t *testing.T) {
 	db, err := gorm.Open(sqlite.Open("../../../db/dev.db"))
 	if err != nil {
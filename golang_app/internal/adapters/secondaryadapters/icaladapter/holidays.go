@@ -0,0 +1,125 @@
+package icaladapter
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"request/internal/domain"
+)
+
+const maxHolidayDays = 31
+
+// ParseHolidays reads the events of an iCalendar file as whole-day holidays.
+// Multi-day events become one holiday per day, DTEND being exclusive.
+func ParseHolidays(r io.Reader) ([]domain.Holiday, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+
+	holidays := []domain.Holiday{}
+	inEvent := false
+	var summary, start, end string
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		property, _, _ := strings.Cut(name, ";")
+		switch strings.ToUpper(property) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent = true
+				summary, start, end = "", "", ""
+			}
+		case "END":
+			if strings.EqualFold(value, "VEVENT") && inEvent {
+				inEvent = false
+
+				days, err := eventDays(start, end)
+				if err != nil {
+					return nil, fmt.Errorf("invalid event %q: %w", summary, err)
+				}
+				for _, day := range days {
+					holidays = append(holidays, domain.NewHoliday(day, summary))
+				}
+			}
+		case "SUMMARY":
+			summary = unescapeText(value)
+		case "DTSTART":
+			start = value
+		case "DTEND":
+			end = value
+		}
+	}
+
+	if len(holidays) == 0 {
+		return nil, errors.New("no events found in calendar")
+	}
+
+	slices.SortStableFunc(holidays, func(a, b domain.Holiday) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+	holidays = slices.CompactFunc(holidays, func(a, b domain.Holiday) bool {
+		return a.Date == b.Date
+	})
+
+	return holidays, nil
+}
+
+func unfoldLines(r io.Reader) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func parseDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+func eventDays(startValue, endValue string) ([]time.Time, error) {
+	start, err := parseDate(startValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if endValue == "" {
+		return []time.Time{start}, nil
+	}
+
+	end, err := parseDate(endValue)
+	if err != nil {
+		return nil, err
+	}
+
+	days := []time.Time{start}
+	for day := start.AddDate(0, 0, 1); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if len(days) == maxHolidayDays {
+			return nil, fmt.Errorf("events can last at most %d days", maxHolidayDays)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,67 @@
+package icaladapter_test
+
+import (
+	"strings"
+	"testing"
+
+	"request/internal/adapters/secondaryadapters/icaladapter"
+	"request/internal/domain"
+)
+
+const bankHolidays = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTEND;VALUE=DATE:20251226\r\n" +
+	"SUMMARY:Christmas Day\r\n" +
+	"DTSTART;VALUE=DATE:20251225\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20250825\r\n" +
+	"SUMMARY:Summer bank holiday\\, England\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20251229T000000Z\r\n" +
+	"DTEND:20251231T000000Z\r\n" +
+	"SUMMARY:Office closed between Christmas and New Ye\r\n" +
+	" ar\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseHolidays(t *testing.T) {
+	t.Run("should read every day of every event in date order", func(t *testing.T) {
+		holidays, err := icaladapter.ParseHolidays(strings.NewReader(bankHolidays))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []domain.Holiday{
+			{Date: "2025-08-25", Name: "Summer bank holiday, England"},
+			{Date: "2025-12-25", Name: "Christmas Day"},
+			{Date: "2025-12-29", Name: "Office closed between Christmas and New Year"},
+			{Date: "2025-12-30", Name: "Office closed between Christmas and New Year"},
+		}
+
+		if len(holidays) != len(expected) {
+			t.Fatalf("Expected %d holidays, got %v", len(expected), holidays)
+		}
+		for i := range expected {
+			if holidays[i] != expected[i] {
+				t.Errorf("Expected %+v, got %+v", expected[i], holidays[i])
+			}
+		}
+	})
+
+	t.Run("should reject calendars without events", func(t *testing.T) {
+		if _, err := icaladapter.ParseHolidays(strings.NewReader("BEGIN:VCALENDAR\nEND:VCALENDAR\n")); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("should reject events without a valid start", func(t *testing.T) {
+		calendar := "BEGIN:VEVENT\nSUMMARY:Broken\nDTSTART:2025\nEND:VEVENT\n"
+
+		if _, err := icaladapter.ParseHolidays(strings.NewReader(calendar)); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
@@ -68,6 +68,97 @@ func (b *BlockBuilder) ChannelSelect(blockId, label, placeholder string, actionI
 	)
 }
 
+func (b *BlockBuilder) Option(value, text string) *slack.OptionBlockObject {
+	return slack.NewOptionBlockObject(
+		value,
+		slack.NewTextBlockObject(slack.PlainTextType, text, NO_EMOJI, NOT_VERBATIM),
+		nil,
+	)
+}
+
+func (b *BlockBuilder) StaticSelect(blockId, label, placeholder string, actionId string, options []*slack.OptionBlockObject) *slack.InputBlock {
+	element := slack.NewOptionsSelectBlockElement(
+		slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, placeholder, NO_EMOJI, NOT_VERBATIM),
+		actionId,
+		options...,
+	)
+
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		element,
+	)
+}
+
+func (b *BlockBuilder) MultiStaticSelect(blockId, label, placeholder string, actionId string, options []*slack.OptionBlockObject) *slack.InputBlock {
+	element := slack.NewOptionsMultiSelectBlockElement(
+		slack.MultiOptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, placeholder, NO_EMOJI, NOT_VERBATIM),
+		actionId,
+		options...,
+	)
+
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		element,
+	)
+}
+
+func (b *BlockBuilder) NumberInput(blockId, label, placeholder string, actionId string) *slack.InputBlock {
+	element := slack.NewNumberInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, placeholder, NO_EMOJI, NOT_VERBATIM),
+		actionId,
+		true,
+	)
+
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		element,
+	)
+}
+
+func (b *BlockBuilder) DatePicker(blockId, label string, actionId string) *slack.InputBlock {
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		slack.NewDatePickerBlockElement(actionId),
+	)
+}
+
+func (b *BlockBuilder) TimePicker(blockId, label string, actionId string) *slack.InputBlock {
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		slack.NewTimePickerBlockElement(actionId),
+	)
+}
+
+func (b *BlockBuilder) DateTimePicker(blockId, label string, actionId string) *slack.InputBlock {
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		slack.NewDateTimePickerBlockElement(actionId),
+	)
+}
+
+func (b *BlockBuilder) FileInput(blockId, label string, actionId string, fileTypes ...string) *slack.InputBlock {
+	return slack.NewInputBlock(
+		blockId,
+		slack.NewTextBlockObject(slack.PlainTextType, label, NO_EMOJI, NOT_VERBATIM),
+		nil,
+		slack.NewFileInputBlockElement(actionId).WithFileTypes(fileTypes...).WithMaxFiles(1),
+	)
+}
+
 func (b *BlockBuilder) Section(text string) *slack.SectionBlock {
 	return slack.NewSectionBlock(
 		slack.NewTextBlockObject(slack.MarkdownType, text, NO_EMOJI, NOT_VERBATIM),
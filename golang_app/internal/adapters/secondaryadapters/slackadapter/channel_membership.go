@@ -0,0 +1,76 @@
+package slackadapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"request/internal/app/ports/secondaryports"
+
+	"github.com/slack-go/slack"
+)
+
+type cachedMembers struct {
+	userIds   map[string]bool
+	fetchedAt time.Time
+}
+
+type SlackChannelMembership struct {
+	client *slack.Client
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedMembers
+}
+
+var _ secondaryports.ForCheckingChannelMembership = (*SlackChannelMembership)(nil)
+
+func NewSlackChannelMembership(client *slack.Client, ttl time.Duration) *SlackChannelMembership {
+	return &SlackChannelMembership{
+		client: client,
+		ttl:    ttl,
+		cache:  map[string]cachedMembers{},
+	}
+}
+
+func (m *SlackChannelMembership) IsChannelMember(ctx context.Context, channelId, userId string) (bool, error) {
+	m.mu.Lock()
+	cached, ok := m.cache[channelId]
+	m.mu.Unlock()
+
+	if !ok || time.Since(cached.fetchedAt) > m.ttl {
+		userIds, err := m.fetchMembers(ctx, channelId)
+		if err != nil {
+			return false, err
+		}
+
+		cached = cachedMembers{userIds: userIds, fetchedAt: time.Now()}
+		m.mu.Lock()
+		m.cache[channelId] = cached
+		m.mu.Unlock()
+	}
+
+	return cached.userIds[userId], nil
+}
+
+func (m *SlackChannelMembership) fetchMembers(ctx context.Context, channelId string) (map[string]bool, error) {
+	userIds := map[string]bool{}
+	params := &slack.GetUsersInConversationParameters{ChannelID: channelId, Limit: 1000}
+
+	for {
+		members, cursor, err := m.client.GetUsersInConversationContext(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get channel members: %w", err)
+		}
+
+		for _, userId := range members {
+			userIds[userId] = true
+		}
+
+		if cursor == "" {
+			return userIds, nil
+		}
+		params.Cursor = cursor
+	}
+}
@@ -0,0 +1,42 @@
+package slackadapter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"request/internal/adapters/secondaryadapters/icaladapter"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+
+	"github.com/slack-go/slack"
+)
+
+type SlackHolidayImporter struct {
+	client *slack.Client
+}
+
+var _ secondaryports.ForImportingHolidays = (*SlackHolidayImporter)(nil)
+
+func NewSlackHolidayImporter(client *slack.Client) *SlackHolidayImporter {
+	return &SlackHolidayImporter{client: client}
+}
+
+func (i *SlackHolidayImporter) ImportHolidays(ctx context.Context, fileId string) ([]domain.Holiday, error) {
+	file, _, _, err := i.client.GetFileInfoContext(ctx, fileId, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar file: %w", err)
+	}
+
+	var content bytes.Buffer
+	if err := i.client.GetFileContext(ctx, file.URLPrivateDownload, &content); err != nil {
+		return nil, fmt.Errorf("failed to download calendar file: %w", err)
+	}
+
+	holidays, err := icaladapter.ParseHolidays(&content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import %s: %w", file.Name, err)
+	}
+
+	return holidays, nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"request/internal/app/ports/secondaryports"
 	"request/internal/domain"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
 )
@@ -54,6 +56,237 @@ func (r *MessageRenderer) UpdateRequestNotification(
 	return nil
 }
 
+// UpdateTransferredNotification replaces a card left behind in the previous
+// queue's channel with a pointer to where the request went.
+func (r *MessageRenderer) UpdateTransferredNotification(
+	ctx context.Context,
+	channelId string,
+	messageTs string,
+	request *domain.Request,
+) error {
+	builder := NewBlockBuilder()
+
+	blocks := []slack.Block{
+		builder.Section(fmt.Sprintf("*%s*", request.Title)),
+		builder.Divider(),
+		builder.Section(fmt.Sprintf("_Created by <@%s>_", request.CreatedByID)),
+	}
+
+	if transfer, ok := request.LastTransfer(); ok {
+		blocks = append(blocks,
+			builder.Divider(),
+			builder.Section(fmt.Sprintf("*Status:* ➡️ Moved to %s by <@%s>\n_Reason: %s_", transferDestinationText(transfer), transfer.ByID, transfer.Reason)),
+		)
+	}
+
+	_, _, _, err := r.client.UpdateMessageContext(ctx, channelId, messageTs,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update transferred request notification: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MessageRenderer) RenderHoldQuestion(
+	ctx context.Context,
+	channelId string,
+	request *domain.Request,
+) (string, error) {
+	builder := NewBlockBuilder()
+
+	blocks := []slack.Block{
+		builder.Section(fmt.Sprintf("*%s*", request.Title)),
+		builder.Section(fmt.Sprintf("<@%s> asked:\n>%s", request.AcceptedByID, request.HoldQuestion)),
+		builder.Section("_Reply in this thread or use the button below to resume your request._"),
+		builder.Actions(BlockIDHoldActions,
+			builder.Button(ActionIDReplyToHold, "Reply", request.ID, slack.StylePrimary),
+		),
+	}
+
+	_, messageTs, err := r.client.PostMessageContext(ctx, channelId,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to post hold question: %w", err)
+	}
+
+	return messageTs, nil
+}
+
+// RenderReminder carries the request ID in the block ID, since the remind
+// later select has no value of its own to put it in.
+func (r *MessageRenderer) RenderReminder(
+	ctx context.Context,
+	channelId string,
+	request *domain.Request,
+	message string,
+) (string, error) {
+	builder := NewBlockBuilder()
+
+	laterOptions := []*slack.OptionBlockObject{
+		builder.Option("1h", "In 1 hour"),
+		builder.Option("4h", "In 4 hours"),
+		builder.Option("24h", "Tomorrow"),
+	}
+	laterSelect := slack.NewOptionsSelectBlockElement(
+		slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, "Remind me later", NO_EMOJI, NOT_VERBATIM),
+		ActionIDRemindLater,
+		laterOptions...,
+	)
+
+	blocks := []slack.Block{
+		builder.Section(message),
+	}
+
+	if request.Description != "" {
+		blocks = append(blocks, builder.Section(request.Description))
+	}
+
+	blocks = append(blocks,
+		builder.Actions(BlockIDReminderActionsPrefix+request.ID,
+			builder.Button(ActionIDSnoozeReminders, "Snooze for a day", request.ID, ""),
+			laterSelect,
+		),
+	)
+
+	_, messageTs, err := r.client.PostMessageContext(ctx, channelId,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to post reminder: %w", err)
+	}
+
+	return messageTs, nil
+}
+
+func (r *MessageRenderer) RenderExpiryWarning(
+	ctx context.Context,
+	channelId string,
+	request *domain.Request,
+	expiresAt time.Time,
+) (string, error) {
+	builder := NewBlockBuilder()
+
+	text := fmt.Sprintf("⌛ Your request '%s' has had no activity for a while and will expire <!date^%d^{date_short_pretty} at {time}|%s>. Keep it open if you still need it, or cancel it if you don't.",
+		request.Title, expiresAt.Unix(), expiresAt.Format(time.RFC1123))
+
+	blocks := []slack.Block{
+		builder.Section(text),
+		builder.Actions(BlockIDExpiryActions,
+			builder.Button(ActionIDKeepRequestOpen, "Keep open", request.ID, slack.StylePrimary),
+			builder.Button(ActionIDCancelRequest, "Cancel request", request.ID, slack.StyleDanger),
+		),
+	}
+
+	_, messageTs, err := r.client.PostMessageContext(ctx, channelId,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to post expiry warning: %w", err)
+	}
+
+	return messageTs, nil
+}
+
+func (r *MessageRenderer) RenderApprovalRequest(
+	ctx context.Context,
+	channelId string,
+	request *domain.Request,
+	approverId string,
+) (string, error) {
+	blocks := r.buildApprovalRequestBlocks(request, approverId)
+
+	_, messageTs, err := r.client.PostMessageContext(ctx, channelId,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to post approval request: %w", err)
+	}
+
+	return messageTs, nil
+}
+
+func (r *MessageRenderer) RenderAssignmentNotice(
+	ctx context.Context,
+	channelId string,
+	request *domain.Request,
+) (string, error) {
+	builder := NewBlockBuilder()
+
+	blocks := []slack.Block{
+		builder.Section(fmt.Sprintf("You have been assigned *%s* from <@%s>", request.Title, request.CreatedByID)),
+	}
+
+	if request.Description != "" {
+		blocks = append(blocks, builder.Section(request.Description))
+	}
+
+	blocks = append(blocks,
+		builder.Section("_Can't take it? Decline and it moves to the next person in the queue._"),
+		builder.Actions(BlockIDAssignmentActions,
+			builder.Button(ActionIDDeclineRequest, "Decline", request.ID, slack.StyleDanger),
+		),
+	)
+
+	_, messageTs, err := r.client.PostMessageContext(ctx, channelId,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to post assignment notice: %w", err)
+	}
+
+	return messageTs, nil
+}
+
+func (r *MessageRenderer) UpdateApprovalRequest(
+	ctx context.Context,
+	channelId string,
+	messageTs string,
+	request *domain.Request,
+	approverId string,
+) error {
+	blocks := r.buildApprovalRequestBlocks(request, approverId)
+
+	_, _, _, err := r.client.UpdateMessageContext(ctx, channelId, messageTs,
+		slack.MsgOptionBlocks(blocks...),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update approval request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MessageRenderer) buildApprovalRequestBlocks(request *domain.Request, approverId string) []slack.Block {
+	builder := NewBlockBuilder()
+
+	blocks := []slack.Block{
+		builder.Section(fmt.Sprintf("<@%s> needs your approval for *%s*", request.CreatedByID, request.Title)),
+	}
+
+	if request.Description != "" {
+		blocks = append(blocks, builder.Section(request.Description))
+	}
+
+	if len(request.Fields) > 0 {
+		blocks = append(blocks, builder.Section(fieldValuesText(request.Fields)))
+	}
+
+	blocks = append(blocks, builder.Section(approvalChainText(request)))
+
+	if request.CanBeApprovedBy(approverId) {
+		blocks = append(blocks, builder.Actions(BlockIDApprovalActions,
+			builder.Button(ActionIDApproveRequest, "Approve", request.ID, slack.StylePrimary),
+			builder.Button(ActionIDDenyRequest, "Deny", request.ID, slack.StyleDanger),
+		))
+	}
+
+	return blocks
+}
+
 func (r *MessageRenderer) buildRequestNotificationBlocks(request *domain.Request) []slack.Block {
 	builder := NewBlockBuilder()
 
@@ -64,22 +297,76 @@ func (r *MessageRenderer) buildRequestNotificationBlocks(request *domain.Request
 		builder.Section(fmt.Sprintf("_Created by <@%s>_", request.CreatedByID)),
 	}
 
+	if transfer, ok := request.LastTransfer(); ok && transfer.ToQueueID == request.Recipient.ID {
+		blocks = append(blocks, builder.Section(fmt.Sprintf("_➡️ Moved here from *%s* by <@%s>: %s_", transfer.FromQueueName, transfer.ByID, transfer.Reason)))
+	}
+
+	if request.RoutedFromChannelID != "" {
+		blocks = append(blocks, builder.Section(fmt.Sprintf("_↪️ Routed here from <#%s> because %s_", request.RoutedFromChannelID, request.RoutingReason)))
+	}
+
+	if len(request.Fields) > 0 {
+		blocks = append(blocks, builder.Section(fieldValuesText(request.Fields)))
+	}
+
+	if len(request.Labels) > 0 {
+		blocks = append(blocks, builder.Section(labelsText(request.Labels)))
+	}
+
+	if len(request.ApprovalChain) > 0 {
+		blocks = append(blocks, builder.Section(approvalChainText(request)))
+	}
+
+	if len(request.Checklist) > 0 {
+		blocks = append(blocks, r.buildChecklistBlocks(request)...)
+	}
+
+	if request.IsOpen() && request.IsBlocked() {
+		blocks = append(blocks, builder.Section("⛔ *Blocked by:* "+blockersText(request.OpenBlockers())))
+	}
+
+	if badge := slaBadgeText(request); badge != "" {
+		blocks = append(blocks, builder.Section(badge))
+	}
+
 	switch request.Status {
 	case domain.RequestPending:
+		if request.HasWorkflow() {
+			blocks = append(blocks, r.buildWorkflowBlocks(request)...)
+			break
+		}
 		blocks = append(blocks,
 			builder.Divider(),
 			builder.Actions(BlockIDRequestActions,
 				builder.Button(ActionIDAcceptRequest, "Accept", request.ID, slack.StylePrimary),
+				builder.Button(ActionIDAssignRequest, "Assign…", request.ID, ""),
 				builder.Button(ActionIDRejectRequest, "Reject", request.ID, slack.StyleDanger),
 			),
 		)
 
 	case domain.RequestAccepted:
+		if request.HasWorkflow() {
+			blocks = append(blocks, r.buildWorkflowBlocks(request)...)
+			break
+		}
 		blocks = append(blocks,
 			builder.Divider(),
-			builder.Section(fmt.Sprintf("*Status:* Accepted by <@%s>", request.AcceptedByID)),
+			builder.Section(fmt.Sprintf("*Status:* Accepted by <@%s>%s", request.AcceptedByID, collaboratorsText(request))),
 			builder.Actions(BlockIDRequestActions,
 				builder.Button(ActionIDCompleteRequest, "Complete", request.ID, slack.StylePrimary),
+				builder.Button(ActionIDHoldRequest, "Ask requester", request.ID, ""),
+				builder.Button(ActionIDJoinRequest, "Join", request.ID, ""),
+				builder.Button(ActionIDRejectRequest, "Reject", request.ID, slack.StyleDanger),
+			),
+		)
+
+	case domain.RequestOnHold:
+		blocks = append(blocks,
+			builder.Divider(),
+			builder.Section(fmt.Sprintf("*Status:* ⏸️ On hold, waiting on <@%s>%s\n_Question: %s_", request.CreatedByID, collaboratorsText(request), request.HoldQuestion)),
+			builder.Actions(BlockIDRequestActions,
+				builder.Button(ActionIDReplyToHold, "Reply", request.ID, slack.StylePrimary),
+				builder.Button(ActionIDJoinRequest, "Join", request.ID, ""),
 				builder.Button(ActionIDRejectRequest, "Reject", request.ID, slack.StyleDanger),
 			),
 		)
@@ -87,7 +374,25 @@ func (r *MessageRenderer) buildRequestNotificationBlocks(request *domain.Request
 	case domain.RequestCompleted:
 		blocks = append(blocks,
 			builder.Divider(),
-			builder.Section(fmt.Sprintf("*Status:* ✅ Completed by <@%s>", request.AcceptedByID)),
+			builder.Section(fmt.Sprintf("*Status:* ✅ %s by <@%s>%s", completedStatusText(request), request.AcceptedByID, collaboratorsText(request))),
+		)
+
+	case domain.RequestDuplicate:
+		blocks = append(blocks,
+			builder.Divider(),
+			builder.Section(fmt.Sprintf("*Status:* 🔁 Closed as a duplicate of *%s*", request.DuplicateOfTitle)),
+		)
+
+	case domain.RequestExpired:
+		blocks = append(blocks,
+			builder.Divider(),
+			builder.Section("*Status:* ⌛ Expired after no activity"),
+		)
+
+	case domain.RequestCancelled:
+		blocks = append(blocks,
+			builder.Divider(),
+			builder.Section(fmt.Sprintf("*Status:* 🚫 Cancelled by <@%s>", request.CreatedByID)),
 		)
 
 	case domain.RequestRejected:
@@ -101,5 +406,228 @@ func (r *MessageRenderer) buildRequestNotificationBlocks(request *domain.Request
 		)
 	}
 
+	if request.IsOpen() {
+		elements := []slack.BlockElement{}
+		if request.Recipient.Type == domain.RequestRecipientQueue {
+			elements = append(elements, builder.Button(ActionIDLabelRequest, "Edit labels", request.ID, ""))
+			elements = append(elements, builder.Button(ActionIDTransferRequest, "Move to queue…", request.ID, ""))
+		}
+		elements = append(elements, builder.Button(ActionIDEditBlockers, "Blocked by…", request.ID, ""))
+		elements = append(elements, builder.Button(ActionIDMarkDuplicate, "Duplicate of…", request.ID, ""))
+		elements = append(elements, builder.Button(ActionIDToggleWatch, watchButtonText(request), request.ID, ""))
+
+		blocks = append(blocks, builder.Actions(BlockIDRequestEditActions, elements...))
+	}
+
 	return blocks
 }
+
+func (r *MessageRenderer) buildWorkflowBlocks(request *domain.Request) []slack.Block {
+	builder := NewBlockBuilder()
+
+	statusText := "*Status:* " + requestStatusText(request)
+	if request.AcceptedByID != "" {
+		statusText += fmt.Sprintf(" · <@%s>%s", request.AcceptedByID, collaboratorsText(request))
+	}
+
+	elements := []slack.BlockElement{}
+	for _, next := range request.AvailableTransitions() {
+		style := slack.Style("")
+		if next.Category == domain.StatusCategoryDone {
+			style = slack.StylePrimary
+		}
+		elements = append(elements, builder.Button(ActionIDTransitionPrefix+next.Key, next.Name, request.ID, style))
+	}
+
+	if request.Status == domain.RequestAccepted {
+		elements = append(elements,
+			builder.Button(ActionIDHoldRequest, "Ask requester", request.ID, ""),
+			builder.Button(ActionIDJoinRequest, "Join", request.ID, ""),
+		)
+	}
+	elements = append(elements, builder.Button(ActionIDRejectRequest, "Reject", request.ID, slack.StyleDanger))
+
+	return []slack.Block{
+		builder.Divider(),
+		builder.Section(statusText),
+		builder.Actions(BlockIDRequestActions, elements...),
+	}
+}
+
+func (r *MessageRenderer) buildChecklistBlocks(request *domain.Request) []slack.Block {
+	builder := NewBlockBuilder()
+
+	if request.Status != domain.RequestAccepted && request.Status != domain.RequestOnHold {
+		return []slack.Block{builder.Section(checklistProgressText(request) + "\n" + checklistText(request.Checklist))}
+	}
+
+	options := make([]*slack.OptionBlockObject, len(request.Checklist))
+	initial := []*slack.OptionBlockObject{}
+	for i, item := range request.Checklist {
+		text := item.Text
+		if item.Required {
+			text += " *"
+		}
+		options[i] = builder.Option(item.ID, truncate(text, 75))
+		if item.Done {
+			initial = append(initial, options[i])
+		}
+	}
+
+	checkboxes := slack.NewCheckboxGroupsBlockElement(ActionIDTickChecklist, options...)
+	checkboxes.InitialOptions = initial
+
+	return []slack.Block{
+		builder.Section(checklistProgressText(request)),
+		builder.Actions(BlockIDChecklistPrefix+request.ID, checkboxes),
+	}
+}
+
+func checklistProgressText(request *domain.Request) string {
+	done, total := request.ChecklistProgress()
+	filled := done * 10 / total
+	return fmt.Sprintf("*Checklist:* %s %d/%d done", strings.Repeat("▰", filled)+strings.Repeat("▱", 10-filled), done, total)
+}
+
+func checklistText(items []domain.ChecklistItem) string {
+	lines := make([]string, len(items))
+	for i, item := range items {
+		mark := "☐"
+		if item.Done {
+			mark = "☑"
+		}
+		lines[i] = fmt.Sprintf("%s %s", mark, item.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func watchButtonText(request *domain.Request) string {
+	if len(request.WatcherIDs) == 0 {
+		return "👀 Watch"
+	}
+	return fmt.Sprintf("👀 Watch / Unwatch (%d)", len(request.WatcherIDs))
+}
+
+func slaBadgeText(request *domain.Request) string {
+	if !request.IsOpen() {
+		return ""
+	}
+
+	switch request.SLAState {
+	case domain.SLAAtRisk:
+		return "⏳ *SLA at risk*"
+	case domain.SLABreached:
+		targets := make([]string, len(request.SLABreaches))
+		for i, breach := range request.SLABreaches {
+			targets[i] = slaTargetText(breach.Target)
+		}
+		return "🚨 *SLA breached:* " + strings.Join(targets, ", ")
+	default:
+		return ""
+	}
+}
+
+func slaTargetText(target domain.SLATarget) string {
+	if target == domain.SLATargetAccept {
+		return "time to accept"
+	}
+	return "time to complete"
+}
+
+func blockersText(blockers []domain.Blocker) string {
+	titles := make([]string, len(blockers))
+	for i, blocker := range blockers {
+		titles[i] = fmt.Sprintf("%s _(%s)_", blocker.Title, blocker.Status)
+	}
+	return strings.Join(titles, ", ")
+}
+
+func approvalChainText(request *domain.Request) string {
+	lines := []string{"*Approvals:*"}
+
+	for i, stage := range request.ApprovalChain {
+		icon := "◻️"
+		switch {
+		case i < request.ApprovalStage:
+			icon = "✅"
+		case i == request.ApprovalStage && request.Status == domain.RequestDenied:
+			icon = "🚫"
+		case i == request.ApprovalStage && request.Status == domain.RequestAwaitingApproval:
+			icon = "⏳"
+		}
+
+		line := fmt.Sprintf("%s *%s* (%s)", icon, stage.Name, stage.RuleText())
+		if i == request.ApprovalStage && request.Status == domain.RequestAwaitingApproval {
+			line += fmt.Sprintf(" · %d/%d", len(request.StageApprovals(i)), stage.RequiredApprovals())
+			if request.ApprovalEscalated {
+				line += fmt.Sprintf(" · escalated to <@%s>", stage.FallbackApproverID)
+			} else if deadline, ok := request.ApprovalDeadline(); ok {
+				line += fmt.Sprintf(" · escalates <!date^%d^{date_short_pretty} {time}|%s>", deadline.Unix(), deadline.Format(time.RFC1123))
+			}
+		}
+
+		for _, decision := range request.Approvals {
+			if decision.Stage != i {
+				continue
+			}
+			if decision.Approved {
+				line += fmt.Sprintf("\n      ✅ <@%s>", decision.ApproverID)
+			} else {
+				line += fmt.Sprintf("\n      🚫 <@%s>", decision.ApproverID)
+			}
+			if decision.Comment != "" {
+				line += fmt.Sprintf(": _%s_", decision.Comment)
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func requestStatusText(request *domain.Request) string {
+	if status, ok := request.CurrentWorkflowStatus(); ok {
+		return status.Name
+	}
+	return string(request.Status)
+}
+
+func completedStatusText(request *domain.Request) string {
+	if status, ok := request.CurrentWorkflowStatus(); ok {
+		return status.Name
+	}
+	return "Completed"
+}
+
+func collaboratorsText(request *domain.Request) string {
+	if len(request.CollaboratorIDs) == 0 {
+		return ""
+	}
+
+	mentions := make([]string, len(request.CollaboratorIDs))
+	for i, collaboratorId := range request.CollaboratorIDs {
+		mentions[i] = fmt.Sprintf("<@%s>", collaboratorId)
+	}
+
+	return fmt.Sprintf(" with %s", strings.Join(mentions, ", "))
+}
+
+func transferDestinationText(transfer domain.QueueTransfer) string {
+	if transfer.ToChannelID == "" {
+		return fmt.Sprintf("*%s*", transfer.ToQueueName)
+	}
+	return fmt.Sprintf("*%s* in <#%s>", transfer.ToQueueName, transfer.ToChannelID)
+}
+
+func fieldValuesText(fields []domain.FieldValue) string {
+	lines := make([]string, len(fields))
+	for i, field := range fields {
+		value := field.Value
+		if field.Type == domain.IntakeFieldUser {
+			value = fmt.Sprintf("<@%s>", field.Value)
+		}
+		lines[i] = fmt.Sprintf("*%s:* %s", field.Label, value)
+	}
+	return strings.Join(lines, "\n")
+}
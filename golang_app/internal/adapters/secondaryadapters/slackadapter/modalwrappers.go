@@ -14,7 +14,7 @@ func newModalViewRequest(callbackId string, title string, submitEnabled bool) *s
 
 	return &slack.ModalViewRequest{
 		Type:       slack.VTModal,
-		CallbackID: CallbackIDRequestForm,
+		CallbackID: callbackId,
 		Title: &slack.TextBlockObject{
 			Type: slack.PlainTextType,
 			Text: title,
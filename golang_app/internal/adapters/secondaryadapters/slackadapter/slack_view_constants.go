@@ -1,19 +1,32 @@
 package slackadapter
 
 const (
-	ActionIDRecipientTypeSelect = "recipient_type_select"
-	BlockIDRecipientTypeAction  = "recipient_type_action"
-	ActionIDUserSelect          = "user_select"
-	BlockIDUserSelect           = "user_select_block"
-	ActionIDChannelSelect       = "channel_select"
-	BlockIDChannelSelect        = "channel_select_block"
-	ActionIDQueueSelect         = "queue_select"
-	BlockIDQueueSelect          = "queue_select_block"
-	BlockIDRequestTitle         = "request_title_block"
-	ActionIDRequestTitle        = "request_title_input"
-	BlockIDRequestDescription   = "request_description_block"
-	ActionIDRequestDescription  = "request_description_input"
-	CallbackIDRequestForm       = "request_form"
+	ActionIDRecipientTypeSelect      = "recipient_type_select"
+	BlockIDRecipientTypeAction       = "recipient_type_action"
+	ActionIDUserSelect               = "user_select"
+	BlockIDUserSelect                = "user_select_block"
+	ActionIDChannelSelect            = "channel_select"
+	BlockIDChannelSelect             = "channel_select_block"
+	ActionIDQueueSelect              = "queue_select"
+	BlockIDQueueSelect               = "queue_select_block"
+	BlockIDRequestTitle              = "request_title_block"
+	ActionIDRequestTitle             = "request_title_input"
+	BlockIDRequestDescription        = "request_description_block"
+	ActionIDRequestDescription       = "request_description_input"
+	CallbackIDRequestForm            = "request_form"
+	BlockIDRequestLabels             = "request_labels_block"
+	ActionIDRequestLabels            = "request_labels_select"
+	BlockIDIntakeFieldPrefix         = "intake_field_"
+	ActionIDIntakeField              = "intake_field_input"
+	BlockIDTemplateAction            = "template_action"
+	ActionIDTemplateSelect           = "template_select"
+	TemplateOptionSeparator          = "/"
+	BlockIDTemplatePlaceholderPrefix = "template_placeholder_"
+	ActionIDTemplatePlaceholder      = "template_placeholder_input"
+	BlockIDRequestChecklist          = "request_checklist_block"
+	ActionIDRequestChecklist         = "request_checklist_input"
+	BlockIDRequestWatchers           = "request_watchers_block"
+	ActionIDRequestWatchers          = "request_watchers_select"
 
 	CallbackIDQueueForm        = "queue_form"
 	BlockIDQueueChannel        = "queue_channel_block"
@@ -24,13 +37,234 @@ const (
 	ActionIDQueueDescription   = "queue_description_input"
 	BlockIDQueueAdmins         = "queue_admins_block"
 	ActionIDQueueAdminsSelect  = "queue_admins_select"
+	BlockIDQueueTriagers       = "queue_triagers_block"
+	ActionIDQueueTriagers      = "queue_triagers_select"
+	BlockIDQueueResponders     = "queue_responders_block"
+	ActionIDQueueResponders    = "queue_responders_select"
+	BlockIDQueueLabels         = "queue_labels_block"
+	ActionIDQueueLabels        = "queue_labels_input"
+	BlockIDQueueIntakeFields   = "queue_intake_fields_block"
+	ActionIDQueueIntakeFields  = "queue_intake_fields_input"
+	BlockIDQueueApprovers      = "queue_approvers_block"
+	ActionIDQueueApprovers     = "queue_approvers_select"
+	BlockIDQueueWorkflow       = "queue_workflow_block"
+	ActionIDQueueWorkflow      = "queue_workflow_input"
+	BlockIDQueueAssignment     = "queue_assignment_block"
+	ActionIDQueueAssignment    = "queue_assignment_select"
+
+	CallbackIDTemplateForm      = "template_form"
+	BlockIDTemplateQueue        = "template_queue_block"
+	ActionIDTemplateQueue       = "template_queue_select"
+	BlockIDTemplateName         = "template_name_block"
+	ActionIDTemplateName        = "template_name_input"
+	BlockIDTemplateTitle        = "template_title_block"
+	ActionIDTemplateTitle       = "template_title_input"
+	BlockIDTemplateDescription  = "template_description_block"
+	ActionIDTemplateDescription = "template_description_input"
+	BlockIDTemplateUser         = "template_user_block"
+	ActionIDTemplateUser        = "template_user_select"
+	BlockIDTemplateChannel      = "template_channel_block"
+	ActionIDTemplateChannel     = "template_channel_select"
+
+	CallbackIDRemoveTemplateForm = "remove_template_form"
+	BlockIDRemoveTemplate        = "remove_template_block"
+	ActionIDRemoveTemplate       = "remove_template_select"
+
+	CallbackIDApprovalChainForm    = "approval_chain_form"
+	BlockIDApprovalChainQueue      = "approval_chain_queue_block"
+	ActionIDApprovalChainQueue     = "approval_chain_queue_select"
+	BlockIDApprovalStagePrefix     = "approval_stage_"
+	ActionIDApprovalStageName      = "approval_stage_name_input"
+	ActionIDApprovalStageApprovers = "approval_stage_approvers_select"
+	ActionIDApprovalStageRule      = "approval_stage_rule_select"
+	ActionIDApprovalStageQuorum    = "approval_stage_quorum_input"
+	ActionIDApprovalStageTimeout   = "approval_stage_timeout_input"
+	ActionIDApprovalStageFallback  = "approval_stage_fallback_select"
+
+	CallbackIDOnCallForm     = "oncall_form"
+	BlockIDOnCallQueue       = "oncall_queue_block"
+	ActionIDOnCallQueue      = "oncall_queue_select"
+	BlockIDOnCallMembers     = "oncall_members_block"
+	ActionIDOnCallMembers    = "oncall_members_select"
+	BlockIDOnCallStartDate   = "oncall_start_date_block"
+	ActionIDOnCallStartDate  = "oncall_start_date_picker"
+	BlockIDOnCallHandoff     = "oncall_handoff_block"
+	ActionIDOnCallHandoff    = "oncall_handoff_picker"
+	BlockIDOnCallShiftDays   = "oncall_shift_days_block"
+	ActionIDOnCallShiftDays  = "oncall_shift_days_input"
+	BlockIDOnCallTimeZone    = "oncall_time_zone_block"
+	ActionIDOnCallTimeZone   = "oncall_time_zone_input"
+	BlockIDOnCallAssignment  = "oncall_assignment_block"
+	ActionIDOnCallAssignment = "oncall_assignment_select"
+	OnCallAssignOptionValue  = "assign"
+	OnCallKeepOptionValue    = "keep"
+
+	CallbackIDOnCallOverrideForm = "oncall_override_form"
+	BlockIDOnCallOverrideQueue   = "oncall_override_queue_block"
+	ActionIDOnCallOverrideQueue  = "oncall_override_queue_select"
+	BlockIDOnCallOverrideUser    = "oncall_override_user_block"
+	ActionIDOnCallOverrideUser   = "oncall_override_user_select"
+	BlockIDOnCallOverrideStart   = "oncall_override_start_block"
+	ActionIDOnCallOverrideStart  = "oncall_override_start_picker"
+	BlockIDOnCallOverrideEnd     = "oncall_override_end_block"
+	ActionIDOnCallOverrideEnd    = "oncall_override_end_picker"
+
+	CallbackIDBusinessHoursForm   = "business_hours_form"
+	BlockIDBusinessHoursQueue     = "business_hours_queue_block"
+	ActionIDBusinessHoursQueue    = "business_hours_queue_select"
+	BlockIDBusinessHoursTimeZone  = "business_hours_time_zone_block"
+	ActionIDBusinessHoursTimeZone = "business_hours_time_zone_input"
+	BlockIDBusinessHours          = "business_hours_block"
+	ActionIDBusinessHours         = "business_hours_input"
+	BlockIDHolidayCalendar        = "holiday_calendar_block"
+	ActionIDHolidayCalendar       = "holiday_calendar_input"
+
+	CallbackIDSLAForm         = "sla_form"
+	BlockIDSLAQueue           = "sla_queue_block"
+	ActionIDSLAQueue          = "sla_queue_select"
+	BlockIDSLAAcceptWithin    = "sla_accept_block"
+	ActionIDSLAAcceptWithin   = "sla_accept_input"
+	BlockIDSLACompleteWithin  = "sla_complete_block"
+	ActionIDSLACompleteWithin = "sla_complete_input"
+	BlockIDSLAAtRisk          = "sla_at_risk_block"
+	ActionIDSLAAtRisk         = "sla_at_risk_input"
+
+	CallbackIDReminderRulesForm   = "reminder_rules_form"
+	BlockIDReminderRulesQueue     = "reminder_rules_queue_block"
+	ActionIDReminderRulesQueue    = "reminder_rules_queue_select"
+	BlockIDReminderRulePrefix     = "reminder_rule_"
+	ActionIDReminderRuleCondition = "reminder_rule_condition_select"
+	ActionIDReminderRuleAfter     = "reminder_rule_after_input"
+	ActionIDReminderRuleTarget    = "reminder_rule_target_select"
+
+	CallbackIDExpiryForm = "expiry_form"
+	BlockIDExpiryQueue   = "expiry_queue_block"
+	ActionIDExpiryQueue  = "expiry_queue_select"
+	BlockIDExpiryAfter   = "expiry_after_block"
+	ActionIDExpiryAfter  = "expiry_after_input"
+
+	CallbackIDWIPLimitsForm = "wip_limits_form"
+	BlockIDWIPQueue         = "wip_queue_block"
+	ActionIDWIPQueue        = "wip_queue_select"
+	BlockIDWIPPerMember     = "wip_per_member_block"
+	ActionIDWIPPerMember    = "wip_per_member_input"
+	BlockIDWIPPerQueue      = "wip_per_queue_block"
+	ActionIDWIPPerQueue     = "wip_per_queue_input"
+
+	CallbackIDQueueLabelsForm = "queue_labels_form"
+	BlockIDLabelsQueue        = "labels_queue_block"
+	ActionIDLabelsQueue       = "labels_queue_select"
+	BlockIDLabelsAdd          = "labels_add_block"
+	ActionIDLabelsAdd         = "labels_add_input"
+	BlockIDLabelsRemove       = "labels_remove_block"
+	ActionIDLabelsRemove      = "labels_remove_input"
+
+	CallbackIDIntakeFieldsForm = "intake_fields_form"
+	BlockIDIntakeFieldsQueue   = "intake_fields_queue_block"
+	ActionIDIntakeFieldsQueue  = "intake_fields_queue_select"
+	BlockIDIntakeFieldsSpec    = "intake_fields_spec_block"
+	ActionIDIntakeFieldsSpec   = "intake_fields_spec_input"
+
+	CallbackIDWorkflowForm = "workflow_form"
+	BlockIDWorkflowQueue   = "workflow_queue_block"
+	ActionIDWorkflowQueue  = "workflow_queue_select"
+	BlockIDWorkflowSpec    = "workflow_spec_block"
+	ActionIDWorkflowSpec   = "workflow_spec_input"
+
+	CallbackIDRoutingRulesForm = "routing_rules_form"
+	BlockIDRoutingRulesQueue   = "routing_rules_queue_block"
+	ActionIDRoutingRulesQueue  = "routing_rules_queue_select"
+	BlockIDRoutingRulePrefix   = "routing_rule_"
+	ActionIDRoutingRuleChannel = "routing_rule_channel_select"
+	ActionIDRoutingRuleMatch   = "routing_rule_match_input"
+	ActionIDRoutingRuleGroup   = "routing_rule_group_input"
+
+	CallbackIDRecurringRequestForm = "recurring_request_form"
+	BlockIDRecurringTitle          = "recurring_title_block"
+	ActionIDRecurringTitle         = "recurring_title_input"
+	BlockIDRecurringDescription    = "recurring_description_block"
+	ActionIDRecurringDescription   = "recurring_description_input"
+	BlockIDRecurringQueue          = "recurring_queue_block"
+	ActionIDRecurringQueue         = "recurring_queue_select"
+	BlockIDRecurringUser           = "recurring_user_block"
+	ActionIDRecurringUser          = "recurring_user_select"
+	BlockIDRecurringChannel        = "recurring_channel_block"
+	ActionIDRecurringChannel       = "recurring_channel_select"
+	BlockIDRecurringSchedule       = "recurring_schedule_block"
+	ActionIDRecurringSchedule      = "recurring_schedule_input"
+	CallbackIDRecurringRequestList = "recurring_request_list"
+	BlockIDRecurringActionsPrefix  = "recurring_actions_"
+	ActionIDPauseRecurringRequest  = "pause_recurring_request"
+	ActionIDResumeRecurringRequest = "resume_recurring_request"
+	ActionIDDeleteRecurringRequest = "delete_recurring_request"
+
+	CallbackIDQueueBrowser      = "queue_browser"
+	BlockIDQueueBrowserActions  = "queue_browser_actions"
+	ActionIDBrowseQueue         = "browse_queue_select"
+	BlockIDRequestListActions   = "request_list_actions"
+	ActionIDFilterRequestsLabel = "filter_requests_label"
+	CallbackIDRequestLabels     = "request_labels_modal"
+	ActionIDLabelRequest        = "label_request"
+	BlockIDRequestEditActions   = "request_edit_actions_block"
+	ActionIDEditBlockers        = "edit_blockers"
+	ActionIDMarkDuplicate       = "mark_duplicate"
+	CallbackIDMarkDuplicate     = "mark_duplicate_modal"
+	BlockIDCanonicalRequest     = "canonical_request_block"
+	ActionIDCanonicalRequest    = "canonical_request_select"
+	ActionIDTransferRequest     = "transfer_request"
+	CallbackIDTransferRequest   = "transfer_request_modal"
+	BlockIDTransferQueue        = "transfer_queue_block"
+	ActionIDTransferQueue       = "transfer_queue_select"
+	BlockIDTransferReason       = "transfer_reason_block"
+	ActionIDTransferReason      = "transfer_reason_input"
+	CallbackIDRequestBlockers   = "request_blockers_modal"
+	BlockIDRequestBlockers      = "request_blockers_block"
+	ActionIDRequestBlockers     = "request_blockers_select"
+	AllLabelsOptionValue        = "__all__"
 
 	// Request notification action IDs
 	BlockIDRequestActions     = "request_actions_block"
 	ActionIDAcceptRequest     = "accept_request"
 	ActionIDRejectRequest     = "reject_request"
 	ActionIDCompleteRequest   = "complete_request"
+	ActionIDJoinRequest       = "join_request"
+	ActionIDAssignRequest     = "assign_request"
+	CallbackIDAssignRequest   = "assign_request_modal"
+	BlockIDAssignee           = "assignee_block"
+	ActionIDAssignee          = "assignee_select"
+	BlockIDAssignmentActions  = "assignment_actions_block"
+	ActionIDDeclineRequest    = "decline_request"
+	ActionIDToggleWatch       = "toggle_watch"
+	ActionIDTransitionPrefix  = "transition_request_"
+	BlockIDChecklistPrefix    = "checklist_"
+	ActionIDTickChecklist     = "tick_checklist"
 	CallbackIDRejectionReason = "rejection_reason_modal"
 	BlockIDRejectionReason    = "rejection_reason_block"
 	ActionIDRejectionReason   = "rejection_reason_input"
+
+	BlockIDApprovalActions   = "approval_actions_block"
+	ActionIDApproveRequest   = "approve_request"
+	ActionIDDenyRequest      = "deny_request"
+	CallbackIDApproveRequest = "approve_request_modal"
+	CallbackIDDenyRequest    = "deny_request_modal"
+	BlockIDApprovalComment   = "approval_comment_block"
+	ActionIDApprovalComment  = "approval_comment_input"
+
+	ActionIDHoldRequest    = "hold_request"
+	ActionIDReplyToHold    = "reply_to_hold"
+	BlockIDHoldActions     = "hold_actions_block"
+	CallbackIDHoldQuestion = "hold_question_modal"
+	BlockIDHoldQuestion    = "hold_question_block"
+	ActionIDHoldQuestion   = "hold_question_input"
+	CallbackIDHoldReply    = "hold_reply_modal"
+	BlockIDHoldReply       = "hold_reply_block"
+	ActionIDHoldReply      = "hold_reply_input"
+
+	BlockIDReminderActionsPrefix = "reminder_actions_"
+	ActionIDSnoozeReminders      = "snooze_reminders"
+	ActionIDRemindLater          = "remind_later"
+
+	BlockIDExpiryActions    = "expiry_actions_block"
+	ActionIDKeepRequestOpen = "keep_request_open"
+	ActionIDCancelRequest   = "cancel_request"
 )
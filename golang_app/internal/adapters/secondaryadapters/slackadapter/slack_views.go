@@ -3,9 +3,11 @@ package slackadapter
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"request/internal/app/ports/secondaryports"
 	"request/internal/domain"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
 )
@@ -28,8 +30,8 @@ func NewSlackViewRenderer(client *slack.Client) *SlackViewRenderer {
 }
 
 func (r *SlackViewRenderer) RenderRequestForm(ctx context.Context, triggerId string, view secondaryports.RequestFormView) error {
-	blocks := r.buildRequestFormBlocks("", view.RecipientTypeOptions)
-	modalRequest := newModalViewRequest(CallbackIDRequestForm, "Create New Request", false)
+	blocks := r.buildRequestFormBlocks(view.InitialState, view.RecipientTypeOptions)
+	modalRequest := newModalViewRequest(CallbackIDRequestForm, "Create New Request", view.InitialState.RecipientType != "")
 	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, blocks.BlockSet...)
 
 	_, err := r.client.OpenView(triggerId, *modalRequest)
@@ -40,16 +42,16 @@ func (r *SlackViewRenderer) RenderRequestForm(ctx context.Context, triggerId str
 	return nil
 }
 
-func (r *SlackViewRenderer) UpdateRequestFormWithRecipient(ctx context.Context, viewID string, recipientType domain.RequestRecipientType) error {
+func (r *SlackViewRenderer) UpdateRequestForm(ctx context.Context, viewID string, state secondaryports.RequestFormState) error {
 	options := []secondaryports.RecipientTypeOption{
 		{Value: string(domain.RequestRecipientUser), Label: "User"},
 		{Value: "channel", Label: "Channel"},
 		{Value: string(domain.RequestRecipientQueue), Label: "Queue"},
 	}
 
-	blocks := r.buildRequestFormBlocks(recipientType, options)
+	blocks := r.buildRequestFormBlocks(state, options)
 
-	modalRequest := newModalViewRequest(CallbackIDRequestForm, "Create New Request", recipientType != "")
+	modalRequest := newModalViewRequest(CallbackIDRequestForm, "Create New Request", state.RecipientType != "")
 	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, blocks.BlockSet...)
 
 	_, err := r.client.UpdateView(*modalRequest, "", "", viewID)
@@ -60,9 +62,14 @@ func (r *SlackViewRenderer) UpdateRequestFormWithRecipient(ctx context.Context,
 	return nil
 }
 
-func (r *SlackViewRenderer) buildRequestFormBlocks(selectedRecipientType domain.RequestRecipientType, recipientTypeOptions []secondaryports.RecipientTypeOption) slack.Blocks {
+func (r *SlackViewRenderer) buildRequestFormBlocks(state secondaryports.RequestFormState, recipientTypeOptions []secondaryports.RecipientTypeOption) slack.Blocks {
+	selectedRecipientType := state.RecipientType
 	blocks := []slack.Block{}
 
+	if len(state.Templates) > 0 {
+		blocks = append(blocks, r.buildTemplatePickerBlock(state))
+	}
+
 	blocks = append(blocks, slack.NewSectionBlock(
 		slack.NewTextBlockObject("plain_text", "Select the type of recipient for your request", false, false),
 		nil,
@@ -92,25 +99,154 @@ func (r *SlackViewRenderer) buildRequestFormBlocks(selectedRecipientType domain.
 
 	blocks = append(blocks, slack.NewActionBlock(BlockIDRecipientTypeAction, recipientTypeSelect))
 
+	var template *domain.RequestTemplate
+	if state.SelectedTemplate != nil {
+		template = &state.SelectedTemplate.Template
+	}
+
 	builder := NewBlockBuilder()
 	if selectedRecipientType == "user" {
-		blocks = append(blocks, builder.UserSelect(BlockIDUserSelect, "Select a user", "Choose user", ActionIDUserSelect))
+		userSelect := builder.UserSelect(BlockIDUserSelect, "Select a user", "Choose user", ActionIDUserSelect)
+		if template != nil && template.RecipientType == domain.RequestRecipientUser {
+			userSelect.Element.(*slack.SelectBlockElement).InitialUser = template.RecipientID
+		}
+		blocks = append(blocks, userSelect)
 	} else if selectedRecipientType == "channel" {
-		blocks = append(blocks, builder.ChannelSelect(BlockIDChannelSelect, "Select a channel", "Choose channel", ActionIDChannelSelect))
+		channelSelect := builder.ChannelSelect(BlockIDChannelSelect, "Select a channel", "Choose channel", ActionIDChannelSelect)
+		if template != nil && template.RecipientType == domain.RequestRecipientChannel {
+			channelSelect.Element.(*slack.SelectBlockElement).InitialChannel = template.RecipientID
+		}
+		blocks = append(blocks, channelSelect)
 	} else if selectedRecipientType == "queue" {
-		slog.Error("Not implemented")
+		blocks = append(blocks, r.buildQueueSelectBlocks(state)...)
 	}
 
 	if selectedRecipientType != "" {
-		blocks = append(blocks,
-			builder.TextInput(BlockIDRequestTitle, "Title", "Enter request title", false, ActionIDRequestTitle),
-			builder.TextInput(BlockIDRequestDescription, "Description", "Enter request description", true, ActionIDRequestDescription),
-		)
+		titleBlock := builder.TextInput(BlockIDRequestTitle, "Title", "Enter request title", false, ActionIDRequestTitle)
+		descriptionBlock := builder.TextInput(BlockIDRequestDescription, "Description", "Enter request description", true, ActionIDRequestDescription)
+
+		if template != nil {
+			titleBlock.Element.(*slack.PlainTextInputBlockElement).InitialValue = template.Title
+			descriptionBlock.Element.(*slack.PlainTextInputBlockElement).InitialValue = template.Description
+		}
+
+		checklistBlock := builder.TextInput(BlockIDRequestChecklist, "Checklist", "One step per line", true, ActionIDRequestChecklist)
+		checklistBlock.Optional = true
+		checklistBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, fmt.Sprintf("Up to %d steps. End a step with * if it must be done before the request can be completed.", domain.MaxChecklistItems), NO_EMOJI, NOT_VERBATIM)
+
+		watchersBlock := builder.MultiUserSelect(BlockIDRequestWatchers, "Watchers", "People to keep updated", ActionIDRequestWatchers)
+		watchersBlock.Optional = true
+
+		blocks = append(blocks, titleBlock, descriptionBlock, checklistBlock, watchersBlock)
+	}
+
+	if template != nil {
+		for _, placeholder := range template.Placeholders() {
+			blocks = append(blocks, builder.TextInput(BlockIDTemplatePlaceholderPrefix+placeholder, placeholder, "", false, ActionIDTemplatePlaceholder))
+		}
 	}
 
 	return slack.Blocks{BlockSet: blocks}
 }
 
+func (r *SlackViewRenderer) buildTemplatePickerBlock(state secondaryports.RequestFormState) *slack.ActionBlock {
+	builder := NewBlockBuilder()
+
+	options := make([]*slack.OptionBlockObject, len(state.Templates))
+	for i, option := range state.Templates {
+		options[i] = builder.Option(
+			templateOptionValue(option.Queue.ID, option.Template.Key),
+			truncate(option.Queue.Name+" · "+option.Template.Name, 75),
+		)
+	}
+
+	templateSelect := slack.NewOptionsSelectBlockElement(
+		slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, "Start from a template", NO_EMOJI, NOT_VERBATIM),
+		ActionIDTemplateSelect,
+		options...,
+	)
+
+	if state.SelectedTemplate != nil {
+		selected := templateOptionValue(state.SelectedTemplate.Queue.ID, state.SelectedTemplate.Template.Key)
+		for _, opt := range options {
+			if opt.Value == selected {
+				templateSelect.InitialOption = opt
+				break
+			}
+		}
+	}
+
+	return builder.Actions(BlockIDTemplateAction, templateSelect)
+}
+
+func templateOptionValue(queueId, templateKey string) string {
+	return queueId + TemplateOptionSeparator + templateKey
+}
+
+func (r *SlackViewRenderer) buildQueueSelectBlocks(state secondaryports.RequestFormState) []slack.Block {
+	builder := NewBlockBuilder()
+
+	if len(state.Queues) == 0 {
+		return []slack.Block{builder.Section("_There are no queues to send a request to yet. Create one with `/request new-queue`._")}
+	}
+
+	queueOptions := make([]*slack.OptionBlockObject, len(state.Queues))
+	for i, queue := range state.Queues {
+		queueOptions[i] = builder.Option(queue.ID, queue.Name)
+	}
+
+	queueSelect := builder.StaticSelect(BlockIDQueueSelect, "Select a queue", "Choose queue", ActionIDQueueSelect, queueOptions)
+	queueSelect.DispatchAction = true
+
+	blocks := []slack.Block{queueSelect}
+
+	if state.SelectedQueue == nil {
+		return blocks
+	}
+
+	for _, opt := range queueOptions {
+		if opt.Value == state.SelectedQueue.ID {
+			queueSelect.Element.(*slack.SelectBlockElement).InitialOption = opt
+			break
+		}
+	}
+
+	if len(state.SelectedQueue.Labels) > 0 {
+		labelsSelect := builder.MultiStaticSelect(BlockIDRequestLabels, "Labels", "Choose labels", ActionIDRequestLabels, labelOptions(state.SelectedQueue.Labels))
+		labelsSelect.Optional = true
+		blocks = append(blocks, labelsSelect)
+	}
+
+	for _, field := range state.SelectedQueue.IntakeFields {
+		blocks = append(blocks, r.buildIntakeFieldBlock(field))
+	}
+
+	return blocks
+}
+
+func (r *SlackViewRenderer) buildIntakeFieldBlock(field domain.IntakeField) *slack.InputBlock {
+	builder := NewBlockBuilder()
+	blockId := BlockIDIntakeFieldPrefix + field.Key
+
+	var block *slack.InputBlock
+	switch field.Type {
+	case domain.IntakeFieldNumber:
+		block = builder.NumberInput(blockId, field.Label, "Enter a number", ActionIDIntakeField)
+	case domain.IntakeFieldSelect:
+		block = builder.StaticSelect(blockId, field.Label, "Choose an option", ActionIDIntakeField, labelOptions(field.Options))
+	case domain.IntakeFieldUser:
+		block = builder.UserSelect(blockId, field.Label, "Choose user", ActionIDIntakeField)
+	case domain.IntakeFieldDate:
+		block = builder.DatePicker(blockId, field.Label, ActionIDIntakeField)
+	default:
+		block = builder.TextInput(blockId, field.Label, "", false, ActionIDIntakeField)
+	}
+
+	block.Optional = !field.Required
+	return block
+}
+
 func (r *SlackViewRenderer) RenderQueueForm(ctx context.Context, triggerId string, view secondaryports.QueueFormView) error {
 	builder := NewBlockBuilder()
 
@@ -118,9 +254,32 @@ func (r *SlackViewRenderer) RenderQueueForm(ctx context.Context, triggerId strin
 	queueTitleBlock := builder.TextInput(BlockIDQueueName, "Title", "Enter queue title...", false, ActionIDQueueName)
 	descriptionBlock := builder.TextInput(BlockIDQueueDescription, "Description", "Enter queue description...", true, ActionIDQueueDescription)
 	queueAdminsBlock := builder.MultiUserSelect(BlockIDQueueAdmins, "Select queue admins", "Select users to manage queue...", ActionIDQueueAdminsSelect)
+	queueTriagersBlock := builder.MultiUserSelect(BlockIDQueueTriagers, "Triagers", "Select users who sort and assign requests...", ActionIDQueueTriagers)
+	queueTriagersBlock.Optional = true
+	queueRespondersBlock := builder.MultiUserSelect(BlockIDQueueResponders, "Responders", "Select users who work on requests...", ActionIDQueueResponders)
+	queueRespondersBlock.Optional = true
+	queueLabelsBlock := builder.TextInput(BlockIDQueueLabels, "Labels", "Comma separated, e.g. access, bug, question", false, ActionIDQueueLabels)
+	queueLabelsBlock.Optional = true
+	intakeFieldsBlock := builder.TextInput(BlockIDQueueIntakeFields, "Intake fields", "One per line, e.g. System: select* = Payments | Billing", true, ActionIDQueueIntakeFields)
+	intakeFieldsBlock.Optional = true
+	intakeFieldsBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Format: Label: type, where type is text, number, select, user or date. Add * to make it required and = a | b for select options.", NO_EMOJI, NOT_VERBATIM)
+	approversBlock := builder.MultiUserSelect(BlockIDQueueApprovers, "Approvers", "Select users who must approve new requests...", ActionIDQueueApprovers)
+	approversBlock.Optional = true
+	approversBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "New requests wait for one of these users to approve them before they reach the queue. Leave empty to skip approval.", NO_EMOJI, NOT_VERBATIM)
+	workflowBlock := builder.TextInput(BlockIDQueueWorkflow, "Workflow", "One status per line, e.g. Triage (open) -> Investigating", true, ActionIDQueueWorkflow)
+	workflowBlock.Optional = true
+	workflowBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Format: Status (category) -> Next, Other. Categories are open, in progress and done; the first status must be open. Leave empty for the default Accept/Complete flow.", NO_EMOJI, NOT_VERBATIM)
+
+	assignmentBlock := builder.StaticSelect(BlockIDQueueAssignment, "Auto-assignment", "Don't assign automatically", ActionIDQueueAssignment, []*slack.OptionBlockObject{
+		builder.Option(string(domain.AssignmentNone), "Don't assign automatically"),
+		builder.Option(string(domain.AssignmentRoundRobin), "Round robin over responders"),
+		builder.Option(string(domain.AssignmentLeastOpen), "Responder with the fewest open requests"),
+		builder.Option(string(domain.AssignmentRandom), "Random responder"),
+	})
+	assignmentBlock.Optional = true
 
 	modalRequest := newModalViewRequest(CallbackIDQueueForm, "Create New Queue", true)
-	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, []slack.Block{channelSelectBlock, queueTitleBlock, descriptionBlock, queueAdminsBlock}...)
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, []slack.Block{channelSelectBlock, queueTitleBlock, descriptionBlock, queueAdminsBlock, queueTriagersBlock, queueRespondersBlock, queueLabelsBlock, intakeFieldsBlock, approversBlock, workflowBlock, assignmentBlock}...)
 
 	_, err := r.client.OpenView(triggerId, *modalRequest)
 	if err != nil {
@@ -131,20 +290,1217 @@ func (r *SlackViewRenderer) RenderQueueForm(ctx context.Context, triggerId strin
 	return nil
 }
 
-func (r *SlackViewRenderer) UpdateRequestForm(ctx context.Context, viewId string, recipientType domain.RequestRecipientType) error {
-	return r.UpdateRequestFormWithRecipient(ctx, viewId, recipientType)
+func (r *SlackViewRenderer) RenderTemplateForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDTemplateForm, "New Request Template", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can create templates._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		titleBlock := builder.TextInput(BlockIDTemplateTitle, "Title pattern", "e.g. Access to {{system}} for {{who}}", false, ActionIDTemplateTitle)
+		titleBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Wrap values to ask for in double braces, e.g. {{system}}", NO_EMOJI, NOT_VERBATIM)
+		descriptionBlock := builder.TextInput(BlockIDTemplateDescription, "Description skeleton", "Prefilled description text", true, ActionIDTemplateDescription)
+		descriptionBlock.Optional = true
+		userBlock := builder.UserSelect(BlockIDTemplateUser, "Send to a user instead of the queue", "Choose user", ActionIDTemplateUser)
+		userBlock.Optional = true
+		channelBlock := builder.ChannelSelect(BlockIDTemplateChannel, "Send to a channel instead of the queue", "Choose channel", ActionIDTemplateChannel)
+		channelBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDTemplateQueue, "Queue", "Choose queue", ActionIDTemplateQueue, queueOptions),
+			builder.TextInput(BlockIDTemplateName, "Template name", "e.g. access", false, ActionIDTemplateName),
+			titleBlock,
+			descriptionBlock,
+			userBlock,
+			channelBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open template modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRemoveTemplateForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	templateOptions := []*slack.OptionBlockObject{}
+	for _, queue := range queues {
+		for _, template := range queue.Templates {
+			templateOptions = append(templateOptions, builder.Option(templateOptionValue(queue.ID, template.Key), fmt.Sprintf("%s: %s", queue.Name, template.Name)))
+		}
+	}
+
+	modalRequest := newModalViewRequest(CallbackIDRemoveTemplateForm, "Remove Template", len(templateOptions) > 0)
+
+	switch {
+	case len(queues) == 0:
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can remove templates._"),
+		)
+	case len(templateOptions) == 0:
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_None of your queues have templates. Create one with `/request new-template`._"),
+		)
+	default:
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDRemoveTemplate, "Template", "Choose template", ActionIDRemoveTemplate, templateOptions),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open remove template modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderQueueLabelsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDQueueLabelsForm, "Queue Labels", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can change labels._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		current := make([]string, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+
+			labels := "_no labels_"
+			if len(queue.Labels) > 0 {
+				labels = strings.Join(queue.Labels, ", ")
+			}
+			current[i] = fmt.Sprintf("*%s:* %s", queue.Name, labels)
+		}
+
+		addBlock := builder.TextInput(BlockIDLabelsAdd, "Add labels", "Comma separated, e.g. access, bug", false, ActionIDLabelsAdd)
+		addBlock.Optional = true
+		removeBlock := builder.TextInput(BlockIDLabelsRemove, "Remove labels", "Comma separated", false, ActionIDLabelsRemove)
+		removeBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(strings.Join(current, "\n")),
+			builder.StaticSelect(BlockIDLabelsQueue, "Queue", "Choose queue", ActionIDLabelsQueue, queueOptions),
+			addBlock,
+			removeBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open queue labels modal: %w", err)
+	}
+
+	return nil
 }
 
-func (r *SlackViewRenderer) RenderQueueSelector(ctx context.Context, triggerId string, queues []*domain.Queue) error {
-	return fmt.Errorf("not implemented: RenderQueueSelector")
+func (r *SlackViewRenderer) RenderIntakeFieldsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDIntakeFieldsForm, "Intake Fields", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can change intake fields._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		current := make([]string, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+
+			current[i] = fmt.Sprintf("*%s:* _no intake fields_", queue.Name)
+			if len(queue.IntakeFields) > 0 {
+				lines := make([]string, len(queue.IntakeFields))
+				for j, field := range queue.IntakeFields {
+					lines[j] = intakeFieldSpec(field)
+				}
+				current[i] = fmt.Sprintf("*%s:*\n```%s```", queue.Name, strings.Join(lines, "\n"))
+			}
+		}
+
+		specBlock := builder.TextInput(BlockIDIntakeFieldsSpec, "Intake fields", "One per line, e.g. System: select* = Payments | Billing", true, ActionIDIntakeFieldsSpec)
+		specBlock.Optional = true
+		specBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Format: Label: type, where type is text, number, select, user or date. Add * to make it required and = a | b for select options. Replaces the queue's fields; leave empty to remove them.", NO_EMOJI, NOT_VERBATIM)
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(strings.Join(current, "\n")),
+			builder.StaticSelect(BlockIDIntakeFieldsQueue, "Queue", "Choose queue", ActionIDIntakeFieldsQueue, queueOptions),
+			specBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open intake fields modal: %w", err)
+	}
+
+	return nil
 }
 
-func (r *SlackViewRenderer) RenderRequestList(ctx context.Context, viewId string, requests []*domain.Request) error {
-	return fmt.Errorf("not implemented: RenderRequestList")
+// intakeFieldSpec writes a field the way the intake fields input reads it.
+func intakeFieldSpec(field domain.IntakeField) string {
+	spec := fmt.Sprintf("%s: %s", field.Label, field.Type)
+	if field.Required {
+		spec += "*"
+	}
+	if len(field.Options) > 0 {
+		spec += " = " + strings.Join(field.Options, " | ")
+	}
+	return spec
 }
 
-func (r *SlackViewRenderer) RenderRequestDetail(ctx context.Context, triggerId string, request *domain.Request, userPermissions secondaryports.Permissions) error {
-	return fmt.Errorf("not implemented: RenderRequestDetail")
+func (r *SlackViewRenderer) RenderWorkflowForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDWorkflowForm, "Workflow", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can change workflows._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		current := make([]string, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+
+			current[i] = fmt.Sprintf("*%s:* _default Accept/Complete flow_", queue.Name)
+			if queue.Workflow != nil {
+				current[i] = fmt.Sprintf("*%s:*\n```%s```", queue.Name, workflowSpec(queue.Workflow))
+			}
+		}
+
+		specBlock := builder.TextInput(BlockIDWorkflowSpec, "Workflow", "One status per line, e.g. Triage (open) -> Investigating", true, ActionIDWorkflowSpec)
+		specBlock.Optional = true
+		specBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Format: Status (category) -> Next, Other. Categories are open, in progress and done; the first status must be open. Requests already in the queue keep their workflow. Leave empty for the default Accept/Complete flow.", NO_EMOJI, NOT_VERBATIM)
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(strings.Join(current, "\n")),
+			builder.StaticSelect(BlockIDWorkflowQueue, "Queue", "Choose queue", ActionIDWorkflowQueue, queueOptions),
+			specBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open workflow modal: %w", err)
+	}
+
+	return nil
+}
+
+// workflowSpec writes a workflow the way the workflow input reads it.
+func workflowSpec(workflow *domain.Workflow) string {
+	lines := make([]string, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		line := fmt.Sprintf("%s (%s)", status.Name, strings.ReplaceAll(string(status.Category), "_", " "))
+
+		next := make([]string, 0, len(status.Next))
+		for _, key := range status.Next {
+			if nextStatus, ok := workflow.Status(key); ok {
+				next = append(next, nextStatus.Name)
+			}
+		}
+		if len(next) > 0 {
+			line += " -> " + strings.Join(next, ", ")
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *SlackViewRenderer) RenderApprovalChainForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDApprovalChainForm, "Approval Chain", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can configure approvals._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDApprovalChainQueue, "Queue", "Choose queue", ActionIDApprovalChainQueue, queueOptions),
+			builder.Section("_Stages run in order. Leave a stage without approvers to skip it, or all stages empty to remove approvals._"),
+		)
+
+		ruleOptions := []*slack.OptionBlockObject{
+			builder.Option(string(domain.QuorumAny), "Any one approver"),
+			builder.Option(string(domain.QuorumAll), "All approvers"),
+			builder.Option(string(domain.QuorumCount), "A number of approvers"),
+		}
+
+		for stage := 1; stage <= domain.MaxApprovalStages; stage++ {
+			nameBlock := builder.TextInput(approvalStageBlockID(stage, "name"), "Name", "e.g. Manager", false, ActionIDApprovalStageName)
+			nameBlock.Optional = true
+			approversBlock := builder.MultiUserSelect(approvalStageBlockID(stage, "approvers"), "Approvers", "Select approvers...", ActionIDApprovalStageApprovers)
+			approversBlock.Optional = true
+			ruleBlock := builder.StaticSelect(approvalStageBlockID(stage, "rule"), "Rule", "Any one approver", ActionIDApprovalStageRule, ruleOptions)
+			ruleBlock.Optional = true
+			quorumBlock := builder.NumberInput(approvalStageBlockID(stage, "quorum"), "Approvals needed", "Only for a number of approvers", ActionIDApprovalStageQuorum)
+			quorumBlock.Optional = true
+			timeoutBlock := builder.NumberInput(approvalStageBlockID(stage, "timeout"), "Escalate after (hours)", "Leave empty to never escalate", ActionIDApprovalStageTimeout)
+			timeoutBlock.Optional = true
+			fallbackBlock := builder.UserSelect(approvalStageBlockID(stage, "fallback"), "Fallback approver", "Choose user", ActionIDApprovalStageFallback)
+			fallbackBlock.Optional = true
+
+			modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+				builder.Divider(),
+				builder.Section(fmt.Sprintf("*Stage %d*", stage)),
+				nameBlock,
+				approversBlock,
+				ruleBlock,
+				quorumBlock,
+				timeoutBlock,
+				fallbackBlock,
+			)
+		}
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open approval chain modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderOnCallForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDOnCallForm, "On-call Rotation", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set up on-call rotations._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		membersBlock := builder.MultiUserSelect(BlockIDOnCallMembers, "Rotation", "Select people in on-call order...", ActionIDOnCallMembers)
+		membersBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "People take turns in the order they are selected. Everyone must be a queue member.", NO_EMOJI, NOT_VERBATIM)
+		shiftDaysBlock := builder.NumberInput(BlockIDOnCallShiftDays, "Shift length (days)", "e.g. 7", ActionIDOnCallShiftDays)
+		timeZoneBlock := builder.TextInput(BlockIDOnCallTimeZone, "Time zone", "e.g. Europe/London", false, ActionIDOnCallTimeZone)
+		timeZoneBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Handoffs happen at the same local time in this time zone, including across daylight saving changes.", NO_EMOJI, NOT_VERBATIM)
+		assignmentBlock := builder.StaticSelect(BlockIDOnCallAssignment, "New requests", "Keep the queue's assignment setting", ActionIDOnCallAssignment, []*slack.OptionBlockObject{
+			builder.Option(OnCallKeepOptionValue, "Keep the queue's assignment setting"),
+			builder.Option(OnCallAssignOptionValue, "Assign to whoever is on call"),
+		})
+		assignmentBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDOnCallQueue, "Queue", "Choose queue", ActionIDOnCallQueue, queueOptions),
+			membersBlock,
+			builder.DatePicker(BlockIDOnCallStartDate, "First handoff date", ActionIDOnCallStartDate),
+			builder.TimePicker(BlockIDOnCallHandoff, "Handoff time", ActionIDOnCallHandoff),
+			shiftDaysBlock,
+			timeZoneBlock,
+			assignmentBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open on-call modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderOnCallOverrideForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDOnCallOverrideForm, "Swap On-call", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not in any on-call rotation you can change._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		userBlock := builder.UserSelect(BlockIDOnCallOverrideUser, "Who covers", "Yourself", ActionIDOnCallOverrideUser)
+		userBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDOnCallOverrideQueue, "Queue", "Choose queue", ActionIDOnCallOverrideQueue, queueOptions),
+			userBlock,
+			builder.DateTimePicker(BlockIDOnCallOverrideStart, "From", ActionIDOnCallOverrideStart),
+			builder.DateTimePicker(BlockIDOnCallOverrideEnd, "Until", ActionIDOnCallOverrideEnd),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open on-call override modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderBusinessHoursForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDBusinessHoursForm, "Business Hours", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set business hours._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		timeZoneBlock := builder.TextInput(BlockIDBusinessHoursTimeZone, "Time zone", "e.g. Europe/London", false, ActionIDBusinessHoursTimeZone)
+		hoursBlock := builder.TextInput(BlockIDBusinessHours, "Working hours", "Mon-Fri 09:00-17:30", true, ActionIDBusinessHours)
+		hoursBlock.Optional = true
+		hoursBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "One line per set of days, e.g. Mon-Fri 09:00-12:30, 13:30-17:30 or Sat 10:00-14:00. Leave empty to treat the queue as always open.", NO_EMOJI, NOT_VERBATIM)
+		holidaysBlock := builder.FileInput(BlockIDHolidayCalendar, "Holiday calendar", ActionIDHolidayCalendar, "ics")
+		holidaysBlock.Optional = true
+		holidaysBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Upload an iCal (.ics) file. Every day with an event is treated as a holiday. Without a file the current holidays are kept.", NO_EMOJI, NOT_VERBATIM)
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDBusinessHoursQueue, "Queue", "Choose queue", ActionIDBusinessHoursQueue, queueOptions),
+			timeZoneBlock,
+			hoursBlock,
+			holidaysBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open business hours modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderSLAForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDSLAForm, "SLA Targets", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set SLA targets._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		acceptBlock := builder.TextInput(BlockIDSLAAcceptWithin, "Time to accept", "e.g. 4h", false, ActionIDSLAAcceptWithin)
+		acceptBlock.Optional = true
+		completeBlock := builder.TextInput(BlockIDSLACompleteWithin, "Time to complete", "e.g. 24h or 1h30m", false, ActionIDSLACompleteWithin)
+		completeBlock.Optional = true
+		completeBlock.Hint = slack.NewTextBlockObject(slack.PlainTextType, "Counted from when the request was created, in business time if the queue has business hours. Time on hold is not counted. Leave both targets empty to remove the SLA.", NO_EMOJI, NOT_VERBATIM)
+		atRiskBlock := builder.TextInput(BlockIDSLAAtRisk, "At risk after (%)", fmt.Sprintf("%d", domain.DefaultSLAAtRiskPercent), false, ActionIDSLAAtRisk)
+		atRiskBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDSLAQueue, "Queue", "Choose queue", ActionIDSLAQueue, queueOptions),
+			acceptBlock,
+			completeBlock,
+			atRiskBlock,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open SLA modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderReminderRulesForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDReminderRulesForm, "Reminders", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set up reminders._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDReminderRulesQueue, "Queue", "Choose queue", ActionIDReminderRulesQueue, queueOptions),
+			builder.Section("_Each rule nudges once per request while the queue is open. Leave a rule without a delay to skip it, or all rules empty to turn reminders off._"),
+		)
+
+		conditionOptions := []*slack.OptionBlockObject{
+			builder.Option(string(domain.ReminderPending), "Pending for longer than"),
+			builder.Option(string(domain.ReminderNoUpdate), "Accepted with no update for longer than"),
+		}
+		targetOptions := []*slack.OptionBlockObject{
+			builder.Option(string(domain.ReminderTargetChannel), "The queue channel"),
+			builder.Option(string(domain.ReminderTargetAssignee), "The assignee"),
+			builder.Option(string(domain.ReminderTargetAdmins), "The queue admins"),
+		}
+
+		for rule := 1; rule <= domain.MaxReminderRules; rule++ {
+			conditionBlock := builder.StaticSelect(reminderRuleBlockID(rule, "condition"), "When a request is", "Pending for longer than", ActionIDReminderRuleCondition, conditionOptions)
+			conditionBlock.Optional = true
+			afterBlock := builder.TextInput(reminderRuleBlockID(rule, "after"), "Delay", "e.g. 24h or 3d", false, ActionIDReminderRuleAfter)
+			afterBlock.Optional = true
+			targetBlock := builder.StaticSelect(reminderRuleBlockID(rule, "target"), "Remind", "The queue channel", ActionIDReminderRuleTarget, targetOptions)
+			targetBlock.Optional = true
+
+			modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+				builder.Divider(),
+				builder.Section(fmt.Sprintf("*Rule %d*", rule)),
+				conditionBlock,
+				afterBlock,
+				targetBlock,
+			)
+		}
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open reminders modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderExpiryForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDExpiryForm, "Request Expiry", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set up request expiry._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		afterBlock := builder.TextInput(BlockIDExpiryAfter, "Expire pending requests after", "e.g. 14d", false, ActionIDExpiryAfter)
+		afterBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDExpiryQueue, "Queue", "Choose queue", ActionIDExpiryQueue, queueOptions),
+			afterBlock,
+			builder.Section("_Pending requests with no activity for this long are closed as expired. The requester is warned 48 hours beforehand and can keep the request open. Leave it empty to use the workspace default._"),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open expiry modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderWIPLimitsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDWIPLimitsForm, "WIP Limits", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set work in progress limits._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		perMemberBlock := builder.TextInput(BlockIDWIPPerMember, "Max accepted requests per member", "e.g. 3", false, ActionIDWIPPerMember)
+		perMemberBlock.Optional = true
+		perQueueBlock := builder.TextInput(BlockIDWIPPerQueue, "Max requests in progress for the queue", "e.g. 10", false, ActionIDWIPPerQueue)
+		perQueueBlock.Optional = true
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDWIPQueue, "Queue", "Choose queue", ActionIDWIPQueue, queueOptions),
+			perMemberBlock,
+			perQueueBlock,
+			builder.Section("_Accepted and on hold requests count as in progress. Members at a limit can't accept more until they finish or hand over a request, but queue admins can still assign work past it. Leave a limit empty for no limit._"),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open WIP limits modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRoutingRulesForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRoutingRulesForm, "Routing", len(queues) > 0)
+
+	if len(queues) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You are not an admin of any queue. Only queue admins can set up routing._"),
+		)
+	} else {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.StaticSelect(BlockIDRoutingRulesQueue, "Route into queue", "Choose queue", ActionIDRoutingRulesQueue, queueOptions),
+			builder.Section("_Requests sent to a rule's channel go to this queue instead when they mention one of the keywords, or match a /regular expression/, and the requester is in the user group. Leave a condition empty to skip it, and a rule without a channel to remove it. Try rules out with `/request route-test <title>` in the channel._"),
+		)
+
+		for rule := 1; rule <= domain.MaxRoutingRules; rule++ {
+			channelBlock := builder.ChannelSelect(routingRuleBlockID(rule, "channel"), "Requests sent to", "Choose channel", ActionIDRoutingRuleChannel)
+			channelBlock.Optional = true
+			matchBlock := builder.TextInput(routingRuleBlockID(rule, "match"), "That mention", "e.g. deploy, release or /db-\\d+/", false, ActionIDRoutingRuleMatch)
+			matchBlock.Optional = true
+			groupBlock := builder.TextInput(routingRuleBlockID(rule, "group"), "From members of user group", "e.g. @platform", false, ActionIDRoutingRuleGroup)
+			groupBlock.Optional = true
+
+			modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+				builder.Divider(),
+				builder.Section(fmt.Sprintf("*Rule %d*", rule)),
+				channelBlock,
+				matchBlock,
+				groupBlock,
+			)
+		}
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open routing modal: %w", err)
+	}
+
+	return nil
+}
+
+func routingRuleBlockID(rule int, field string) string {
+	return fmt.Sprintf("%s%d_%s", BlockIDRoutingRulePrefix, rule, field)
+}
+
+func reminderRuleBlockID(rule int, field string) string {
+	return fmt.Sprintf("%s%d_%s", BlockIDReminderRulePrefix, rule, field)
+}
+
+func approvalStageBlockID(stage int, field string) string {
+	return fmt.Sprintf("%s%d_%s", BlockIDApprovalStagePrefix, stage, field)
+}
+
+func (r *SlackViewRenderer) RenderRecurringRequestForm(ctx context.Context, triggerId string, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRecurringRequestForm, "Recurring Request", true)
+
+	titleBlock := builder.TextInput(BlockIDRecurringTitle, "Title", "e.g. Access review for {{quarter}}", false, ActionIDRecurringTitle)
+	descriptionBlock := builder.TextInput(BlockIDRecurringDescription, "Description", "What needs doing each time", true, ActionIDRecurringDescription)
+	descriptionBlock.Optional = true
+
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		titleBlock,
+		descriptionBlock,
+		builder.Section("_The title and description can use {{date}}, {{week}}, {{month}}, {{quarter}} and {{year}}, filled in for each occurrence._"),
+		builder.Divider(),
+		builder.Section("*Send each request to* one queue, user or channel"),
+	)
+
+	if len(queues) > 0 {
+		queueOptions := make([]*slack.OptionBlockObject, len(queues))
+		for i, queue := range queues {
+			queueOptions[i] = builder.Option(queue.ID, queue.Name)
+		}
+		queueBlock := builder.StaticSelect(BlockIDRecurringQueue, "Queue", "Choose queue", ActionIDRecurringQueue, queueOptions)
+		queueBlock.Optional = true
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, queueBlock)
+	}
+
+	userBlock := builder.UserSelect(BlockIDRecurringUser, "User", "Choose user", ActionIDRecurringUser)
+	userBlock.Optional = true
+	channelBlock := builder.ChannelSelect(BlockIDRecurringChannel, "Channel", "Choose channel", ActionIDRecurringChannel)
+	channelBlock.Optional = true
+
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		userBlock,
+		channelBlock,
+		builder.Divider(),
+		builder.TextInput(BlockIDRecurringSchedule, "Schedule", "e.g. 0 9 * * mon or FREQ=MONTHLY;BYDAY=1MO;BYHOUR=9", false, ActionIDRecurringSchedule),
+		builder.Section("_Use a cron expression (`CRON_TZ=Europe/London 0 9 * * 1-5`) or an RRULE (`DTSTART;TZID=Europe/London:20260105T090000 RRULE:FREQ=WEEKLY;INTERVAL=2`). Times are UTC unless a time zone is given._"),
+	)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open recurring request modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRecurringRequestList(ctx context.Context, triggerId string, view secondaryports.RecurringRequestListView) error {
+	_, err := r.client.OpenViewContext(ctx, triggerId, *r.buildRecurringRequestList(view))
+	if err != nil {
+		return fmt.Errorf("failed to open recurring requests modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) UpdateRecurringRequestList(ctx context.Context, viewId string, view secondaryports.RecurringRequestListView) error {
+	_, err := r.client.UpdateViewContext(ctx, *r.buildRecurringRequestList(view), "", "", viewId)
+	if err != nil {
+		return fmt.Errorf("failed to update recurring requests modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) buildRecurringRequestList(view secondaryports.RecurringRequestListView) *slack.ModalViewRequest {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRecurringRequestList, "Recurring Requests", false)
+
+	if len(view.RecurringRequests) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_You have no recurring requests. Create one with `/request recurring new`._"),
+		)
+	}
+
+	for _, recurring := range view.RecurringRequests {
+		text := fmt.Sprintf("*%s* → %s\n`%s`", recurring.Title, recurringRecipientText(recurring, view.QueueNames), recurring.Schedule)
+		switch {
+		case recurring.Paused:
+			text += " · _paused_"
+		case recurring.IsFinished():
+			text += " · _finished_"
+		default:
+			text += fmt.Sprintf(" · next <!date^%d^{date_short_pretty} {time}|%s>", recurring.NextRunAt.Unix(), recurring.NextRunAt.Format(time.RFC1123))
+		}
+		if recurring.CreatedByID != "" {
+			text += fmt.Sprintf(" · by <@%s>", recurring.CreatedByID)
+		}
+		if recurring.LastError != "" {
+			text += fmt.Sprintf("\n⚠️ Last run failed: _%s_", recurring.LastError)
+		}
+
+		toggle := builder.Button(ActionIDPauseRecurringRequest, "Pause", recurring.ID, "")
+		if recurring.Paused {
+			toggle = builder.Button(ActionIDResumeRecurringRequest, "Resume", recurring.ID, slack.StylePrimary)
+		}
+		remove := builder.Button(ActionIDDeleteRecurringRequest, "Delete", recurring.ID, slack.StyleDanger)
+		remove.Confirm = slack.NewConfirmationBlockObject(
+			slack.NewTextBlockObject(slack.PlainTextType, "Delete recurring request?", NO_EMOJI, NOT_VERBATIM),
+			slack.NewTextBlockObject(slack.PlainTextType, fmt.Sprintf("'%s' will stop being created. Requests already created are kept.", truncate(recurring.Title, 100)), NO_EMOJI, NOT_VERBATIM),
+			slack.NewTextBlockObject(slack.PlainTextType, "Delete", NO_EMOJI, NOT_VERBATIM),
+			slack.NewTextBlockObject(slack.PlainTextType, "Cancel", NO_EMOJI, NOT_VERBATIM),
+		)
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(text),
+			builder.Actions(BlockIDRecurringActionsPrefix+recurring.ID, toggle, remove),
+			builder.Divider(),
+		)
+	}
+
+	return modalRequest
+}
+
+func recurringRecipientText(recurring *domain.RecurringRequest, queueNames map[string]string) string {
+	switch recurring.Recipient.Type {
+	case domain.RequestRecipientUser:
+		return fmt.Sprintf("<@%s>", recurring.Recipient.ID)
+	case domain.RequestRecipientChannel:
+		return fmt.Sprintf("<#%s>", recurring.Recipient.ID)
+	default:
+		if name, ok := queueNames[recurring.Recipient.ID]; ok {
+			return "*" + name + "*"
+		}
+		return "_a deleted queue_"
+	}
+}
+
+func (r *SlackViewRenderer) RenderQueueSelector(ctx context.Context, triggerId string, summaries []*domain.QueueSummary) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDQueueBrowser, "Queues", false)
+
+	if len(summaries) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_There are no queues in this channel yet. Create one with `/request new-queue`._"),
+		)
+	}
+
+	queueOptions := make([]*slack.OptionBlockObject, 0, len(summaries))
+	for _, summary := range summaries {
+		text := fmt.Sprintf("*%s* · %d open", summary.Queue.Name, summary.OpenRequests)
+		if counts := labelCountsText(summary.LabelCounts); counts != "" {
+			text += "\n" + counts
+		}
+		if breaches := slaBreachCountsText(summary.SLABreaches); breaches != "" {
+			text += "\n" + breaches
+		}
+		if load := wipLoadText(summary.Queue, summary.Load); load != "" {
+			text += "\n" + load
+		}
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, builder.Section(text))
+		queueOptions = append(queueOptions, builder.Option(summary.Queue.ID, summary.Queue.Name))
+	}
+
+	if len(queueOptions) > 0 {
+		queueSelect := slack.NewOptionsSelectBlockElement(
+			slack.OptTypeStatic,
+			slack.NewTextBlockObject(slack.PlainTextType, "View a queue's requests", NO_EMOJI, NOT_VERBATIM),
+			ActionIDBrowseQueue,
+			queueOptions...,
+		)
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Divider(),
+			builder.Actions(BlockIDQueueBrowserActions, queueSelect),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open queue selector modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRequestList(ctx context.Context, viewId string, view secondaryports.RequestListView) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDQueueBrowser, truncate(view.Queue.Name, 24), false)
+	modalRequest.PrivateMetadata = view.Queue.ID
+
+	if len(view.Queue.Labels) > 0 {
+		options := append([]*slack.OptionBlockObject{builder.Option(AllLabelsOptionValue, "All labels")}, labelOptions(view.Queue.Labels)...)
+		labelSelect := slack.NewOptionsSelectBlockElement(
+			slack.OptTypeStatic,
+			slack.NewTextBlockObject(slack.PlainTextType, "Filter by label", NO_EMOJI, NOT_VERBATIM),
+			ActionIDFilterRequestsLabel,
+			options...,
+		)
+		for _, opt := range options {
+			if opt.Value == view.SelectedLabel {
+				labelSelect.InitialOption = opt
+			}
+		}
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, builder.Actions(BlockIDRequestListActions, labelSelect))
+	}
+
+	if load := wipLoadText(view.Queue, view.Load); load != "" {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, builder.Section(load))
+	}
+
+	if len(view.Requests) == 0 {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, builder.Section("_No open requests._"))
+	}
+
+	for _, request := range view.Requests {
+		text := fmt.Sprintf("*%s*\n%s · created by <@%s>", request.Title, requestStatusText(request), request.CreatedByID)
+		if request.IsBlocked() {
+			text = fmt.Sprintf("⛔ *%s*\n%s · blocked · created by <@%s>", request.Title, requestStatusText(request), request.CreatedByID)
+		}
+		if badge := slaBadgeText(request); badge != "" {
+			text += "\n" + badge
+		}
+		if len(request.Labels) > 0 {
+			text += "\n" + labelsText(request.Labels)
+		}
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, builder.Section(text))
+	}
+
+	_, err := r.client.UpdateViewContext(ctx, *modalRequest, "", "", viewId)
+	if err != nil {
+		return fmt.Errorf("failed to render request list: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRequestDetail(ctx context.Context, triggerId string, request *domain.Request, userPermissions secondaryports.Permissions) error {
+	return fmt.Errorf("not implemented: RenderRequestDetail")
+}
+
+func (r *SlackViewRenderer) RenderApprovalDecisionForm(ctx context.Context, triggerId string, requestId string, approve bool) error {
+	builder := NewBlockBuilder()
+
+	callbackId, title, placeholder := CallbackIDApproveRequest, "Approve request", "Anything the responders should know? (optional)"
+	if !approve {
+		callbackId, title, placeholder = CallbackIDDenyRequest, "Deny request", "Why is this request denied?"
+	}
+
+	commentBlock := builder.TextInput(BlockIDApprovalComment, "Comment", placeholder, true, ActionIDApprovalComment)
+	commentBlock.Optional = approve
+
+	modalRequest := newModalViewRequest(callbackId, title, true)
+	modalRequest.PrivateMetadata = requestId
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet, commentBlock)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open approval modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderAssignForm(ctx context.Context, triggerId string, requestId string) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDAssignRequest, "Assign request", true)
+	modalRequest.PrivateMetadata = requestId
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		builder.UserSelect(BlockIDAssignee, "Assignee", "Choose who works on this request", ActionIDAssignee),
+	)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open assign modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRejectionReasonForm(ctx context.Context, triggerId string, requestId string) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRejectionReason, "Reject request", true)
+	modalRequest.PrivateMetadata = requestId
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		builder.TextInput(BlockIDRejectionReason, "Reason", "Why is this request being rejected?", true, ActionIDRejectionReason),
+	)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open rejection reason modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderHoldQuestionForm(ctx context.Context, triggerId string, requestId string) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDHoldQuestion, "Ask the requester", true)
+	modalRequest.PrivateMetadata = requestId
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		builder.TextInput(BlockIDHoldQuestion, "Question", "What do you need from the requester?", true, ActionIDHoldQuestion),
+	)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open hold question modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderHoldReplyForm(ctx context.Context, triggerId string, request *domain.Request) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDHoldReply, "Reply to question", true)
+	modalRequest.PrivateMetadata = request.ID
+	modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+		builder.Section(fmt.Sprintf("*%s*\n>%s", request.Title, request.HoldQuestion)),
+		builder.TextInput(BlockIDHoldReply, "Reply", "Enter your reply...", true, ActionIDHoldReply),
+	)
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open hold reply modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRequestLabelsForm(ctx context.Context, triggerId string, request *domain.Request, queue *domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRequestLabels, "Labels", true)
+	modalRequest.PrivateMetadata = request.ID
+
+	if len(queue.Labels) == 0 {
+		modalRequest.Submit = nil
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(fmt.Sprintf("_%s has no labels defined yet._", queue.Name)),
+		)
+	} else {
+		options := labelOptions(queue.Labels)
+		labelsSelect := builder.MultiStaticSelect(BlockIDRequestLabels, "Labels", "Choose labels", ActionIDRequestLabels, options)
+		labelsSelect.Optional = true
+
+		element := labelsSelect.Element.(*slack.MultiSelectBlockElement)
+		for _, opt := range options {
+			if request.HasLabel(opt.Value) {
+				element.InitialOptions = append(element.InitialOptions, opt)
+			}
+		}
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(fmt.Sprintf("*%s*", request.Title)),
+			labelsSelect,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open request labels modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderRequestBlockersForm(ctx context.Context, triggerId string, request *domain.Request, candidates []*domain.Request) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDRequestBlockers, "Blocked by", true)
+	modalRequest.PrivateMetadata = request.ID
+
+	options := []*slack.OptionBlockObject{}
+	initial := []*slack.OptionBlockObject{}
+	for _, blocker := range request.BlockedBy {
+		option := builder.Option(blocker.RequestID, truncate(blocker.Title, 75))
+		options = append(options, option)
+		initial = append(initial, option)
+	}
+	for _, candidate := range candidates {
+		if len(options) >= 100 {
+			break
+		}
+		if candidate.ID == request.ID || request.IsBlockedBy(candidate.ID) {
+			continue
+		}
+		options = append(options, builder.Option(candidate.ID, truncate(candidate.Title, 75)))
+	}
+
+	if len(options) == 0 {
+		modalRequest.Submit = nil
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_There are no other open requests that could block this one._"),
+		)
+	} else {
+		blockersSelect := builder.MultiStaticSelect(BlockIDRequestBlockers, "Blocked by", "Choose requests", ActionIDRequestBlockers, options)
+		blockersSelect.Optional = true
+		blockersSelect.Element.(*slack.MultiSelectBlockElement).InitialOptions = initial
+
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(fmt.Sprintf("*%s* can't start until these requests are done:", request.Title)),
+			blockersSelect,
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open request blockers modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderMarkDuplicateForm(ctx context.Context, triggerId string, request *domain.Request, candidates []*domain.Request) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDMarkDuplicate, "Mark as duplicate", true)
+	modalRequest.PrivateMetadata = request.ID
+
+	options := []*slack.OptionBlockObject{}
+	for _, candidate := range candidates {
+		if len(options) >= 100 {
+			break
+		}
+		if candidate.ID == request.ID {
+			continue
+		}
+		options = append(options, builder.Option(candidate.ID, truncate(candidate.Title, 75)))
+	}
+
+	if len(options) == 0 {
+		modalRequest.Submit = nil
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_There are no other open requests to merge this one into._"),
+		)
+	} else {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(fmt.Sprintf("*%s* will be closed and its description and requester moved to the request you choose.", request.Title)),
+			builder.StaticSelect(BlockIDCanonicalRequest, "Duplicate of", "Choose request", ActionIDCanonicalRequest, options),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open mark duplicate modal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SlackViewRenderer) RenderTransferRequestForm(ctx context.Context, triggerId string, request *domain.Request, queues []*domain.Queue) error {
+	builder := NewBlockBuilder()
+
+	modalRequest := newModalViewRequest(CallbackIDTransferRequest, "Move to queue", true)
+	modalRequest.PrivateMetadata = request.ID
+
+	options := []*slack.OptionBlockObject{}
+	for _, queue := range queues {
+		if len(options) >= 100 {
+			break
+		}
+		if queue.ID == request.Recipient.ID {
+			continue
+		}
+		options = append(options, builder.Option(queue.ID, truncate(queue.Name, 75)))
+	}
+
+	if len(options) == 0 {
+		modalRequest.Submit = nil
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section("_There are no other queues to move this request to._"),
+		)
+	} else {
+		modalRequest.Blocks.BlockSet = append(modalRequest.Blocks.BlockSet,
+			builder.Section(fmt.Sprintf("*%s* will be posted in the new queue's channel. It goes back to pending if its assignee isn't a member there, and labels or fields the new queue doesn't have are dropped.", request.Title)),
+			builder.StaticSelect(BlockIDTransferQueue, "Move to", "Choose queue", ActionIDTransferQueue, options),
+			builder.TextInput(BlockIDTransferReason, "Reason", "e.g. This is a billing question", true, ActionIDTransferReason),
+		)
+	}
+
+	_, err := r.client.OpenViewContext(ctx, triggerId, *modalRequest)
+	if err != nil {
+		return fmt.Errorf("failed to open transfer request modal: %w", err)
+	}
+
+	return nil
+}
+
+func labelOptions(labels []string) []*slack.OptionBlockObject {
+	builder := NewBlockBuilder()
+
+	options := make([]*slack.OptionBlockObject, len(labels))
+	for i, label := range labels {
+		options[i] = builder.Option(label, label)
+	}
+	return options
+}
+
+func labelsText(labels []string) string {
+	formatted := make([]string, len(labels))
+	for i, label := range labels {
+		formatted[i] = fmt.Sprintf("`%s`", label)
+	}
+	return strings.Join(formatted, " ")
+}
+
+func labelCountsText(counts map[string]int) string {
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	formatted := make([]string, len(labels))
+	for i, label := range labels {
+		formatted[i] = fmt.Sprintf("`%s` %d", label, counts[label])
+	}
+	return strings.Join(formatted, " · ")
+}
+
+func slaBreachCountsText(counts map[domain.SLATarget]int) string {
+	parts := []string{}
+	for _, target := range []domain.SLATarget{domain.SLATargetAccept, domain.SLATargetComplete} {
+		if counts[target] > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", slaTargetText(target), counts[target]))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("🚨 SLA breaches in the last %d days: %s", domain.SLAReportingDays, strings.Join(parts, " · "))
+}
+
+// wipLoadText shows how much each member has in progress, against the
+// queue's limits when it has them.
+func wipLoadText(queue *domain.Queue, load domain.WIPLoad) string {
+	if load.InProgress == 0 && queue.WIP == nil {
+		return ""
+	}
+
+	withLimit := func(count, limit int) string {
+		if limit > 0 {
+			return fmt.Sprintf("%d/%d", count, limit)
+		}
+		return fmt.Sprintf("%d", count)
+	}
+
+	var perMember, perQueue int
+	if queue.WIP != nil {
+		perMember, perQueue = queue.WIP.PerMember, queue.WIP.PerQueue
+	}
+
+	memberIds := make([]string, 0, len(load.ByMember))
+	for memberId := range load.ByMember {
+		memberIds = append(memberIds, memberId)
+	}
+	sort.Slice(memberIds, func(i, j int) bool {
+		if load.ByMember[memberIds[i]] != load.ByMember[memberIds[j]] {
+			return load.ByMember[memberIds[i]] > load.ByMember[memberIds[j]]
+		}
+		return memberIds[i] < memberIds[j]
+	})
+
+	parts := []string{withLimit(load.InProgress, perQueue) + " in progress"}
+	for _, memberId := range memberIds {
+		parts = append(parts, fmt.Sprintf("<@%s> %s", memberId, withLimit(load.ByMember[memberId], perMember)))
+	}
+	return "🚧 " + strings.Join(parts, " · ")
+}
+
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
 }
 
 var _ secondaryports.ForRenderingModals = (*SlackViewRenderer)(nil)
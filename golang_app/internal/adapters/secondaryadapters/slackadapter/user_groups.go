@@ -0,0 +1,74 @@
+package slackadapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"request/internal/app/ports/secondaryports"
+
+	"github.com/slack-go/slack"
+)
+
+// SlackUserGroups answers user group membership from a cached copy of every
+// group in the workspace, looked up by ID or handle.
+type SlackUserGroups struct {
+	client *slack.Client
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	members   map[string]map[string]bool
+	fetchedAt time.Time
+}
+
+var _ secondaryports.ForCheckingUserGroups = (*SlackUserGroups)(nil)
+
+func NewSlackUserGroups(client *slack.Client, ttl time.Duration) *SlackUserGroups {
+	return &SlackUserGroups{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (g *SlackUserGroups) IsUserGroupMember(ctx context.Context, userGroup, userId string) (bool, error) {
+	g.mu.Lock()
+	members, fetchedAt := g.members, g.fetchedAt
+	g.mu.Unlock()
+
+	if members == nil || time.Since(fetchedAt) > g.ttl {
+		fetched, err := g.fetchGroups(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		members = fetched
+		g.mu.Lock()
+		g.members, g.fetchedAt = fetched, time.Now()
+		g.mu.Unlock()
+	}
+
+	group, ok := members[userGroup]
+	if !ok {
+		return false, fmt.Errorf("user group %s not found", userGroup)
+	}
+	return group[userId], nil
+}
+
+func (g *SlackUserGroups) fetchGroups(ctx context.Context) (map[string]map[string]bool, error) {
+	groups, err := g.client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeUsers(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	members := map[string]map[string]bool{}
+	for _, group := range groups {
+		userIds := map[string]bool{}
+		for _, userId := range group.Users {
+			userIds[userId] = true
+		}
+		members[group.ID] = userIds
+		members[group.Handle] = userIds
+	}
+	return members, nil
+}
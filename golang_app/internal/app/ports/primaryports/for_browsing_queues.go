@@ -8,5 +8,6 @@ import (
 
 type ForBrowsingQueues interface {
 	ListQueuesByChannel(ctx context.Context, channelId string) ([]*domain.Queue, error)
-	GetQueueRequests(ctx context.Context, queueId string, statuses []domain.RequestStatus) ([]*domain.Request, error)
+	ListQueueSummaries(ctx context.Context, channelId string) ([]*domain.QueueSummary, error)
+	GetQueueRequests(ctx context.Context, queueId, userId string, statuses []domain.RequestStatus, labels []string) ([]*domain.Request, error)
 }
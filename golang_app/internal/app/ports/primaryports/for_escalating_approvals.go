@@ -0,0 +1,10 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+)
+
+type ForEscalatingApprovals interface {
+	EscalateOverdueApprovals(ctx context.Context, at time.Time) (escalated int, err error)
+}
@@ -0,0 +1,10 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+)
+
+type ForEvaluatingSLAs interface {
+	EvaluateSLAs(ctx context.Context, at time.Time) (breached int, err error)
+}
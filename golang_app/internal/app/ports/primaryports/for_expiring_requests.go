@@ -0,0 +1,10 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+)
+
+type ForExpiringRequests interface {
+	ExpireStaleRequests(ctx context.Context, at time.Time) (expired int, err error)
+}
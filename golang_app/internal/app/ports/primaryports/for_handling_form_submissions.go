@@ -12,17 +12,34 @@ type ForHandlingFormSubmissions interface {
 }
 
 type RequestFormData struct {
-	Title         string
-	Description   string
-	RecipientID   string
-	RecipientType domain.RequestRecipientType
-	CreatedByID   string
+	Title             string
+	Description       string
+	RecipientID       string
+	RecipientType     domain.RequestRecipientType
+	Labels            []string
+	FieldValues       map[string]string
+	PlaceholderValues map[string]string
+	Checklist         []ChecklistItemData
+	WatcherIDs        []string
+	CreatedByID       string
+}
+
+type ChecklistItemData struct {
+	Text     string
+	Required bool
 }
 
 type QueueFormData struct {
-	Name        string
-	Description string
-	AdminIds    []string
-	ChannelId   string
-	CreatedById string
+	Name               string
+	Description        string
+	AdminIds           []string
+	TriagerIds         []string
+	ResponderIds       []string
+	Labels             []string
+	IntakeFields       []domain.IntakeField
+	Workflow           *domain.Workflow
+	ApproverIds        []string
+	AssignmentStrategy domain.AssignmentStrategy
+	ChannelId          string
+	CreatedById        string
 }
@@ -6,7 +6,9 @@ import (
 )
 
 type ForHandlingRequests interface {
-	OpenNewRequestForm(ctx context.Context, triggerId string) error
+	OpenNewRequestForm(ctx context.Context, triggerId string, templateName string) error
+	SelectRequestTemplate(ctx context.Context, viewId string, queueId, templateKey string) error
+	RefreshNewRequestForm(ctx context.Context, viewId string, recipientType domain.RequestRecipientType, queueId string) error
 	CreateRequest(ctx context.Context, request *domain.Request) error
 	UpdateRequest(ctx context.Context, request *domain.Request) error
 	DeleteRequest(ctx context.Context, requestId string) error
@@ -3,6 +3,7 @@ package primaryports
 import (
 	"context"
 	"request/internal/domain"
+	"time"
 )
 
 type ForManagingQueues interface {
@@ -15,4 +16,24 @@ type ForManagingQueues interface {
 	RemoveQueueAdmin(ctx context.Context, queueId, userId, requestingUserId string) error
 	AddQueueMember(ctx context.Context, queueId, userId, requestingUserId string) error
 	RemoveQueueMember(ctx context.Context, queueId, userId, requestingUserId string) error
+	AddQueueTriager(ctx context.Context, queueId, userId, requestingUserId string) error
+	RemoveQueueTriager(ctx context.Context, queueId, userId, requestingUserId string) error
+	AddQueueLabel(ctx context.Context, queueId, label, requestingUserId string) error
+	RemoveQueueLabel(ctx context.Context, queueId, label, requestingUserId string) error
+	AddQueueTemplate(ctx context.Context, queueId string, template domain.RequestTemplate, requestingUserId string) error
+	RemoveQueueTemplate(ctx context.Context, queueId, name, requestingUserId string) error
+	SetQueueIntakeFields(ctx context.Context, queueId string, fields []domain.IntakeField, requestingUserId string) error
+	SetQueueApprovalChain(ctx context.Context, queueId string, stages []domain.ApprovalStage, requestingUserId string) error
+	SetQueueWorkflow(ctx context.Context, queueId string, workflow *domain.Workflow, requestingUserId string) error
+	SetQueueAssignmentStrategy(ctx context.Context, queueId string, strategy domain.AssignmentStrategy, requestingUserId string) error
+	FindQueueByName(ctx context.Context, name, channelId string) (*domain.Queue, error)
+	SetQueueOnCallRotation(ctx context.Context, queueId string, rotation *domain.OnCallRotation, requestingUserId string) error
+	AddQueueOnCallOverride(ctx context.Context, queueId string, override domain.OnCallOverride, requestingUserId string) error
+	SetQueueBusinessHours(ctx context.Context, queueId string, calendar *domain.BusinessCalendar, holidayFileId, requestingUserId string) error
+	SetQueueSLAPolicy(ctx context.Context, queueId string, policy *domain.SLAPolicy, requestingUserId string) error
+	SetQueueReminderRules(ctx context.Context, queueId string, rules []domain.ReminderRule, requestingUserId string) error
+	SetQueueExpiryPolicy(ctx context.Context, queueId string, policy *domain.ExpiryPolicy, requestingUserId string) error
+	SetQueueWIPLimits(ctx context.Context, queueId string, limits *domain.WIPLimits, requestingUserId string) error
+	SetQueueRoutingRules(ctx context.Context, queueId string, rules []domain.RoutingRule, requestingUserId string) ([]domain.RoutingOverlap, error)
+	GetOnCall(ctx context.Context, queueId string, at time.Time) (current, next *domain.OnCallShift, err error)
 }
@@ -0,0 +1,29 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+
+	"request/internal/domain"
+)
+
+type ForManagingRecurringRequests interface {
+	CreateRecurringRequest(ctx context.Context, data RecurringRequestData) (*domain.RecurringRequest, error)
+	ListRecurringRequests(ctx context.Context, userId string) ([]*domain.RecurringRequest, error)
+	PauseRecurringRequest(ctx context.Context, recurringId, userId string) error
+	ResumeRecurringRequest(ctx context.Context, recurringId, userId string) error
+	DeleteRecurringRequest(ctx context.Context, recurringId, userId string) error
+}
+
+type ForCreatingRecurringRequests interface {
+	CreateDueRequests(ctx context.Context, at time.Time) (created int, err error)
+}
+
+type RecurringRequestData struct {
+	Title         string
+	Description   string
+	RecipientID   string
+	RecipientType domain.RequestRecipientType
+	Schedule      string
+	CreatedByID   string
+}
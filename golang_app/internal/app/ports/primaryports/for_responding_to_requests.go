@@ -6,9 +6,26 @@ import (
 )
 
 type ForRespondingToRequests interface {
+	ApproveRequest(ctx context.Context, requestId, userId, comment string) error
+	DenyRequest(ctx context.Context, requestId, userId, comment string) error
 	AcceptRequest(ctx context.Context, requestId, userId string) error
+	AssignRequest(ctx context.Context, requestId, assigneeId, userId string) error
+	DeclineRequest(ctx context.Context, requestId, userId string) error
 	RejectRequest(ctx context.Context, requestId, userId, reason string) error
 	CompleteRequest(ctx context.Context, requestId, userId string) error
+	JoinRequest(ctx context.Context, requestId, userId string) error
+	TransitionRequest(ctx context.Context, requestId, statusKey, userId string) error
+	TickChecklistItems(ctx context.Context, requestId, userId string, doneItemIds []string) error
+	SetRequestBlockers(ctx context.Context, requestId, userId string, blockerIds []string) error
+	MarkRequestDuplicate(ctx context.Context, requestId, canonicalId, userId string) error
+	TransferRequest(ctx context.Context, requestId, queueId, userId, reason string) error
+	ToggleWatch(ctx context.Context, requestId, userId string) (watching bool, err error)
+	LabelRequest(ctx context.Context, requestId, userId string, labels []string) error
+	PutRequestOnHold(ctx context.Context, requestId, userId, question string) error
+	ResumeRequest(ctx context.Context, requestId, userId, reply string) error
+	ResumeRequestFromThread(ctx context.Context, channelId, threadTs, userId, reply string) error
+	KeepRequestOpen(ctx context.Context, requestId, userId string) error
+	CancelRequest(ctx context.Context, requestId, userId string) error
 	GetRequestDetails(ctx context.Context, requestId string) (*domain.Request, error)
 	ListUserRequests(ctx context.Context, userId string) ([]*domain.Request, error)
 	ListRecipientRequests(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType) ([]*domain.Request, error)
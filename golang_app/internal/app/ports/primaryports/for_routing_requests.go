@@ -0,0 +1,10 @@
+package primaryports
+
+import (
+	"context"
+	"request/internal/domain"
+)
+
+type ForRoutingRequests interface {
+	RouteRequest(ctx context.Context, candidate domain.RoutingCandidate) (match domain.RoutingMatch, ok bool, err error)
+}
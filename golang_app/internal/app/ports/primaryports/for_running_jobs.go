@@ -0,0 +1,21 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+
+	"request/internal/domain"
+)
+
+type ForRunningJobs interface {
+	RunDueJobs(ctx context.Context, at time.Time) (ran int, err error)
+}
+
+type ForSchedulingJobs interface {
+	ScheduleJob(ctx context.Context, name, key, payload string, runAt time.Time) (*domain.Job, error)
+	ScheduleRecurringJob(ctx context.Context, name, key, schedule, payload string) (*domain.Job, error)
+}
+
+type ForInspectingJobs interface {
+	ListJobs(ctx context.Context, statuses []domain.JobStatus) ([]*domain.Job, error)
+}
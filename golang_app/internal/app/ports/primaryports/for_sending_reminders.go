@@ -0,0 +1,16 @@
+package primaryports
+
+import (
+	"context"
+	"time"
+)
+
+type ForSendingReminders interface {
+	SendDueReminders(ctx context.Context, at time.Time) (sent int, err error)
+	SendLaterReminder(ctx context.Context, requestId, userId string) error
+}
+
+type ForSnoozingReminders interface {
+	SnoozeReminders(ctx context.Context, requestId, userId, channelId string) error
+	RemindLater(ctx context.Context, requestId, userId, channelId string, delay time.Duration) error
+}
@@ -0,0 +1,7 @@
+package secondaryports
+
+import "context"
+
+type ForCheckingChannelMembership interface {
+	IsChannelMember(ctx context.Context, channelId, userId string) (bool, error)
+}
@@ -0,0 +1,7 @@
+package secondaryports
+
+import "context"
+
+type ForCheckingUserGroups interface {
+	IsUserGroupMember(ctx context.Context, userGroup, userId string) (bool, error)
+}
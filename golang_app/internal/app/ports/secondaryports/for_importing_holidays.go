@@ -0,0 +1,11 @@
+package secondaryports
+
+import (
+	"context"
+
+	"request/internal/domain"
+)
+
+type ForImportingHolidays interface {
+	ImportHolidays(ctx context.Context, fileId string) ([]domain.Holiday, error)
+}
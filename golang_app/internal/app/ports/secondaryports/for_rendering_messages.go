@@ -2,6 +2,7 @@ package secondaryports
 
 import (
 	"context"
+	"time"
 
 	"request/internal/domain"
 )
@@ -9,4 +10,11 @@ import (
 type ForRenderingMessages interface {
 	RenderRequestNotification(ctx context.Context, channelId string, request *domain.Request) (messageTs string, error error)
 	UpdateRequestNotification(ctx context.Context, channelId string, messageTs string, request *domain.Request) error
+	UpdateTransferredNotification(ctx context.Context, channelId string, messageTs string, request *domain.Request) error
+	RenderApprovalRequest(ctx context.Context, channelId string, request *domain.Request, approverId string) (messageTs string, error error)
+	RenderAssignmentNotice(ctx context.Context, channelId string, request *domain.Request) (messageTs string, error error)
+	UpdateApprovalRequest(ctx context.Context, channelId string, messageTs string, request *domain.Request, approverId string) error
+	RenderHoldQuestion(ctx context.Context, channelId string, request *domain.Request) (messageTs string, error error)
+	RenderReminder(ctx context.Context, channelId string, request *domain.Request, message string) (messageTs string, error error)
+	RenderExpiryWarning(ctx context.Context, channelId string, request *domain.Request, expiresAt time.Time) (messageTs string, error error)
 }
@@ -8,6 +8,7 @@ import (
 
 type RequestFormView struct {
 	RecipientTypeOptions []RecipientTypeOption
+	InitialState         RequestFormState
 }
 
 type RecipientTypeOption struct {
@@ -15,6 +16,31 @@ type RecipientTypeOption struct {
 	Label string
 }
 
+type RequestFormState struct {
+	RecipientType    domain.RequestRecipientType
+	Queues           []*domain.Queue
+	SelectedQueue    *domain.Queue
+	Templates        []RequestTemplateOption
+	SelectedTemplate *RequestTemplateOption
+}
+
+type RequestTemplateOption struct {
+	Queue    *domain.Queue
+	Template domain.RequestTemplate
+}
+
+type RequestListView struct {
+	Queue         *domain.Queue
+	SelectedLabel string
+	Requests      []*domain.Request
+	Load          domain.WIPLoad
+}
+
+type RecurringRequestListView struct {
+	RecurringRequests []*domain.RecurringRequest
+	QueueNames        map[string]string
+}
+
 type QueueFormView struct {
 	InitialName        string
 	InitialDescription string
@@ -24,13 +50,41 @@ type Permissions struct {
 	CanAccept   bool
 	CanReject   bool
 	CanComplete bool
+	CanHold     bool
+	CanResume   bool
 }
 
 type ForRenderingModals interface {
 	RenderRequestForm(ctx context.Context, triggerId string, view RequestFormView) error
-	UpdateRequestForm(ctx context.Context, viewId string, recipientType domain.RequestRecipientType) error
+	UpdateRequestForm(ctx context.Context, viewId string, state RequestFormState) error
 	RenderQueueForm(ctx context.Context, triggerId string, view QueueFormView) error
-	RenderQueueSelector(ctx context.Context, triggerId string, queues []*domain.Queue) error
-	RenderRequestList(ctx context.Context, viewId string, requests []*domain.Request) error
+	RenderTemplateForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderRemoveTemplateForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderQueueLabelsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderIntakeFieldsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderWorkflowForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderApprovalChainForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderOnCallForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderOnCallOverrideForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderBusinessHoursForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderSLAForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderReminderRulesForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderExpiryForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderWIPLimitsForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderRoutingRulesForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderRecurringRequestForm(ctx context.Context, triggerId string, queues []*domain.Queue) error
+	RenderRecurringRequestList(ctx context.Context, triggerId string, view RecurringRequestListView) error
+	UpdateRecurringRequestList(ctx context.Context, viewId string, view RecurringRequestListView) error
+	RenderQueueSelector(ctx context.Context, triggerId string, summaries []*domain.QueueSummary) error
+	RenderRequestList(ctx context.Context, viewId string, view RequestListView) error
 	RenderRequestDetail(ctx context.Context, triggerId string, request *domain.Request, userPermissions Permissions) error
+	RenderApprovalDecisionForm(ctx context.Context, triggerId string, requestId string, approve bool) error
+	RenderAssignForm(ctx context.Context, triggerId string, requestId string) error
+	RenderRejectionReasonForm(ctx context.Context, triggerId string, requestId string) error
+	RenderHoldQuestionForm(ctx context.Context, triggerId string, requestId string) error
+	RenderHoldReplyForm(ctx context.Context, triggerId string, request *domain.Request) error
+	RenderRequestLabelsForm(ctx context.Context, triggerId string, request *domain.Request, queue *domain.Queue) error
+	RenderMarkDuplicateForm(ctx context.Context, triggerId string, request *domain.Request, candidates []*domain.Request) error
+	RenderTransferRequestForm(ctx context.Context, triggerId string, request *domain.Request, queues []*domain.Queue) error
+	RenderRequestBlockersForm(ctx context.Context, triggerId string, request *domain.Request, candidates []*domain.Request) error
 }
@@ -0,0 +1,22 @@
+package secondaryports
+
+import (
+	"context"
+	"time"
+
+	"request/internal/domain"
+)
+
+type ForStoringJobs interface {
+	// Save inserts new jobs, failing with domain.ErrJobExists when the key is
+	// taken, and updates existing ones only if nobody else saved them since
+	// they were read, failing with domain.ErrJobConflict otherwise.
+	Save(ctx context.Context, job *domain.Job) error
+}
+
+type ForReadingJobs interface {
+	GetById(ctx context.Context, jobId string) (*domain.Job, error)
+	FindByKey(ctx context.Context, key string) (*domain.Job, error)
+	FindByStatuses(ctx context.Context, statuses []domain.JobStatus) ([]*domain.Job, error)
+	FindDue(ctx context.Context, at time.Time, limit int) ([]*domain.Job, error)
+}
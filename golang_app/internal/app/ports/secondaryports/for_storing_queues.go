@@ -7,6 +7,7 @@ import (
 
 type ForStoringQueues interface {
 	Save(ctx context.Context, request *domain.Queue) error
+	RecordLastAssignee(ctx context.Context, queueId, previousId, userId string) error
 }
 
 type ForReadingQueues interface {
@@ -0,0 +1,21 @@
+package secondaryports
+
+import (
+	"context"
+	"time"
+
+	"request/internal/domain"
+)
+
+type ForStoringRecurringRequests interface {
+	Save(ctx context.Context, recurring *domain.RecurringRequest) error
+	ClaimOccurrence(ctx context.Context, recurring *domain.RecurringRequest, occurrence time.Time) error
+	RecordLastError(ctx context.Context, recurringId, lastError string) error
+	Delete(ctx context.Context, recurringId string) error
+}
+
+type ForReadingRecurringRequests interface {
+	GetById(ctx context.Context, recurringId string) (*domain.RecurringRequest, error)
+	FindAll(ctx context.Context) ([]*domain.RecurringRequest, error)
+	FindDue(ctx context.Context, at time.Time) ([]*domain.RecurringRequest, error)
+}
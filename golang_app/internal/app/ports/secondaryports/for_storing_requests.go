@@ -3,16 +3,30 @@ package secondaryports
 import (
 	"context"
 	"request/internal/domain"
+	"time"
 )
 
 type ForStoringRequests interface {
 	Save(ctx context.Context, request *domain.Request) error
+	RecordSLAState(ctx context.Context, requestId string, state domain.SLAState, breaches []domain.SLABreach) error
+	RecordSentReminders(ctx context.Context, requestId string, sent []domain.SentReminder) error
+	RecordRemindersSnoozed(ctx context.Context, requestId string, until time.Time) error
+	RecordExpiry(ctx context.Context, request *domain.Request, lastUpdatedAt time.Time) error
+	RecordExpiryWarning(ctx context.Context, requestId string, at time.Time) error
 }
 
 type ForReadingRequests interface {
 	GetById(ctx context.Context, requestId string) (*domain.Request, error)
 	FindByCreatedById(ctx context.Context, createdById string) ([]*domain.Request, error)
 	FindByAcceptedById(ctx context.Context, acceptedById string) ([]*domain.Request, error)
+	FindByCollaboratorId(ctx context.Context, userId string) ([]*domain.Request, error)
 	FindByRecipient(ctx context.Context, recipient domain.RequestRecipient) ([]*domain.Request, error)
-	FindByRecipientAndStatuses(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType, statuses []domain.RequestStatus) ([]*domain.Request, error)
+	FindByHoldMessage(ctx context.Context, channelId, messageTs string) (*domain.Request, error)
+	FindByRecipientAndStatuses(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType, statuses []domain.RequestStatus, labels []string) ([]*domain.Request, error)
+	FindByStatuses(ctx context.Context, statuses []domain.RequestStatus) ([]*domain.Request, error)
+	FindBlockedBy(ctx context.Context, blockerId string) ([]*domain.Request, error)
+	LoadDependencyGraph(ctx context.Context, requestIds []string) (domain.DependencyGraph, error)
+	CountLabelsByRecipient(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType, statuses []domain.RequestStatus) (map[string]int, error)
+	FindByRecipientResolvedSince(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType, since time.Time) ([]*domain.Request, error)
+	CountSLABreachesByRecipient(ctx context.Context, recipientId string, recipientType domain.RequestRecipientType, since time.Time) (map[domain.SLATarget]int, error)
 }
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+)
+
+const maxAssignmentAttempts = 3
+
+func autoAssignRequest(
+	ctx context.Context,
+	requestsReader secondaryports.ForReadingRequests,
+	queuesWriter secondaryports.ForStoringQueues,
+	queuesReader secondaryports.ForReadingQueues,
+	queue *domain.Queue,
+	request *domain.Request,
+) (string, bool) {
+	if queue == nil || !queue.AutoAssigns() || request.Status != domain.RequestPending {
+		return "", false
+	}
+
+	var openCounts map[string]int
+	var load domain.WIPLoad
+	if queue.AssignmentStrategy == domain.AssignmentLeastOpen || queue.WIP != nil {
+		openRequests, err := requestsReader.FindByRecipientAndStatuses(ctx, queue.ID, domain.RequestRecipientQueue, domain.OpenRequestStatuses(), nil)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to count open assignments",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queue.ID))
+			return "", false
+		}
+		openCounts = countOpenAssignments(openRequests)
+		load = domain.NewWIPLoad(openRequests)
+	}
+
+	var assigneeId string
+	for attempt := 1; ; attempt++ {
+		var ok bool
+		assigneeId, ok = queue.NextAssignee(request, time.Now(), openCounts, load, rand.IntN)
+		if !ok {
+			slog.InfoContext(ctx, "No member available for auto-assignment",
+				slog.String("queueId", queue.ID),
+				slog.String("requestId", request.ID))
+			return "", false
+		}
+
+		if queue.AssignmentStrategy != domain.AssignmentRoundRobin {
+			break
+		}
+
+		err := queuesWriter.RecordLastAssignee(ctx, queue.ID, queue.LastAssigneeID, assigneeId)
+		if errors.Is(err, domain.ErrAssignmentConflict) && attempt < maxAssignmentAttempts {
+			current, err := queuesReader.GetById(ctx, queue.ID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to reload queue assignment state",
+					slog.String("err", err.Error()),
+					slog.String("queueId", queue.ID))
+				return "", false
+			}
+			queue.LastAssigneeID = current.LastAssigneeID
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to save queue assignment state",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queue.ID))
+		}
+		break
+	}
+
+	if err := request.Accept(assigneeId); err != nil {
+		slog.ErrorContext(ctx, "Failed to auto-assign request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return "", false
+	}
+
+	queue.RecordAssignment(assigneeId)
+
+	slog.InfoContext(ctx, "Request auto-assigned",
+		slog.String("requestId", request.ID),
+		slog.String("queueId", queue.ID),
+		slog.String("strategy", string(queue.AssignmentStrategy)),
+		slog.String("assigneeId", assigneeId))
+
+	return assigneeId, true
+}
+
+func countOpenAssignments(requests []*domain.Request) map[string]int {
+	counts := map[string]int{}
+	for _, request := range requests {
+		for _, assigneeId := range request.AssigneeIDs() {
+			counts[assigneeId]++
+		}
+	}
+	return counts
+}
+
+// Queue admins can go over the work in progress limits.
+func checkWIPLimit(
+	ctx context.Context,
+	requestsReader secondaryports.ForReadingRequests,
+	queue *domain.Queue,
+	assigneeId string,
+	actorId string,
+) error {
+	if queue == nil || queue.WIP == nil {
+		return nil
+	}
+
+	inProgress, err := requestsReader.FindByRecipientAndStatuses(ctx, queue.ID, domain.RequestRecipientQueue, domain.InProgressStatuses(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to find in progress queue requests: %w", err)
+	}
+
+	err = queue.CheckWIPLimit(assigneeId, domain.NewWIPLoad(inProgress))
+	if err == nil {
+		return nil
+	}
+
+	if queue.CanOverrideWIPLimit(actorId) {
+		slog.InfoContext(ctx, "Work in progress limit overridden",
+			slog.String("queueId", queue.ID),
+			slog.String("assigneeId", assigneeId),
+			slog.String("overriddenBy", actorId),
+			slog.String("limit", err.Error()))
+		return nil
+	}
+
+	return err
+}
+
+func sendAssignmentNotice(
+	ctx context.Context,
+	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+	request *domain.Request,
+	assigneeId string,
+) {
+	channelId, _, err := messenger.SendDirectMessage(ctx, assigneeId, "")
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to open DM with assignee",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID),
+			slog.String("assigneeId", assigneeId))
+		return
+	}
+
+	if _, err := msgRenderer.RenderAssignmentNotice(ctx, channelId, request); err != nil {
+		slog.ErrorContext(ctx, "Failed to send assignment notice",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID),
+			slog.String("assigneeId", assigneeId))
+	}
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"request/internal/app/ports/primaryports"
 	"request/internal/app/ports/secondaryports"
@@ -14,24 +16,33 @@ import (
 
 type FormSubmissionService struct {
 	requestsWriter secondaryports.ForStoringRequests
+	requestsReader secondaryports.ForReadingRequests
 	queuesWriter   secondaryports.ForStoringQueues
+	queuesReader   secondaryports.ForReadingQueues
 	messenger      secondaryports.ForMessagingUsers
 	msgRenderer    secondaryports.ForRenderingMessages
+	router         primaryports.ForRoutingRequests
 }
 
 var _ primaryports.ForHandlingFormSubmissions = (*FormSubmissionService)(nil)
 
 func NewFormSubmissionService(
 	requestsWriter secondaryports.ForStoringRequests,
+	requestsReader secondaryports.ForReadingRequests,
 	queuesWriter secondaryports.ForStoringQueues,
+	queuesReader secondaryports.ForReadingQueues,
 	messenger secondaryports.ForMessagingUsers,
 	msgRenderer secondaryports.ForRenderingMessages,
+	router primaryports.ForRoutingRequests,
 ) *FormSubmissionService {
 	return &FormSubmissionService{
 		requestsWriter: requestsWriter,
+		requestsReader: requestsReader,
 		queuesWriter:   queuesWriter,
+		queuesReader:   queuesReader,
 		messenger:      messenger,
 		msgRenderer:    msgRenderer,
+		router:         router,
 	}
 }
 
@@ -51,6 +62,21 @@ func (s *FormSubmissionService) HandleRequestFormSubmission(
 		return fmt.Errorf("invalid recipient type")
 	}
 
+	if len(formData.PlaceholderValues) > 0 {
+		title, err := domain.FillPlaceholders(formData.Title, formData.PlaceholderValues)
+		if err != nil {
+			return fmt.Errorf("invalid title: %w", err)
+		}
+
+		description, err := domain.FillPlaceholders(formData.Description, formData.PlaceholderValues)
+		if err != nil {
+			return fmt.Errorf("invalid description: %w", err)
+		}
+
+		formData.Title = title
+		formData.Description = description
+	}
+
 	recipient := &domain.RequestRecipient{
 		ID:   formData.RecipientID,
 		Type: formData.RecipientType,
@@ -68,6 +94,66 @@ func (s *FormSubmissionService) HandleRequestFormSubmission(
 
 	request.Description = formData.Description
 
+	for _, watcherId := range formData.WatcherIDs {
+		if err := request.AddWatcher(watcherId); err != nil && !errors.Is(err, domain.ErrAlreadyFollowing) {
+			return fmt.Errorf("invalid watchers: %w", err)
+		}
+	}
+
+	if len(formData.Checklist) > 0 {
+		items := make([]domain.ChecklistItem, len(formData.Checklist))
+		for i, itemData := range formData.Checklist {
+			item, err := domain.NewChecklistItem(uuid.New().String(), itemData.Text, itemData.Required)
+			if err != nil {
+				return fmt.Errorf("invalid checklist: %w", err)
+			}
+			items[i] = item
+		}
+
+		if err := request.SetChecklist(items); err != nil {
+			return fmt.Errorf("invalid checklist: %w", err)
+		}
+	}
+
+	if request.Recipient.Type == domain.RequestRecipientChannel {
+		s.routeRequest(ctx, &request)
+	}
+
+	var assigneeId string
+	var queue *domain.Queue
+	if request.Recipient.Type == domain.RequestRecipientQueue {
+		queue, err = s.queuesReader.GetById(ctx, request.Recipient.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get queue: %w", err)
+		}
+
+		if err := request.SetFieldValues(formData.FieldValues, queue); err != nil {
+			return fmt.Errorf("invalid intake fields: %w", err)
+		}
+
+		if err := request.SetLabels(formData.Labels, queue); err != nil {
+			return fmt.Errorf("invalid labels: %w", err)
+		}
+
+		if err := request.UseWorkflow(queue.Workflow); err != nil {
+			return fmt.Errorf("failed to apply queue workflow: %w", err)
+		}
+
+		if queue.RequiresApproval() {
+			if err := request.RequireApproval(queue.ApprovalChain, queue.AdminIds); err != nil {
+				slog.WarnContext(ctx, "Request rejected by queue approval chain",
+					slog.String("err", err.Error()),
+					slog.String("queueId", queue.ID),
+					slog.String("createdBy", request.CreatedByID))
+				return fmt.Errorf("failed to require approval: %w", err)
+			}
+		}
+
+		assigneeId, _ = autoAssignRequest(ctx, s.requestsReader, s.queuesWriter, s.queuesReader, queue, &request)
+	} else if len(formData.Labels) > 0 || len(formData.FieldValues) > 0 {
+		return fmt.Errorf("labels and intake fields can only be supplied for queue requests")
+	}
+
 	if err := s.requestsWriter.Save(ctx, &request); err != nil {
 		slog.ErrorContext(ctx, "Failed to save request",
 			slog.String("err", err.Error()),
@@ -81,15 +167,92 @@ func (s *FormSubmissionService) HandleRequestFormSubmission(
 		slog.String("recipientType", string(request.Recipient.Type)),
 		slog.String("recipientId", request.Recipient.ID))
 
-	if err := s.sendNotification(ctx, &request); err != nil {
+	if queue != nil && !queue.IsOpenAt(request.CreatedAt) {
+		s.sendOutOfHoursReply(ctx, queue, &request)
+	}
+
+	if request.Status == domain.RequestAwaitingApproval {
+		s.requestApproval(ctx, &request)
+		return nil
+	}
+
+	if assigneeId != "" {
+		sendAssignmentNotice(ctx, s.messenger, s.msgRenderer, &request, assigneeId)
+	}
+
+	notification, err := s.sendNotification(ctx, &request)
+	if err != nil {
 		slog.ErrorContext(ctx, "Failed to send notification",
 			slog.String("err", err.Error()),
 			slog.String("requestId", request.ID))
+		return nil
+	}
+
+	request.Notification = notification
+	if err := s.requestsWriter.Save(ctx, &request); err != nil {
+		slog.ErrorContext(ctx, "Failed to save request notification reference",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
 	}
 
 	return nil
 }
 
+func (s *FormSubmissionService) routeRequest(ctx context.Context, request *domain.Request) {
+	match, ok, err := s.router.RouteRequest(ctx, domain.RoutingCandidate{
+		ChannelID:   request.Recipient.ID,
+		CreatorID:   request.CreatedByID,
+		Title:       request.Title,
+		Description: request.Description,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to route request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return
+	}
+	if !ok {
+		return
+	}
+
+	if err := request.RouteTo(match); err != nil {
+		slog.ErrorContext(ctx, "Failed to route request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return
+	}
+
+	slog.InfoContext(ctx, "Request routed to queue",
+		slog.String("requestId", request.ID),
+		slog.String("channelId", request.RoutedFromChannelID),
+		slog.String("queueId", match.Queue.ID),
+		slog.String("reason", match.Reason))
+}
+
+func (s *FormSubmissionService) sendOutOfHoursReply(ctx context.Context, queue *domain.Queue, request *domain.Request) {
+	message := fmt.Sprintf("Thanks for your request '%s'. *%s* is outside working hours right now", request.Title, queue.Name)
+	if opening, ok := queue.BusinessHours.NextOpening(request.CreatedAt); ok {
+		local := opening.In(queue.BusinessHours.Location())
+		message += fmt.Sprintf(", we'll pick this up at %s (%s).", local.Format("15:04 on Monday 2 January"), queue.BusinessHours.TimeZone)
+	} else {
+		message += "."
+	}
+
+	_, _, err := s.messenger.SendDirectMessage(ctx, request.CreatedByID, message)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to send out-of-hours reply",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID),
+			slog.String("queueId", queue.ID))
+		return
+	}
+
+	slog.InfoContext(ctx, "Sent out-of-hours reply",
+		slog.String("requestId", request.ID),
+		slog.String("queueId", queue.ID),
+		slog.Time("submittedAt", request.CreatedAt))
+}
+
 func (s *FormSubmissionService) HandleQueueFormSubmission(
 	ctx context.Context,
 	formData primaryports.QueueFormData,
@@ -115,6 +278,34 @@ func (s *FormSubmissionService) HandleQueueFormSubmission(
 	queue.ChannelId = formData.ChannelId
 	queue.Description = formData.Description
 
+	if err := queue.SetIntakeFields(formData.IntakeFields); err != nil {
+		return fmt.Errorf("invalid intake fields: %w", err)
+	}
+
+	queue.SetWorkflow(formData.Workflow)
+
+	if err := queue.SetAssignmentStrategy(formData.AssignmentStrategy); err != nil {
+		return fmt.Errorf("invalid assignment strategy: %w", err)
+	}
+
+	if len(formData.ApproverIds) > 0 {
+		stage, err := domain.NewApprovalStage("Approval", formData.ApproverIds, domain.QuorumAny, 0, 0, "")
+		if err != nil {
+			return fmt.Errorf("invalid approvers: %w", err)
+		}
+		if err := queue.SetApprovalChain([]domain.ApprovalStage{stage}); err != nil {
+			return fmt.Errorf("invalid approvers: %w", err)
+		}
+	}
+
+	for _, label := range formData.Labels {
+		if err := queue.AddLabel(label); err != nil {
+			slog.WarnContext(ctx, "Failed to add label to queue",
+				slog.String("err", err.Error()),
+				slog.String("label", label))
+		}
+	}
+
 	for _, adminId := range formData.AdminIds {
 		if adminId != formData.CreatedById {
 			if err := queue.AddAdmin(adminId); err != nil {
@@ -125,6 +316,22 @@ func (s *FormSubmissionService) HandleQueueFormSubmission(
 		}
 	}
 
+	for _, triagerId := range formData.TriagerIds {
+		if err := queue.AddTriager(triagerId); err != nil {
+			slog.WarnContext(ctx, "Failed to add triager to queue",
+				slog.String("err", err.Error()),
+				slog.String("triagerId", triagerId))
+		}
+	}
+
+	for _, responderId := range formData.ResponderIds {
+		if err := queue.AddMember(responderId); err != nil {
+			slog.WarnContext(ctx, "Failed to add responder to queue",
+				slog.String("err", err.Error()),
+				slog.String("responderId", responderId))
+		}
+	}
+
 	if err := s.queuesWriter.Save(ctx, &queue); err != nil {
 		slog.ErrorContext(ctx, "Failed to save queue",
 			slog.String("err", err.Error()),
@@ -136,19 +343,80 @@ func (s *FormSubmissionService) HandleQueueFormSubmission(
 		slog.String("queueId", queue.ID),
 		slog.String("createdBy", queue.CreatedById),
 		slog.String("channelId", queue.ChannelId),
-		slog.Int("adminCount", len(queue.AdminIds)))
+		slog.Int("adminCount", len(queue.AdminIds)),
+		slog.Int("labelCount", len(queue.Labels)),
+		slog.Int("intakeFieldCount", len(queue.IntakeFields)))
 
 	return nil
 }
 
-func (s *FormSubmissionService) sendNotification(ctx context.Context, request *domain.Request) error {
+func (s *FormSubmissionService) requestApproval(ctx context.Context, request *domain.Request) {
+	sendApprovalRequests(ctx, s.messenger, s.msgRenderer, request, request.CurrentApproverIDs())
+
+	if err := s.requestsWriter.Save(ctx, request); err != nil {
+		slog.ErrorContext(ctx, "Failed to save approval message references",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+
+	message := fmt.Sprintf("Your request '%s' is awaiting approval from %s", request.Title, mentions(request.CurrentApproverIDs()))
+	if _, _, err := s.messenger.SendDirectMessage(ctx, request.CreatedByID, message); err != nil {
+		slog.ErrorContext(ctx, "Failed to notify creator of pending approval",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func sendApprovalRequests(
+	ctx context.Context,
+	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+	request *domain.Request,
+	approverIds []string,
+) {
+	for _, approverId := range approverIds {
+		channelId, _, err := messenger.SendDirectMessage(ctx, approverId, "")
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to open DM with approver",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("approverId", approverId))
+			continue
+		}
+
+		messageTs, err := msgRenderer.RenderApprovalRequest(ctx, channelId, request, approverId)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to send approval request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("approverId", approverId))
+			continue
+		}
+
+		request.ApprovalMessages = append(request.ApprovalMessages, domain.ApprovalMessage{
+			ApproverID: approverId,
+			Message:    domain.MessageRef{ChannelID: channelId, Ts: messageTs},
+		})
+	}
+}
+
+func (s *FormSubmissionService) sendNotification(ctx context.Context, request *domain.Request) (*domain.MessageRef, error) {
+	return sendRequestNotification(ctx, s.messenger, s.msgRenderer, request)
+}
+
+func sendRequestNotification(
+	ctx context.Context,
+	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+	request *domain.Request,
+) (*domain.MessageRef, error) {
 	var channelId string
 
 	switch request.Recipient.Type {
 	case domain.RequestRecipientUser:
-		channel, _, err := s.messenger.SendDirectMessage(ctx, request.Recipient.ID, "")
+		channel, _, err := messenger.SendDirectMessage(ctx, request.Recipient.ID, "")
 		if err != nil {
-			return fmt.Errorf("failed to open DM channel: %w", err)
+			return nil, fmt.Errorf("failed to open DM channel: %w", err)
 		}
 		channelId = channel
 
@@ -156,13 +424,21 @@ func (s *FormSubmissionService) sendNotification(ctx context.Context, request *d
 		channelId = request.Recipient.ID
 
 	default:
-		return fmt.Errorf("unknown recipient type: %s", request.Recipient.Type)
+		return nil, fmt.Errorf("unknown recipient type: %s", request.Recipient.Type)
 	}
 
-	_, err := s.msgRenderer.RenderRequestNotification(ctx, channelId, request)
+	messageTs, err := msgRenderer.RenderRequestNotification(ctx, channelId, request)
 	if err != nil {
-		return fmt.Errorf("failed to render notification: %w", err)
+		return nil, fmt.Errorf("failed to render notification: %w", err)
 	}
 
-	return nil
+	return &domain.MessageRef{ChannelID: channelId, Ts: messageTs}, nil
+}
+
+func mentions(userIds []string) string {
+	tags := make([]string, len(userIds))
+	for i, userId := range userIds {
+		tags[i] = fmt.Sprintf("<@%s>", userId)
+	}
+	return strings.Join(tags, ", ")
 }
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	jobBatchSize       = 20
+	maxJobSaveAttempts = 3
+)
+
+type JobHandler func(ctx context.Context, job *domain.Job) error
+
+type JobScheduler struct {
+	jobsWriter secondaryports.ForStoringJobs
+	jobsReader secondaryports.ForReadingJobs
+	workerId   string
+	lease      time.Duration
+	handlers   map[string]JobHandler
+}
+
+var _ primaryports.ForRunningJobs = (*JobScheduler)(nil)
+var _ primaryports.ForSchedulingJobs = (*JobScheduler)(nil)
+var _ primaryports.ForInspectingJobs = (*JobScheduler)(nil)
+
+func NewJobScheduler(
+	jobsWriter secondaryports.ForStoringJobs,
+	jobsReader secondaryports.ForReadingJobs,
+	workerId string,
+	lease time.Duration,
+) *JobScheduler {
+	return &JobScheduler{
+		jobsWriter: jobsWriter,
+		jobsReader: jobsReader,
+		workerId:   workerId,
+		lease:      lease,
+		handlers:   map[string]JobHandler{},
+	}
+}
+
+// Register must be called before the scheduler starts running jobs.
+func (s *JobScheduler) Register(name string, handler JobHandler) {
+	s.handlers[name] = handler
+}
+
+func (s *JobScheduler) ScheduleJob(ctx context.Context, name, key, payload string, runAt time.Time) (*domain.Job, error) {
+	job, err := domain.NewJob(uuid.New().String(), name, key, payload, runAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.jobsWriter.Save(ctx, job); err != nil {
+		if errors.Is(err, domain.ErrJobExists) {
+			return s.jobsReader.FindByKey(ctx, job.Key)
+		}
+		return nil, fmt.Errorf("failed to schedule job: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Job scheduled",
+		slog.String("jobId", job.ID),
+		slog.String("name", name),
+		slog.String("key", job.Key),
+		slog.Time("runAt", job.RunAt))
+
+	return job, nil
+}
+
+// ScheduleRecurringJob is idempotent per key, so every replica can call it on
+// start-up. A changed schedule replaces the existing one once it isn't running.
+func (s *JobScheduler) ScheduleRecurringJob(ctx context.Context, name, key, schedule, payload string) (*domain.Job, error) {
+	job, err := domain.NewRecurringJob(uuid.New().String(), name, key, schedule, payload, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.jobsWriter.Save(ctx, job)
+	if err == nil {
+		slog.InfoContext(ctx, "Recurring job scheduled",
+			slog.String("jobId", job.ID),
+			slog.String("name", name),
+			slog.String("schedule", job.Schedule))
+		return job, nil
+	}
+
+	if !errors.Is(err, domain.ErrJobExists) {
+		return nil, fmt.Errorf("failed to schedule job: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		existing, err := s.jobsReader.FindByKey(ctx, job.Key)
+		if err != nil || existing == nil {
+			return nil, fmt.Errorf("failed to load existing job %s: %w", job.Key, err)
+		}
+
+		if existing.UsesSchedule(job.Schedule, payload) {
+			return existing, nil
+		}
+
+		if err := existing.ChangeSchedule(job.Schedule, payload, time.Now()); err != nil {
+			return nil, err
+		}
+
+		err = s.jobsWriter.Save(ctx, existing)
+		if errors.Is(err, domain.ErrJobConflict) && attempt < maxJobSaveAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to update job schedule: %w", err)
+		}
+
+		if existing.PendingSchedule != "" {
+			s.logDeferredSchedule(ctx, existing, job.Schedule)
+			return existing, nil
+		}
+
+		slog.InfoContext(ctx, "Recurring job rescheduled",
+			slog.String("jobId", existing.ID),
+			slog.String("name", name),
+			slog.String("schedule", existing.Schedule))
+
+		return existing, nil
+	}
+}
+
+func (s *JobScheduler) logDeferredSchedule(ctx context.Context, job *domain.Job, schedule string) {
+	slog.InfoContext(ctx, "Recurring job is running, schedule change deferred",
+		slog.String("jobId", job.ID),
+		slog.String("name", job.Name),
+		slog.String("schedule", schedule))
+}
+
+func (s *JobScheduler) ListJobs(ctx context.Context, statuses []domain.JobStatus) ([]*domain.Job, error) {
+	jobs, err := s.jobsReader.FindByStatuses(ctx, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// RunDueJobs claims due jobs one at a time and runs them. Claims go through
+// the store's optimistic locking, so a job another replica claimed first is
+// skipped.
+func (s *JobScheduler) RunDueJobs(ctx context.Context, at time.Time) (int, error) {
+	jobs, err := s.jobsReader.FindDue(ctx, at, jobBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due jobs: %w", err)
+	}
+
+	ran := 0
+	for _, job := range jobs {
+		handler, ok := s.handlers[job.Name]
+		if !ok {
+			slog.DebugContext(ctx, "No handler registered for job",
+				slog.String("jobId", job.ID),
+				slog.String("name", job.Name))
+			continue
+		}
+
+		if err := job.Claim(s.workerId, time.Now(), s.lease); err != nil {
+			if errors.Is(err, domain.ErrJobLeaseExpired) {
+				s.failExpiredJob(ctx, job)
+			}
+			continue
+		}
+
+		if err := s.jobsWriter.Save(ctx, job); err != nil {
+			if !errors.Is(err, domain.ErrJobConflict) {
+				slog.ErrorContext(ctx, "Failed to claim job",
+					slog.String("err", err.Error()),
+					slog.String("jobId", job.ID))
+			}
+			continue
+		}
+
+		s.run(ctx, job, handler)
+		ran++
+	}
+
+	return ran, nil
+}
+
+func (s *JobScheduler) failExpiredJob(ctx context.Context, job *domain.Job) {
+	if err := s.jobsWriter.Save(ctx, job); err != nil {
+		if !errors.Is(err, domain.ErrJobConflict) {
+			slog.ErrorContext(ctx, "Failed to record expired job",
+				slog.String("err", err.Error()),
+				slog.String("jobId", job.ID))
+		}
+		return
+	}
+
+	slog.WarnContext(ctx, "Job lease expired on its last attempt",
+		slog.String("jobId", job.ID),
+		slog.String("name", job.Name),
+		slog.String("status", string(job.Status)),
+		slog.Time("runAt", job.RunAt))
+}
+
+func (s *JobScheduler) run(ctx context.Context, job *domain.Job, handler JobHandler) {
+	runCtx, cancel := context.WithTimeout(ctx, s.lease)
+	defer cancel()
+
+	runErr := s.invoke(runCtx, job, handler)
+	finishedAt := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		if runErr != nil {
+			job.Fail(runErr, finishedAt)
+		} else {
+			job.Succeed(finishedAt)
+		}
+
+		err := s.jobsWriter.Save(ctx, job)
+		if errors.Is(err, domain.ErrJobConflict) && attempt < maxJobSaveAttempts {
+			if current, ok := s.stillLeased(ctx, job.ID); ok {
+				job = current
+				continue
+			}
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to record job result",
+				slog.String("err", err.Error()),
+				slog.String("jobId", job.ID))
+			return
+		}
+		break
+	}
+
+	if runErr != nil {
+		slog.WarnContext(ctx, "Job failed",
+			slog.String("err", runErr.Error()),
+			slog.String("jobId", job.ID),
+			slog.String("name", job.Name),
+			slog.Int("attempts", job.Attempts),
+			slog.String("status", string(job.Status)),
+			slog.Time("runAt", job.RunAt))
+		return
+	}
+
+	slog.DebugContext(ctx, "Job succeeded",
+		slog.String("jobId", job.ID),
+		slog.String("name", job.Name))
+}
+
+// A schedule change saved while the job ran bumps its version; the run's
+// result still belongs on the job as long as this worker holds the lease.
+func (s *JobScheduler) stillLeased(ctx context.Context, jobId string) (*domain.Job, bool) {
+	current, err := s.jobsReader.GetById(ctx, jobId)
+	if err != nil {
+		return nil, false
+	}
+	return current, current.Status == domain.JobRunning && current.LockedBy == s.workerId
+}
+
+func (s *JobScheduler) invoke(ctx context.Context, job *domain.Job, handler JobHandler) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+	return handler(ctx, job)
+}
@@ -0,0 +1,215 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"request/internal/app/services"
+	"request/internal/domain"
+)
+
+// memoryJobs stores copies of jobs and checks versions the way the database
+// store does, so tests see the same conflicts two replicas would.
+type memoryJobs struct {
+	mu   sync.Mutex
+	jobs map[string]domain.Job
+}
+
+func newMemoryJobs(jobs ...*domain.Job) *memoryJobs {
+	store := &memoryJobs{jobs: map[string]domain.Job{}}
+	for _, job := range jobs {
+		job.Version = 1
+		store.jobs[job.ID] = *job
+	}
+	return store
+}
+
+func (m *memoryJobs) Save(ctx context.Context, job *domain.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.jobs[job.ID]
+	if job.Version == 0 {
+		for _, existing := range m.jobs {
+			if existing.Key == job.Key {
+				return domain.ErrJobExists
+			}
+		}
+	} else if !ok || stored.Version != job.Version {
+		return domain.ErrJobConflict
+	}
+
+	job.Version++
+	m.jobs[job.ID] = *job
+	return nil
+}
+
+func (m *memoryJobs) GetById(ctx context.Context, jobId string) (*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job := m.jobs[jobId]
+	return &job, nil
+}
+
+func (m *memoryJobs) FindByKey(ctx context.Context, key string) (*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, job := range m.jobs {
+		if job.Key == key {
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryJobs) FindByStatuses(ctx context.Context, statuses []domain.JobStatus) ([]*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	jobs := []*domain.Job{}
+	for _, job := range m.jobs {
+		for _, status := range statuses {
+			if job.Status == status {
+				jobs = append(jobs, &job)
+			}
+		}
+	}
+	return jobs, nil
+}
+
+func (m *memoryJobs) FindDue(ctx context.Context, at time.Time, limit int) ([]*domain.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	jobs := []*domain.Job{}
+	for _, job := range m.jobs {
+		if job.IsDue(at) || job.IsLeaseExpired(at) {
+			jobs = append(jobs, &job)
+		}
+	}
+	return jobs, nil
+}
+
+// staleJobs hands out jobs read before another replica got to them.
+type staleJobs struct {
+	*memoryJobs
+	due []*domain.Job
+}
+
+func (s staleJobs) FindDue(ctx context.Context, at time.Time, limit int) ([]*domain.Job, error) {
+	return s.due, nil
+}
+
+func TestRunDueJobs(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("should run a job once when two replicas try to claim it", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", now.Add(-time.Minute))
+		store := newMemoryJobs(job)
+		due, _ := store.FindDue(ctx, now, 10)
+
+		runs := 0
+		handler := func(ctx context.Context, job *domain.Job) error {
+			runs++
+			return nil
+		}
+
+		first := services.NewJobScheduler(store, store, "worker-1", time.Minute)
+		first.Register("send-digest", handler)
+		second := services.NewJobScheduler(store, staleJobs{store, due}, "worker-2", time.Minute)
+		second.Register("send-digest", handler)
+
+		if ran, err := first.RunDueJobs(ctx, now); err != nil || ran != 1 {
+			t.Fatalf("Expected the first replica to run the job, got %d %v", ran, err)
+		}
+		if ran, err := second.RunDueJobs(ctx, now); err != nil || ran != 0 {
+			t.Fatalf("Expected the second replica to lose the claim, got %d %v", ran, err)
+		}
+
+		stored, _ := store.GetById(ctx, "job-1")
+		if runs != 1 || stored.Status != domain.JobSucceeded {
+			t.Errorf("Expected one successful run, got %d runs and %s", runs, stored.Status)
+		}
+	})
+
+	t.Run("should fail a job whose lease expired on its last attempt", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", now.Add(-time.Hour))
+		job.MaxAttempts = 1
+		job.Claim("worker-1", now.Add(-time.Hour), time.Minute)
+		store := newMemoryJobs(job)
+
+		scheduler := services.NewJobScheduler(store, store, "worker-2", time.Minute)
+		scheduler.Register("send-digest", func(ctx context.Context, job *domain.Job) error {
+			t.Error("Expected the job not to run again")
+			return nil
+		})
+
+		if ran, err := scheduler.RunDueJobs(ctx, now); err != nil || ran != 0 {
+			t.Fatalf("Expected nothing to run, got %d %v", ran, err)
+		}
+
+		stored, _ := store.GetById(ctx, "job-1")
+		if stored.Status != domain.JobFailed {
+			t.Errorf("Expected the job to fail, got %s", stored.Status)
+		}
+	})
+
+	t.Run("should apply a schedule change saved while the job runs once it finishes", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "send-digest", "0 9 * * *", "", now.Add(-48*time.Hour))
+		job.RunAt = now.Add(-time.Minute)
+		store := newMemoryJobs(job)
+
+		newer := services.NewJobScheduler(store, store, "worker-2", time.Minute)
+		older := services.NewJobScheduler(store, store, "worker-1", time.Minute)
+		older.Register("send-digest", func(ctx context.Context, job *domain.Job) error {
+			if _, err := newer.ScheduleRecurringJob(ctx, "send-digest", "send-digest", "0 10 * * *", ""); err != nil {
+				t.Errorf("Failed to change the schedule: %v", err)
+			}
+
+			stored, _ := store.GetById(ctx, "job-1")
+			if stored.Status != domain.JobRunning || stored.Schedule != "0 9 * * *" || stored.PendingSchedule != "0 10 * * *" {
+				t.Errorf("Expected the change to wait for the run, got %+v", stored)
+			}
+			return nil
+		})
+
+		if ran, err := older.RunDueJobs(ctx, now); err != nil || ran != 1 {
+			t.Fatalf("Expected the job to run, got %d %v", ran, err)
+		}
+
+		stored, _ := store.GetById(ctx, "job-1")
+		if stored.Status != domain.JobScheduled || stored.Schedule != "0 10 * * *" || stored.PendingSchedule != "" || stored.RunAt.Hour() != 10 {
+			t.Errorf("Expected the new schedule to be used, got %q next at %s", stored.Schedule, stored.RunAt)
+		}
+	})
+
+	t.Run("should keep the stored schedule when a replica that didn't change it claims the job", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "send-digest", "0 9 * * *", "", now.Add(-48*time.Hour))
+		store := newMemoryJobs(job)
+
+		newer := services.NewJobScheduler(store, store, "worker-2", time.Minute)
+		if _, err := newer.ScheduleRecurringJob(ctx, "send-digest", "send-digest", "0 10 * * *", ""); err != nil {
+			t.Fatalf("Failed to change the schedule: %v", err)
+		}
+
+		stored, _ := store.GetById(ctx, "job-1")
+		stored.RunAt = now.Add(-time.Minute)
+		store.jobs["job-1"] = *stored
+
+		older := services.NewJobScheduler(store, store, "worker-1", time.Minute)
+		older.Register("send-digest", func(ctx context.Context, job *domain.Job) error { return nil })
+		if ran, err := older.RunDueJobs(ctx, now); err != nil || ran != 1 {
+			t.Fatalf("Expected the job to run, got %d %v", ran, err)
+		}
+
+		stored, _ = store.GetById(ctx, "job-1")
+		if stored.Schedule != "0 10 * * *" {
+			t.Errorf("Expected the saved schedule to stay, got %q", stored.Schedule)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/domain"
+)
+
+const (
+	JobEscalateApprovals = "escalate-approvals"
+	JobEvaluateSLAs      = "evaluate-slas"
+	JobSendReminders     = "send-reminders"
+	JobRemindLater       = "remind-later"
+	JobCreateRecurring   = "create-recurring-requests"
+	JobExpireRequests    = "expire-requests"
+)
+
+// RegisterMaintenanceJobs registers the app's periodic housekeeping with the
+// scheduler and makes sure each job is scheduled to recur.
+func RegisterMaintenanceJobs(
+	ctx context.Context,
+	scheduler *JobScheduler,
+	escalator primaryports.ForEscalatingApprovals,
+	evaluator primaryports.ForEvaluatingSLAs,
+	reminders primaryports.ForSendingReminders,
+	recurring primaryports.ForCreatingRecurringRequests,
+	expirer primaryports.ForExpiringRequests,
+) error {
+	scheduler.Register(JobEscalateApprovals, func(ctx context.Context, job *domain.Job) error {
+		escalated, err := escalator.EscalateOverdueApprovals(ctx, time.Now())
+		if escalated > 0 {
+			slog.InfoContext(ctx, "Escalated overdue approvals", slog.Int("count", escalated))
+		}
+		return err
+	})
+
+	scheduler.Register(JobEvaluateSLAs, func(ctx context.Context, job *domain.Job) error {
+		breached, err := evaluator.EvaluateSLAs(ctx, time.Now())
+		if breached > 0 {
+			slog.InfoContext(ctx, "Recorded SLA breaches", slog.Int("count", breached))
+		}
+		return err
+	})
+
+	scheduler.Register(JobSendReminders, func(ctx context.Context, job *domain.Job) error {
+		sent, err := reminders.SendDueReminders(ctx, time.Now())
+		if sent > 0 {
+			slog.InfoContext(ctx, "Sent reminders", slog.Int("count", sent))
+		}
+		return err
+	})
+
+	scheduler.Register(JobRemindLater, func(ctx context.Context, job *domain.Job) error {
+		payload, err := decodeRemindLaterPayload(job.Payload)
+		if err != nil {
+			return err
+		}
+		return reminders.SendLaterReminder(ctx, payload.RequestID, payload.UserID)
+	})
+
+	scheduler.Register(JobCreateRecurring, func(ctx context.Context, job *domain.Job) error {
+		created, err := recurring.CreateDueRequests(ctx, time.Now())
+		if created > 0 {
+			slog.InfoContext(ctx, "Created recurring requests", slog.Int("count", created))
+		}
+		return err
+	})
+
+	scheduler.Register(JobExpireRequests, func(ctx context.Context, job *domain.Job) error {
+		expired, err := expirer.ExpireStaleRequests(ctx, time.Now())
+		if expired > 0 {
+			slog.InfoContext(ctx, "Expired stale requests", slog.Int("count", expired))
+		}
+		return err
+	})
+
+	schedules := []struct{ name, schedule string }{
+		{JobEscalateApprovals, "@every 1m"},
+		{JobEvaluateSLAs, "@every 1m"},
+		{JobSendReminders, "@every 5m"},
+		{JobCreateRecurring, "@every 1m"},
+		{JobExpireRequests, "@every 5m"},
+	}
+	for _, job := range schedules {
+		if _, err := scheduler.ScheduleRecurringJob(ctx, job.name, job.name, job.schedule, ""); err != nil {
+			return fmt.Errorf("failed to schedule %s: %w", job.name, err)
+		}
+	}
+
+	return nil
+}
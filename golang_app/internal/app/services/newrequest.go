@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"request/internal/app/ports/primaryports"
 	"request/internal/app/ports/secondaryports"
@@ -11,29 +12,154 @@ import (
 type RequestService struct {
 	modalRenderer secondaryports.ForRenderingModals
 	requestWriter secondaryports.ForStoringRequests
+	queuesReader  secondaryports.ForReadingQueues
 }
 
 var _ primaryports.ForHandlingRequests = (*RequestService)(nil)
 
-func NewRequestService(modalRenderer secondaryports.ForRenderingModals, requestWriter secondaryports.ForStoringRequests) *RequestService {
+func NewRequestService(
+	modalRenderer secondaryports.ForRenderingModals,
+	requestWriter secondaryports.ForStoringRequests,
+	queuesReader secondaryports.ForReadingQueues,
+) *RequestService {
 	return &RequestService{
 		modalRenderer: modalRenderer,
 		requestWriter: requestWriter,
+		queuesReader:  queuesReader,
 	}
 }
 
-func (s *RequestService) OpenNewRequestForm(ctx context.Context, triggerId string) error {
+func (s *RequestService) OpenNewRequestForm(ctx context.Context, triggerId string, templateName string) error {
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues for request form", slog.String("err", err.Error()))
+		return fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	state := secondaryports.RequestFormState{
+		Templates: templateOptions(queues),
+	}
+
+	if templateName != "" {
+		selected, err := findTemplateByName(state.Templates, templateName)
+		if err != nil {
+			return err
+		}
+		state = applyTemplate(state, queues, selected)
+	}
+
 	view := secondaryports.RequestFormView{
 		RecipientTypeOptions: []secondaryports.RecipientTypeOption{
 			{Value: "user", Label: "User"},
 			{Value: "channel", Label: "Channel"},
 			{Value: "queue", Label: "Queue"},
 		},
+		InitialState: state,
 	}
 
 	return s.modalRenderer.RenderRequestForm(ctx, triggerId, view)
 }
 
+func (s *RequestService) RefreshNewRequestForm(ctx context.Context, viewId string, recipientType domain.RequestRecipientType, queueId string) error {
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues for request form", slog.String("err", err.Error()))
+		return fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	state := secondaryports.RequestFormState{
+		RecipientType: recipientType,
+		Templates:     templateOptions(queues),
+	}
+
+	if recipientType == domain.RequestRecipientQueue {
+		state.Queues = queues
+
+		for _, queue := range queues {
+			if queue.ID == queueId {
+				state.SelectedQueue = queue
+				break
+			}
+		}
+	}
+
+	return s.modalRenderer.UpdateRequestForm(ctx, viewId, state)
+}
+
+func (s *RequestService) SelectRequestTemplate(ctx context.Context, viewId string, queueId, templateKey string) error {
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list queues for request form", slog.String("err", err.Error()))
+		return fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	state := secondaryports.RequestFormState{
+		Templates: templateOptions(queues),
+	}
+
+	for i, option := range state.Templates {
+		if option.Queue.ID == queueId && option.Template.Key == templateKey {
+			state = applyTemplate(state, queues, &state.Templates[i])
+			return s.modalRenderer.UpdateRequestForm(ctx, viewId, state)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", domain.ErrTemplateNotFound, templateKey)
+}
+
+func templateOptions(queues []*domain.Queue) []secondaryports.RequestTemplateOption {
+	options := []secondaryports.RequestTemplateOption{}
+	for _, queue := range queues {
+		for _, template := range queue.Templates {
+			options = append(options, secondaryports.RequestTemplateOption{
+				Queue:    queue,
+				Template: template,
+			})
+		}
+	}
+	return options
+}
+
+func findTemplateByName(options []secondaryports.RequestTemplateOption, name string) (*secondaryports.RequestTemplateOption, error) {
+	key := domain.TemplateKey(name)
+
+	var found *secondaryports.RequestTemplateOption
+	for i, option := range options {
+		if option.Template.Key != key {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("template %q exists in more than one queue, pick it from the form instead", name)
+		}
+		found = &options[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTemplateNotFound, name)
+	}
+
+	return found, nil
+}
+
+func applyTemplate(state secondaryports.RequestFormState, queues []*domain.Queue, selected *secondaryports.RequestTemplateOption) secondaryports.RequestFormState {
+	recipient := selected.Template.Recipient(selected.Queue.ID)
+
+	state.SelectedTemplate = selected
+	state.RecipientType = recipient.Type
+
+	if recipient.Type == domain.RequestRecipientQueue {
+		state.Queues = queues
+		for _, queue := range queues {
+			if queue.ID == recipient.ID {
+				state.SelectedQueue = queue
+				break
+			}
+		}
+	}
+
+	return state
+}
+
 func (s *RequestService) CreateRequest(ctx context.Context, r *domain.Request) error {
 	err := s.requestWriter.Save(ctx, r)
 	if err != nil {
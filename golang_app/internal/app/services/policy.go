@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"request/internal/domain"
+)
+
+func logPolicyDecision(ctx context.Context) func(domain.PolicyDecision) {
+	return func(decision domain.PolicyDecision) {
+		slog.InfoContext(ctx, "Policy decision",
+			slog.String("actorId", decision.ActorID),
+			slog.String("role", string(decision.Role)),
+			slog.String("permission", string(decision.Permission)),
+			slog.Bool("allowed", decision.Allowed))
+	}
+}
+
+func authorizeQueue(ctx context.Context, queue *domain.Queue, userId string, permission domain.Permission) bool {
+	decision := queue.Decide(userId, permission)
+	logPolicyDecision(ctx)(decision)
+	return decision.Allowed
+}
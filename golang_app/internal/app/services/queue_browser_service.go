@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"request/internal/app/ports/primaryports"
 	"request/internal/app/ports/secondaryports"
@@ -50,20 +51,89 @@ func (s *QueueBrowserService) ListQueuesByChannel(
 	return queues, nil
 }
 
+func (s *QueueBrowserService) ListQueueSummaries(
+	ctx context.Context,
+	channelId string,
+) ([]*domain.QueueSummary, error) {
+	queues, err := s.ListQueuesByChannel(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*domain.QueueSummary, len(queues))
+	for i, queue := range queues {
+		openRequests, err := s.requestsReader.FindByRecipientAndStatuses(
+			ctx,
+			queue.ID,
+			domain.RequestRecipientQueue,
+			domain.OpenRequestStatuses(),
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open requests for queue %s: %w", queue.ID, err)
+		}
+
+		labelCounts, err := s.requestsReader.CountLabelsByRecipient(
+			ctx,
+			queue.ID,
+			domain.RequestRecipientQueue,
+			domain.OpenRequestStatuses(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count labels for queue %s: %w", queue.ID, err)
+		}
+
+		slaBreaches, err := s.requestsReader.CountSLABreachesByRecipient(
+			ctx,
+			queue.ID,
+			domain.RequestRecipientQueue,
+			time.Now().AddDate(0, 0, -domain.SLAReportingDays),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count SLA breaches for queue %s: %w", queue.ID, err)
+		}
+
+		summaries[i] = &domain.QueueSummary{
+			Queue:        queue,
+			OpenRequests: len(openRequests),
+			LabelCounts:  labelCounts,
+			SLABreaches:  slaBreaches,
+			Load:         domain.NewWIPLoad(openRequests),
+		}
+	}
+
+	return summaries, nil
+}
+
 func (s *QueueBrowserService) GetQueueRequests(
 	ctx context.Context,
 	queueId string,
+	userId string,
 	statuses []domain.RequestStatus,
+	labels []string,
 ) ([]*domain.Request, error) {
 	if queueId == "" {
 		return nil, fmt.Errorf("queue ID is required")
 	}
 
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return nil, fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, userId, domain.PermissionView) {
+		slog.WarnContext(ctx, "Unauthorized attempt to view queue requests",
+			slog.String("queueId", queueId),
+			slog.String("userId", userId))
+		return nil, fmt.Errorf("user is not authorized to view this queue")
+	}
+
 	requests, err := s.requestsReader.FindByRecipientAndStatuses(
 		ctx,
 		queueId,
 		domain.RequestRecipientQueue,
 		statuses,
+		labels,
 	)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get queue requests",
@@ -75,6 +145,7 @@ func (s *QueueBrowserService) GetQueueRequests(
 	slog.DebugContext(ctx, "Retrieved queue requests",
 		slog.String("queueId", queueId),
 		slog.Int("statusCount", len(statuses)),
+		slog.Int("labelCount", len(labels)),
 		slog.Int("count", len(requests)))
 
 	return requests, nil
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"request/internal/app/ports/primaryports"
 	"request/internal/app/ports/secondaryports"
@@ -13,8 +15,9 @@ import (
 )
 
 type QueueService struct {
-	queuesWriter secondaryports.ForStoringQueues
-	queuesReader secondaryports.ForReadingQueues
+	queuesWriter    secondaryports.ForStoringQueues
+	queuesReader    secondaryports.ForReadingQueues
+	holidayImporter secondaryports.ForImportingHolidays
 }
 
 var _ primaryports.ForManagingQueues = (*QueueService)(nil)
@@ -22,10 +25,12 @@ var _ primaryports.ForManagingQueues = (*QueueService)(nil)
 func NewQueueService(
 	queuesWriter secondaryports.ForStoringQueues,
 	queuesReader secondaryports.ForReadingQueues,
+	holidayImporter secondaryports.ForImportingHolidays,
 ) *QueueService {
 	return &QueueService{
-		queuesWriter: queuesWriter,
-		queuesReader: queuesReader,
+		queuesWriter:    queuesWriter,
+		queuesReader:    queuesReader,
+		holidayImporter: holidayImporter,
 	}
 }
 
@@ -160,7 +165,7 @@ func (s *QueueService) AddQueueAdmin(ctx context.Context, queueId, userId, reque
 		return fmt.Errorf("queue not found: %w", err)
 	}
 
-	if !queue.CanBeModifiedBy(requestingUserId) {
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
 		slog.WarnContext(ctx, "Unauthorized attempt to add queue admin",
 			slog.String("queueId", queueId),
 			slog.String("requestingUserId", requestingUserId))
@@ -207,7 +212,7 @@ func (s *QueueService) RemoveQueueAdmin(ctx context.Context, queueId, userId, re
 		return fmt.Errorf("queue not found: %w", err)
 	}
 
-	if !queue.CanBeModifiedBy(requestingUserId) {
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
 		slog.WarnContext(ctx, "Unauthorized attempt to remove queue admin",
 			slog.String("queueId", queueId),
 			slog.String("requestingUserId", requestingUserId))
@@ -254,7 +259,7 @@ func (s *QueueService) AddQueueMember(ctx context.Context, queueId, userId, requ
 		return fmt.Errorf("queue not found: %w", err)
 	}
 
-	if !queue.CanBeModifiedBy(requestingUserId) {
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
 		slog.WarnContext(ctx, "Unauthorized attempt to add queue member",
 			slog.String("queueId", queueId),
 			slog.String("requestingUserId", requestingUserId))
@@ -301,7 +306,7 @@ func (s *QueueService) RemoveQueueMember(ctx context.Context, queueId, userId, r
 		return fmt.Errorf("queue not found: %w", err)
 	}
 
-	if !queue.CanBeModifiedBy(requestingUserId) {
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
 		slog.WarnContext(ctx, "Unauthorized attempt to remove queue member",
 			slog.String("queueId", queueId),
 			slog.String("requestingUserId", requestingUserId))
@@ -329,3 +334,856 @@ func (s *QueueService) RemoveQueueMember(ctx context.Context, queueId, userId, r
 
 	return nil
 }
+
+func (s *QueueService) AddQueueTriager(ctx context.Context, queueId, userId, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to add queue triager",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.AddTriager(userId)
+	if err != nil {
+		return fmt.Errorf("failed to add triager: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after adding triager",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("userId", userId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Triager added to queue",
+		slog.String("queueId", queueId),
+		slog.String("userId", userId),
+		slog.String("addedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) RemoveQueueTriager(ctx context.Context, queueId, userId, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to remove queue triager",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.RemoveTriager(userId)
+	if err != nil {
+		return fmt.Errorf("failed to remove triager: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after removing triager",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("userId", userId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Triager removed from queue",
+		slog.String("queueId", queueId),
+		slog.String("userId", userId),
+		slog.String("removedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) AddQueueLabel(ctx context.Context, queueId, label, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to add queue label",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.AddLabel(label)
+	if err != nil {
+		return fmt.Errorf("failed to add label: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after adding label",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("label", label))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Label added to queue",
+		slog.String("queueId", queueId),
+		slog.String("label", label),
+		slog.String("addedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) RemoveQueueLabel(ctx context.Context, queueId, label, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to remove queue label",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.RemoveLabel(label)
+	if err != nil {
+		return fmt.Errorf("failed to remove label: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after removing label",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("label", label))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Label removed from queue",
+		slog.String("queueId", queueId),
+		slog.String("label", label),
+		slog.String("removedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) AddQueueTemplate(ctx context.Context, queueId string, template domain.RequestTemplate, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to add queue template",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.AddTemplate(template)
+	if err != nil {
+		return fmt.Errorf("failed to add template: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after adding template",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("template", template.Name))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Template added to queue",
+		slog.String("queueId", queueId),
+		slog.String("template", template.Name),
+		slog.String("addedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) RemoveQueueTemplate(ctx context.Context, queueId, name, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if name == "" {
+		return fmt.Errorf("template name is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to remove queue template",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.RemoveTemplate(name)
+	if err != nil {
+		return fmt.Errorf("failed to remove template: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after removing template",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId),
+			slog.String("template", name))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Template removed from queue",
+		slog.String("queueId", queueId),
+		slog.String("template", name),
+		slog.String("removedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueIntakeFields(ctx context.Context, queueId string, fields []domain.IntakeField, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue intake fields",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.SetIntakeFields(fields)
+	if err != nil {
+		return fmt.Errorf("failed to set intake fields: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting intake fields",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue intake fields updated",
+		slog.String("queueId", queueId),
+		slog.Int("fieldCount", len(fields)),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueWorkflow(ctx context.Context, queueId string, workflow *domain.Workflow, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue workflow",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	queue.SetWorkflow(workflow)
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting workflow",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue workflow updated",
+		slog.String("queueId", queueId),
+		slog.Bool("custom", workflow != nil),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueAssignmentStrategy(ctx context.Context, queueId string, strategy domain.AssignmentStrategy, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue assignment strategy",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.SetAssignmentStrategy(strategy)
+	if err != nil {
+		return fmt.Errorf("failed to set assignment strategy: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting assignment strategy",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue assignment strategy updated",
+		slog.String("queueId", queueId),
+		slog.String("strategy", string(queue.AssignmentStrategy)),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueApprovalChain(ctx context.Context, queueId string, stages []domain.ApprovalStage, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue approval chain",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.SetApprovalChain(stages)
+	if err != nil {
+		return fmt.Errorf("failed to set approval chain: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting approval chain",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue approval chain updated",
+		slog.String("queueId", queueId),
+		slog.Int("stageCount", len(stages)),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) FindQueueByName(ctx context.Context, name, channelId string) (*domain.Queue, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("queue name is required")
+	}
+
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	matches := []*domain.Queue{}
+	for _, queue := range queues {
+		if strings.EqualFold(queue.Name, name) {
+			matches = append(matches, queue)
+		}
+	}
+
+	if len(matches) > 1 {
+		for _, queue := range matches {
+			if queue.ChannelId == channelId {
+				return queue, nil
+			}
+		}
+		return nil, fmt.Errorf("more than one queue is called %q, run the command from the queue's channel", name)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrQueueNotFound, name)
+	}
+
+	return matches[0], nil
+}
+
+func (s *QueueService) SetQueueOnCallRotation(ctx context.Context, queueId string, rotation *domain.OnCallRotation, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue on-call rotation",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	err = queue.SetOnCallRotation(rotation)
+	if err != nil {
+		return fmt.Errorf("failed to set on-call rotation: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting on-call rotation",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue on-call rotation updated",
+		slog.String("queueId", queueId),
+		slog.Bool("enabled", rotation != nil),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) AddQueueOnCallOverride(ctx context.Context, queueId string, override domain.OnCallOverride, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if queue.OnCall == nil {
+		return domain.ErrNoOnCallRotation
+	}
+
+	if !queue.OnCall.Includes(requestingUserId) && !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to override on-call rotation",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("only queue admins and people in the rotation can swap shifts")
+	}
+
+	err = queue.AddOnCallOverride(override.UserID, override.Start, override.End)
+	if err != nil {
+		return fmt.Errorf("failed to add on-call override: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after adding on-call override",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "On-call override added",
+		slog.String("queueId", queueId),
+		slog.String("userId", override.UserID),
+		slog.Time("start", override.Start),
+		slog.Time("end", override.End),
+		slog.String("addedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) GetOnCall(ctx context.Context, queueId string, at time.Time) (*domain.OnCallShift, *domain.OnCallShift, error) {
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("queue not found: %w", err)
+	}
+
+	if queue.OnCall == nil {
+		return nil, nil, domain.ErrNoOnCallRotation
+	}
+
+	var current, next *domain.OnCallShift
+	if shift, ok := queue.OnCall.ShiftAt(at); ok {
+		current = &shift
+	}
+	if shift, ok := queue.OnCall.NextShift(at); ok {
+		next = &shift
+	}
+
+	return current, next, nil
+}
+
+func (s *QueueService) SetQueueBusinessHours(ctx context.Context, queueId string, calendar *domain.BusinessCalendar, holidayFileId, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue business hours",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	if calendar != nil {
+		switch {
+		case holidayFileId != "":
+			holidays, err := s.holidayImporter.ImportHolidays(ctx, holidayFileId)
+			if err != nil {
+				return fmt.Errorf("failed to import holidays: %w", err)
+			}
+			calendar.SetHolidays(holidays)
+		case queue.BusinessHours != nil:
+			calendar.SetHolidays(queue.BusinessHours.Holidays)
+		}
+	}
+
+	queue.SetBusinessHours(calendar)
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting business hours",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	holidayCount := 0
+	if calendar != nil {
+		holidayCount = len(calendar.Holidays)
+	}
+
+	slog.InfoContext(ctx, "Queue business hours updated",
+		slog.String("queueId", queueId),
+		slog.Bool("enabled", calendar != nil),
+		slog.Int("holidayCount", holidayCount),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueSLAPolicy(ctx context.Context, queueId string, policy *domain.SLAPolicy, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue SLA policy",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	queue.SetSLAPolicy(policy)
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting SLA policy",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue SLA policy updated",
+		slog.String("queueId", queueId),
+		slog.Bool("enabled", policy != nil),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueExpiryPolicy(ctx context.Context, queueId string, policy *domain.ExpiryPolicy, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue expiry policy",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	queue.SetExpiryPolicy(policy)
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting expiry policy",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue expiry policy updated",
+		slog.String("queueId", queueId),
+		slog.Bool("enabled", policy != nil),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueWIPLimits(ctx context.Context, queueId string, limits *domain.WIPLimits, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue WIP limits",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	queue.SetWIPLimits(limits)
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting WIP limits",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue WIP limits updated",
+		slog.String("queueId", queueId),
+		slog.Bool("enabled", limits != nil),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
+
+func (s *QueueService) SetQueueRoutingRules(ctx context.Context, queueId string, rules []domain.RoutingRule, requestingUserId string) ([]domain.RoutingOverlap, error) {
+	if queueId == "" {
+		return nil, fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return nil, fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return nil, fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue routing rules",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return nil, fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	if err := queue.SetRoutingRules(rules); err != nil {
+		return nil, fmt.Errorf("invalid routing rules: %w", err)
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting routing rules",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return nil, fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue routing rules updated",
+		slog.String("queueId", queueId),
+		slog.Int("ruleCount", len(rules)),
+		slog.String("updatedBy", requestingUserId))
+
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to check routing rules for overlaps",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return nil, nil
+	}
+
+	return queue.RoutingOverlaps(queues), nil
+}
+
+func (s *QueueService) SetQueueReminderRules(ctx context.Context, queueId string, rules []domain.ReminderRule, requestingUserId string) error {
+	if queueId == "" {
+		return fmt.Errorf("queue ID is required")
+	}
+
+	if requestingUserId == "" {
+		return fmt.Errorf("requesting user ID is required")
+	}
+
+	queue, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	if !authorizeQueue(ctx, queue, requestingUserId, domain.PermissionEditQueue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to set queue reminder rules",
+			slog.String("queueId", queueId),
+			slog.String("requestingUserId", requestingUserId))
+		return fmt.Errorf("user is not authorized to modify this queue")
+	}
+
+	if err := queue.SetReminderRules(rules); err != nil {
+		return err
+	}
+
+	err = s.queuesWriter.Save(ctx, queue)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save queue after setting reminder rules",
+			slog.String("err", err.Error()),
+			slog.String("queueId", queueId))
+		return fmt.Errorf("failed to save queue: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Queue reminder rules updated",
+		slog.String("queueId", queueId),
+		slog.Int("rules", len(rules)),
+		slog.String("updatedBy", requestingUserId))
+
+	return nil
+}
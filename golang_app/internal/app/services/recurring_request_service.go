@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type RecurringRequestService struct {
+	recurringWriter secondaryports.ForStoringRecurringRequests
+	recurringReader secondaryports.ForReadingRecurringRequests
+	queuesReader    secondaryports.ForReadingQueues
+	submissions     primaryports.ForHandlingFormSubmissions
+}
+
+var _ primaryports.ForManagingRecurringRequests = (*RecurringRequestService)(nil)
+var _ primaryports.ForCreatingRecurringRequests = (*RecurringRequestService)(nil)
+
+func NewRecurringRequestService(
+	recurringWriter secondaryports.ForStoringRecurringRequests,
+	recurringReader secondaryports.ForReadingRecurringRequests,
+	queuesReader secondaryports.ForReadingQueues,
+	submissions primaryports.ForHandlingFormSubmissions,
+) *RecurringRequestService {
+	return &RecurringRequestService{
+		recurringWriter: recurringWriter,
+		recurringReader: recurringReader,
+		queuesReader:    queuesReader,
+		submissions:     submissions,
+	}
+}
+
+func (s *RecurringRequestService) CreateRecurringRequest(ctx context.Context, data primaryports.RecurringRequestData) (*domain.RecurringRequest, error) {
+	if data.CreatedByID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	recipient := &domain.RequestRecipient{ID: data.RecipientID, Type: data.RecipientType}
+
+	if recipient.Type == domain.RequestRecipientQueue {
+		queue, err := s.queuesReader.GetById(ctx, recipient.ID)
+		if err != nil {
+			return nil, fmt.Errorf("queue not found: %w", err)
+		}
+
+		// Nobody is around to fill in required fields when the request is
+		// created, so every occurrence would fail.
+		for _, field := range queue.IntakeFields {
+			if field.Required {
+				return nil, fmt.Errorf("%s has required intake fields, so requests to it can't recur", queue.Name)
+			}
+		}
+	}
+
+	recurring, err := domain.NewRecurringRequest(
+		uuid.New().String(),
+		data.Title,
+		data.Description,
+		recipient,
+		data.Schedule,
+		data.CreatedByID,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recurringWriter.Save(ctx, recurring); err != nil {
+		return nil, fmt.Errorf("failed to save recurring request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Recurring request created",
+		slog.String("recurringId", recurring.ID),
+		slog.String("createdBy", recurring.CreatedByID),
+		slog.String("schedule", recurring.Schedule),
+		slog.Time("nextRunAt", recurring.NextRunAt))
+
+	return recurring, nil
+}
+
+// ListRecurringRequests returns the recurring requests the user can manage:
+// their own, and those for queues they can edit.
+func (s *RecurringRequestService) ListRecurringRequests(ctx context.Context, userId string) ([]*domain.RecurringRequest, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	all, err := s.recurringReader.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring requests: %w", err)
+	}
+
+	queues := map[string]*domain.Queue{}
+	manageable := []*domain.RecurringRequest{}
+	for _, recurring := range all {
+		var queue *domain.Queue
+		if recurring.Recipient.Type == domain.RequestRecipientQueue && recurring.CreatedByID != userId {
+			if cached, ok := queues[recurring.Recipient.ID]; ok {
+				queue = cached
+			} else if queue, err = s.queuesReader.GetById(ctx, recurring.Recipient.ID); err != nil {
+				slog.WarnContext(ctx, "Queue of recurring request not found",
+					slog.String("recurringId", recurring.ID),
+					slog.String("queueId", recurring.Recipient.ID))
+			}
+			queues[recurring.Recipient.ID] = queue
+		}
+
+		if recurring.CanBeManagedBy(userId, queue) {
+			manageable = append(manageable, recurring)
+		}
+	}
+
+	return manageable, nil
+}
+
+func (s *RecurringRequestService) PauseRecurringRequest(ctx context.Context, recurringId, userId string) error {
+	recurring, err := s.loadForManagement(ctx, recurringId, userId)
+	if err != nil {
+		return err
+	}
+
+	if err := recurring.Pause(time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.recurringWriter.Save(ctx, recurring); err != nil {
+		return fmt.Errorf("failed to save recurring request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Recurring request paused",
+		slog.String("recurringId", recurringId),
+		slog.String("pausedBy", userId))
+	return nil
+}
+
+func (s *RecurringRequestService) ResumeRecurringRequest(ctx context.Context, recurringId, userId string) error {
+	recurring, err := s.loadForManagement(ctx, recurringId, userId)
+	if err != nil {
+		return err
+	}
+
+	if err := recurring.Resume(time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.recurringWriter.Save(ctx, recurring); err != nil {
+		return fmt.Errorf("failed to save recurring request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Recurring request resumed",
+		slog.String("recurringId", recurringId),
+		slog.String("resumedBy", userId),
+		slog.Time("nextRunAt", recurring.NextRunAt))
+	return nil
+}
+
+func (s *RecurringRequestService) DeleteRecurringRequest(ctx context.Context, recurringId, userId string) error {
+	if _, err := s.loadForManagement(ctx, recurringId, userId); err != nil {
+		return err
+	}
+
+	if err := s.recurringWriter.Delete(ctx, recurringId); err != nil {
+		return fmt.Errorf("failed to delete recurring request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Recurring request deleted",
+		slog.String("recurringId", recurringId),
+		slog.String("deletedBy", userId))
+	return nil
+}
+
+func (s *RecurringRequestService) loadForManagement(ctx context.Context, recurringId, userId string) (*domain.RecurringRequest, error) {
+	if recurringId == "" {
+		return nil, fmt.Errorf("recurring request ID is required")
+	}
+
+	if userId == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	recurring, err := s.recurringReader.GetById(ctx, recurringId)
+	if err != nil {
+		return nil, fmt.Errorf("recurring request not found: %w", err)
+	}
+
+	var queue *domain.Queue
+	if recurring.Recipient.Type == domain.RequestRecipientQueue && recurring.CreatedByID != userId {
+		queue, err = s.queuesReader.GetById(ctx, recurring.Recipient.ID)
+		if err != nil {
+			return nil, fmt.Errorf("queue not found: %w", err)
+		}
+	}
+
+	if !recurring.CanBeManagedBy(userId, queue) {
+		slog.WarnContext(ctx, "Unauthorized attempt to manage recurring request",
+			slog.String("recurringId", recurringId),
+			slog.String("userId", userId))
+		return nil, fmt.Errorf("user is not authorized to manage this recurring request")
+	}
+
+	return recurring, nil
+}
+
+// CreateDueRequests creates a request for every recurring request that is due,
+// through the same path as a submitted request form. Each occurrence is
+// claimed by advancing the schedule before the request is created, so two
+// replicas never both create it. A failed occurrence is recorded and skipped
+// rather than retried, so a broken recurring request can't flood its
+// recipient once it is fixed.
+func (s *RecurringRequestService) CreateDueRequests(ctx context.Context, at time.Time) (int, error) {
+	due, err := s.recurringReader.FindDue(ctx, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due recurring requests: %w", err)
+	}
+
+	created := 0
+	for _, recurring := range due {
+		if !recurring.IsDue(at) {
+			continue
+		}
+
+		occurrence := recurring.NextRunAt
+		recurring.Advance(at, nil)
+
+		err := s.recurringWriter.ClaimOccurrence(ctx, recurring, occurrence)
+		if errors.Is(err, domain.ErrOccurrenceClaimed) {
+			slog.InfoContext(ctx, "Recurring request occurrence already created",
+				slog.String("recurringId", recurring.ID),
+				slog.Time("occurrence", occurrence))
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to advance recurring request",
+				slog.String("err", err.Error()),
+				slog.String("recurringId", recurring.ID))
+			continue
+		}
+
+		err = s.submissions.HandleRequestFormSubmission(ctx, primaryports.RequestFormData{
+			Title:             recurring.Title,
+			Description:       recurring.Description,
+			RecipientID:       recurring.Recipient.ID,
+			RecipientType:     recurring.Recipient.Type,
+			PlaceholderValues: recurring.PlaceholderValues(occurrence),
+			CreatedByID:       recurring.CreatedByID,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to create recurring request occurrence",
+				slog.String("err", err.Error()),
+				slog.String("recurringId", recurring.ID))
+
+			if err := s.recurringWriter.RecordLastError(ctx, recurring.ID, err.Error()); err != nil {
+				slog.ErrorContext(ctx, "Failed to save recurring request error",
+					slog.String("err", err.Error()),
+					slog.String("recurringId", recurring.ID))
+			}
+			continue
+		}
+
+		created++
+	}
+
+	return created, nil
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+)
+
+type ReminderService struct {
+	requestsWriter secondaryports.ForStoringRequests
+	requestsReader secondaryports.ForReadingRequests
+	queuesReader   secondaryports.ForReadingQueues
+	messenger      secondaryports.ForMessagingUsers
+	msgRenderer    secondaryports.ForRenderingMessages
+	scheduler      primaryports.ForSchedulingJobs
+}
+
+var _ primaryports.ForSendingReminders = (*ReminderService)(nil)
+var _ primaryports.ForSnoozingReminders = (*ReminderService)(nil)
+
+type remindLaterPayload struct {
+	RequestID string `json:"request_id"`
+	UserID    string `json:"user_id"`
+}
+
+func NewReminderService(
+	requestsWriter secondaryports.ForStoringRequests,
+	requestsReader secondaryports.ForReadingRequests,
+	queuesReader secondaryports.ForReadingQueues,
+	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+	scheduler primaryports.ForSchedulingJobs,
+) *ReminderService {
+	return &ReminderService{
+		requestsWriter: requestsWriter,
+		requestsReader: requestsReader,
+		queuesReader:   queuesReader,
+		messenger:      messenger,
+		msgRenderer:    msgRenderer,
+		scheduler:      scheduler,
+	}
+}
+
+func (s *ReminderService) SendDueReminders(ctx context.Context, at time.Time) (int, error) {
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	sent := 0
+	for _, queue := range queues {
+		if len(queue.ReminderRules) == 0 {
+			continue
+		}
+
+		requests, err := s.requestsReader.FindByRecipientAndStatuses(ctx, queue.ID, domain.RequestRecipientQueue,
+			[]domain.RequestStatus{domain.RequestPending, domain.RequestAccepted}, nil)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to find requests for reminders",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queue.ID))
+			continue
+		}
+
+		for _, request := range requests {
+			due := queue.DueReminders(request, at)
+			if len(due) == 0 {
+				continue
+			}
+
+			delivered := 0
+			for _, reminder := range due {
+				if !s.deliver(ctx, queue, request, reminder, at) {
+					continue
+				}
+				request.RecordReminder(reminder.Rule, reminder.Since, at)
+				delivered++
+			}
+
+			if delivered == 0 {
+				continue
+			}
+			sent += delivered
+
+			if err := s.requestsWriter.RecordSentReminders(ctx, request.ID, request.SentReminders); err != nil {
+				slog.ErrorContext(ctx, "Failed to record sent reminders",
+					slog.String("err", err.Error()),
+					slog.String("requestId", request.ID))
+			}
+		}
+	}
+
+	return sent, nil
+}
+
+func (s *ReminderService) deliver(ctx context.Context, queue *domain.Queue, request *domain.Request, reminder domain.DueReminder, at time.Time) bool {
+	message := reminderText(queue, request, reminder, at)
+
+	slog.InfoContext(ctx, "Sending reminder",
+		slog.String("requestId", request.ID),
+		slog.String("queueId", queue.ID),
+		slog.String("rule", reminder.Rule.Key()))
+
+	delivered := false
+	switch reminder.Rule.Target {
+	case domain.ReminderTargetChannel:
+		if queue.ChannelId == "" {
+			slog.WarnContext(ctx, "Queue has no channel to send reminders to", slog.String("queueId", queue.ID))
+			return false
+		}
+		if _, err := s.msgRenderer.RenderReminder(ctx, queue.ChannelId, request, message); err != nil {
+			slog.ErrorContext(ctx, "Failed to send reminder to queue channel",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("channelId", queue.ChannelId))
+			return false
+		}
+		delivered = true
+
+	case domain.ReminderTargetAssignee:
+		for _, assigneeId := range request.AssigneeIDs() {
+			if err := s.remindUser(ctx, request, assigneeId, message); err != nil {
+				slog.ErrorContext(ctx, "Failed to remind assignee",
+					slog.String("err", err.Error()),
+					slog.String("requestId", request.ID),
+					slog.String("userId", assigneeId))
+				continue
+			}
+			delivered = true
+		}
+
+	case domain.ReminderTargetAdmins:
+		for _, adminId := range queue.AdminIds {
+			if err := s.remindUser(ctx, request, adminId, message); err != nil {
+				slog.ErrorContext(ctx, "Failed to remind queue admin",
+					slog.String("err", err.Error()),
+					slog.String("requestId", request.ID),
+					slog.String("userId", adminId))
+				continue
+			}
+			delivered = true
+		}
+	}
+
+	return delivered
+}
+
+func (s *ReminderService) remindUser(ctx context.Context, request *domain.Request, userId, message string) error {
+	channelId, _, err := s.messenger.SendDirectMessage(ctx, userId, "")
+	if err != nil {
+		return fmt.Errorf("failed to open DM: %w", err)
+	}
+
+	if _, err := s.msgRenderer.RenderReminder(ctx, channelId, request, message); err != nil {
+		return fmt.Errorf("failed to render reminder: %w", err)
+	}
+	return nil
+}
+
+func reminderText(queue *domain.Queue, request *domain.Request, reminder domain.DueReminder, at time.Time) string {
+	waited := formatReminderDelay(at.Sub(reminder.Since))
+
+	if reminder.Rule.Condition == domain.ReminderNoUpdate {
+		return fmt.Sprintf("⏰ '%s' in *%s* was accepted by <@%s> and hasn't been updated for %s.", request.Title, queue.Name, request.AcceptedByID, waited)
+	}
+	return fmt.Sprintf("⏰ '%s' in *%s* has been waiting for someone to pick it up for %s.", request.Title, queue.Name, waited)
+}
+
+func formatReminderDelay(delay time.Duration) string {
+	days := int(delay / (24 * time.Hour))
+	hours := int((delay % (24 * time.Hour)) / time.Hour)
+
+	switch {
+	case days == 0:
+		return fmt.Sprintf("%dh", hours)
+	case hours == 0:
+		return fmt.Sprintf("%dd", days)
+	default:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+}
+
+func (s *ReminderService) SendLaterReminder(ctx context.Context, requestId, userId string) error {
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("failed to load request: %w", err)
+	}
+
+	if !request.IsOpen() {
+		slog.DebugContext(ctx, "Skipping reminder for closed request", slog.String("requestId", requestId))
+		return nil
+	}
+
+	message := fmt.Sprintf("⏰ You asked to be reminded about '%s'.", request.Title)
+	if err := s.remindUser(ctx, request, userId, message); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Sent requested reminder",
+		slog.String("requestId", requestId),
+		slog.String("userId", userId))
+	return nil
+}
+
+func (s *ReminderService) SnoozeReminders(ctx context.Context, requestId, userId, channelId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	var queue *domain.Queue
+	if request.Recipient.Type == domain.RequestRecipientQueue {
+		queue, err = s.queuesReader.GetById(ctx, request.Recipient.ID)
+		if err != nil {
+			return fmt.Errorf("queue not found: %w", err)
+		}
+	}
+
+	authCtx := domain.NewAuthorizationContext(request, queue, userId)
+	authCtx.OnDecision = logPolicyDecision(ctx)
+	if !authCtx.CanSnoozeReminders() {
+		slog.WarnContext(ctx, "Unauthorized attempt to snooze reminders",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to snooze reminders for this request")
+	}
+
+	until := time.Now().Add(domain.ReminderSnoozeDuration)
+	if err := request.SnoozeReminders(until); err != nil {
+		return err
+	}
+
+	if err := s.requestsWriter.RecordRemindersSnoozed(ctx, request.ID, request.RemindersSnoozedUntil); err != nil {
+		return fmt.Errorf("failed to snooze reminders: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Reminders snoozed",
+		slog.String("requestId", requestId),
+		slog.String("snoozedBy", userId),
+		slog.Time("until", until))
+
+	message := fmt.Sprintf("Reminders for '%s' are snoozed for a day.", request.Title)
+	if err := s.messenger.SendEphemeralMessage(ctx, channelId, userId, message); err != nil {
+		slog.ErrorContext(ctx, "Failed to confirm snooze",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	return nil
+}
+
+func (s *ReminderService) RemindLater(ctx context.Context, requestId, userId, channelId string, delay time.Duration) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if delay <= 0 {
+		return fmt.Errorf("reminder delay must be positive")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	if !request.IsOpen() {
+		return fmt.Errorf("request is no longer open")
+	}
+
+	payload, err := json.Marshal(remindLaterPayload{RequestID: requestId, UserID: userId})
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+
+	runAt := time.Now().Add(delay).Truncate(time.Minute)
+	key := fmt.Sprintf("%s:%s:%s:%d", JobRemindLater, requestId, userId, runAt.Unix())
+	if _, err := s.scheduler.ScheduleJob(ctx, JobRemindLater, key, string(payload), runAt); err != nil {
+		return fmt.Errorf("failed to schedule reminder: %w", err)
+	}
+
+	message := fmt.Sprintf("I'll remind you about '%s' in %s.", request.Title, formatReminderDelay(delay))
+	if err := s.messenger.SendEphemeralMessage(ctx, channelId, userId, message); err != nil {
+		slog.ErrorContext(ctx, "Failed to confirm reminder",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	return nil
+}
+
+func decodeRemindLaterPayload(payload string) (remindLaterPayload, error) {
+	var decoded remindLaterPayload
+	if err := json.Unmarshal([]byte(payload), &decoded); err != nil {
+		return remindLaterPayload{}, fmt.Errorf("invalid reminder payload: %w", err)
+	}
+	return decoded, nil
+}
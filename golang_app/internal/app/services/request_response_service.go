@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"request/internal/app/ports/primaryports"
 	"request/internal/app/ports/secondaryports"
@@ -11,28 +14,185 @@ import (
 )
 
 type RequestResponseService struct {
-	requestsWriter secondaryports.ForStoringRequests
-	requestsReader secondaryports.ForReadingRequests
-	queuesReader   secondaryports.ForReadingQueues
-	messenger      secondaryports.ForMessagingUsers
+	requestsWriter    secondaryports.ForStoringRequests
+	requestsReader    secondaryports.ForReadingRequests
+	queuesReader      secondaryports.ForReadingQueues
+	queuesWriter      secondaryports.ForStoringQueues
+	messenger         secondaryports.ForMessagingUsers
+	msgRenderer       secondaryports.ForRenderingMessages
+	channelMembership secondaryports.ForCheckingChannelMembership
+	settings          domain.WorkspaceSettings
 }
 
 var _ primaryports.ForRespondingToRequests = (*RequestResponseService)(nil)
+var _ primaryports.ForEscalatingApprovals = (*RequestResponseService)(nil)
+var _ primaryports.ForExpiringRequests = (*RequestResponseService)(nil)
 
 func NewRequestResponseService(
 	requestsWriter secondaryports.ForStoringRequests,
 	requestsReader secondaryports.ForReadingRequests,
 	queuesReader secondaryports.ForReadingQueues,
+	queuesWriter secondaryports.ForStoringQueues,
 	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+	channelMembership secondaryports.ForCheckingChannelMembership,
+	settings domain.WorkspaceSettings,
 ) *RequestResponseService {
 	return &RequestResponseService{
-		requestsWriter: requestsWriter,
-		requestsReader: requestsReader,
-		queuesReader:   queuesReader,
-		messenger:      messenger,
+		requestsWriter:    requestsWriter,
+		requestsReader:    requestsReader,
+		queuesReader:      queuesReader,
+		queuesWriter:      queuesWriter,
+		messenger:         messenger,
+		msgRenderer:       msgRenderer,
+		channelMembership: channelMembership,
+		settings:          settings,
 	}
 }
 
+func (s *RequestResponseService) ApproveRequest(ctx context.Context, requestId, userId, comment string) error {
+	return s.decideApproval(ctx, requestId, userId, comment, true)
+}
+
+func (s *RequestResponseService) DenyRequest(ctx context.Context, requestId, userId, comment string) error {
+	return s.decideApproval(ctx, requestId, userId, comment, false)
+}
+
+func (s *RequestResponseService) decideApproval(ctx context.Context, requestId, userId, comment string, approve bool) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanDecideApproval() {
+		slog.WarnContext(ctx, "Unauthorized attempt to decide approval",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("status", string(request.Status)))
+		return fmt.Errorf("user is not authorized to approve this request")
+	}
+
+	stage, _ := request.CurrentApprovalStage()
+	stageIndex := request.ApprovalStage
+
+	if approve {
+		err = request.Approve(userId, comment)
+	} else {
+		err = request.Deny(userId, comment)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record approval decision: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save approval decision",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Approval decided",
+		slog.String("requestId", requestId),
+		slog.String("approverId", userId),
+		slog.Bool("approved", approve),
+		slog.String("status", string(request.Status)))
+
+	s.refreshApprovalRequests(ctx, request)
+
+	switch {
+	case request.Status == domain.RequestDenied:
+		s.notifyApprovalDecision(ctx, request, fmt.Sprintf("denied by <@%s> at the %s stage. Comment: %s", userId, stage.Name, comment))
+		return nil
+
+	case request.Status == domain.RequestAwaitingApproval && request.ApprovalStage != stageIndex:
+		s.notifyApprovalDecision(ctx, request, fmt.Sprintf("approved at the %s stage", stage.Name))
+		s.requestNextApprovals(ctx, request, request.CurrentApproverIDs())
+		return nil
+
+	case request.Status == domain.RequestAwaitingApproval:
+		return nil
+	}
+
+	s.notifyApprovalDecision(ctx, request, fmt.Sprintf("approved by <@%s>", userId))
+
+	if assigneeId, ok := autoAssignRequest(ctx, s.requestsReader, s.queuesWriter, s.queuesReader, authCtx.Queue, request); ok {
+		if err := s.requestsWriter.Save(ctx, request); err != nil {
+			slog.ErrorContext(ctx, "Failed to save auto-assigned request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+		}
+		sendAssignmentNotice(ctx, s.messenger, s.msgRenderer, request, assigneeId)
+	}
+
+	notification, err := sendRequestNotification(ctx, s.messenger, s.msgRenderer, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to send notification for approved request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return nil
+	}
+
+	request.Notification = notification
+	if err := s.requestsWriter.Save(ctx, request); err != nil {
+		slog.ErrorContext(ctx, "Failed to save request notification reference",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) EscalateOverdueApprovals(ctx context.Context, at time.Time) (int, error) {
+	requests, err := s.requestsReader.FindByStatuses(ctx, []domain.RequestStatus{domain.RequestAwaitingApproval})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find requests awaiting approval: %w", err)
+	}
+
+	escalated := 0
+	for _, request := range requests {
+		if !request.EscalateApproval(at) {
+			continue
+		}
+
+		stage, _ := request.CurrentApprovalStage()
+
+		err := s.requestsWriter.Save(ctx, request)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to save escalated request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID))
+			continue
+		}
+
+		slog.InfoContext(ctx, "Approval escalated",
+			slog.String("requestId", request.ID),
+			slog.String("stage", stage.Name),
+			slog.String("fallbackApproverId", stage.FallbackApproverID))
+
+		s.refreshApprovalRequests(ctx, request)
+		if !request.HasApprovalMessage(stage.FallbackApproverID) {
+			s.requestNextApprovals(ctx, request, []string{stage.FallbackApproverID})
+		}
+		escalated++
+	}
+
+	return escalated, nil
+}
+
 func (s *RequestResponseService) AcceptRequest(ctx context.Context, requestId, userId string) error {
 	if requestId == "" {
 		return fmt.Errorf("request ID is required")
@@ -52,44 +212,1037 @@ func (s *RequestResponseService) AcceptRequest(ctx context.Context, requestId, u
 		return fmt.Errorf("failed to build authorization context: %w", err)
 	}
 
-	if !authCtx.CanAccept() {
-		slog.WarnContext(ctx, "Unauthorized attempt to accept request",
-			slog.String("requestId", requestId),
+	if !authCtx.CanAccept() {
+		slog.WarnContext(ctx, "Unauthorized attempt to accept request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("recipientType", string(request.Recipient.Type)),
+			slog.String("recipientId", request.Recipient.ID))
+		return fmt.Errorf("user is not authorized to respond to this request")
+	}
+
+	err = request.Accept(userId)
+	if err != nil {
+		return fmt.Errorf("failed to accept request: %w", err)
+	}
+
+	err = checkWIPLimit(ctx, s.requestsReader, authCtx.Queue, userId, userId)
+	if err != nil {
+		slog.InfoContext(ctx, "Request not accepted over work in progress limit",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("failed to accept request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save accepted request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request accepted",
+		slog.String("requestId", requestId),
+		slog.String("acceptedBy", userId),
+		slog.String("createdBy", request.CreatedByID))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestCreator(ctx, request, "accepted")
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify request creator",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	s.notifyWatchers(ctx, request, "accepted", userId)
+
+	if request.IsBlocked() {
+		err = s.warnAcceptorOfBlockers(ctx, request)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to warn acceptor of blockers",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+		}
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) AssignRequest(ctx context.Context, requestId, assigneeId, userId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if assigneeId == "" {
+		return fmt.Errorf("assignee ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanAssign() {
+		slog.WarnContext(ctx, "Unauthorized attempt to assign request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to assign this request")
+	}
+
+	assigneeCtx, err := s.buildAuthorizationContext(ctx, request, assigneeId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !assigneeCtx.CanAccept() {
+		return fmt.Errorf("<@%s> cannot respond to this request", assigneeId)
+	}
+
+	err = request.Accept(assigneeId)
+	if err != nil {
+		return fmt.Errorf("failed to assign request: %w", err)
+	}
+
+	err = checkWIPLimit(ctx, s.requestsReader, authCtx.Queue, assigneeId, userId)
+	if err != nil {
+		slog.InfoContext(ctx, "Request not assigned over work in progress limit",
+			slog.String("requestId", requestId),
+			slog.String("assigneeId", assigneeId),
+			slog.String("assignedBy", userId))
+		return fmt.Errorf("failed to assign request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save assigned request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request assigned",
+		slog.String("requestId", requestId),
+		slog.String("assigneeId", assigneeId),
+		slog.String("assignedBy", userId))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestCreator(ctx, request, fmt.Sprintf("assigned to <@%s>", assigneeId))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify request creator",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	if assigneeId != userId {
+		message := fmt.Sprintf("<@%s> assigned you the request '%s'", userId, request.Title)
+		_, _, err = s.messenger.SendDirectMessage(ctx, assigneeId, message)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to notify assignee",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+		}
+	}
+
+	s.notifyWatchers(ctx, request, fmt.Sprintf("assigned to <@%s>", assigneeId), userId)
+
+	return nil
+}
+
+func (s *RequestResponseService) DeclineRequest(ctx context.Context, requestId, userId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	err = request.DeclineAssignment(userId)
+	if err != nil {
+		return fmt.Errorf("failed to decline request: %w", err)
+	}
+
+	assigneeId, reassigned := autoAssignRequest(ctx, s.requestsReader, s.queuesWriter, s.queuesReader, authCtx.Queue, request)
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save declined request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request declined",
+		slog.String("requestId", requestId),
+		slog.String("declinedBy", userId),
+		slog.String("reassignedTo", assigneeId))
+
+	s.refreshRequestCard(ctx, request)
+
+	if reassigned {
+		sendAssignmentNotice(ctx, s.messenger, s.msgRenderer, request, assigneeId)
+		s.notifyWatchers(ctx, request, fmt.Sprintf("reassigned to <@%s>", assigneeId), userId)
+	} else {
+		s.notifyWatchers(ctx, request, "declined and is waiting for a volunteer", userId)
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) RejectRequest(ctx context.Context, requestId, userId, reason string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if reason == "" {
+		return fmt.Errorf("rejection reason is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanReject() {
+		slog.WarnContext(ctx, "Unauthorized attempt to reject request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to respond to this request")
+	}
+
+	err = request.Reject(reason)
+	if err != nil {
+		return fmt.Errorf("failed to reject request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save rejected request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request rejected",
+		slog.String("requestId", requestId),
+		slog.String("rejectedBy", userId),
+		slog.String("createdBy", request.CreatedByID),
+		slog.Duration("activeFor", request.ActiveDuration(request.ResolvedAt)),
+		slog.Duration("onHoldFor", request.TimeOnHold(request.ResolvedAt)))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestStakeholders(ctx, request, "rejected", userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	s.notifyDependents(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) CompleteRequest(ctx context.Context, requestId, userId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanComplete() {
+		slog.WarnContext(ctx, "Unauthorized attempt to complete request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("acceptedBy", request.AcceptedByID),
+			slog.String("createdBy", request.CreatedByID))
+		return fmt.Errorf("user is not authorized to complete this request")
+	}
+
+	err = request.Complete()
+	if err != nil {
+		return fmt.Errorf("failed to complete request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save completed request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request completed",
+		slog.String("requestId", requestId),
+		slog.String("completedBy", userId),
+		slog.String("createdBy", request.CreatedByID),
+		slog.String("acceptedBy", request.AcceptedByID),
+		slog.Duration("activeFor", request.ActiveDuration(request.ResolvedAt)),
+		slog.Duration("onHoldFor", request.TimeOnHold(request.ResolvedAt)))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestStakeholders(ctx, request, "completed", userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	s.notifyDependents(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) TransitionRequest(ctx context.Context, requestId, statusKey, userId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanTransition() {
+		slog.WarnContext(ctx, "Unauthorized attempt to transition request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("to", statusKey))
+		return fmt.Errorf("user is not authorized to change the status of this request")
+	}
+
+	from := request.WorkflowStatus
+	wasPending := request.Status == domain.RequestPending
+	err = request.Transition(statusKey, userId)
+	if err != nil {
+		return fmt.Errorf("failed to transition request: %w", err)
+	}
+
+	if wasPending && request.Status == domain.RequestAccepted {
+		err = checkWIPLimit(ctx, s.requestsReader, authCtx.Queue, userId, userId)
+		if err != nil {
+			return fmt.Errorf("failed to transition request: %w", err)
+		}
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save transitioned request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	status, _ := request.CurrentWorkflowStatus()
+
+	slog.InfoContext(ctx, "Request transitioned",
+		slog.String("requestId", requestId),
+		slog.String("from", from),
+		slog.String("to", status.Key),
+		slog.String("category", string(status.Category)),
+		slog.String("transitionedBy", userId))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestStakeholders(ctx, request, fmt.Sprintf("moved to %s", status.Name), userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	if !request.IsOpen() {
+		s.notifyDependents(ctx, request)
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) JoinRequest(ctx context.Context, requestId, userId string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanJoin() {
+		slog.WarnContext(ctx, "Unauthorized attempt to join request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("recipientType", string(request.Recipient.Type)),
+			slog.String("recipientId", request.Recipient.ID))
+		return fmt.Errorf("user is not authorized to join this request")
+	}
+
+	err = request.AddCollaborator(userId)
+	if err != nil {
+		return fmt.Errorf("failed to join request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save request collaborators",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Collaborator joined request",
+		slog.String("requestId", requestId),
+		slog.String("userId", userId),
+		slog.Int("collaboratorCount", len(request.CollaboratorIDs)))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestStakeholders(ctx, request, fmt.Sprintf("joined by <@%s>", userId), userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) TickChecklistItems(ctx context.Context, requestId, userId string, doneItemIds []string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanTickChecklist() {
+		slog.WarnContext(ctx, "Unauthorized attempt to tick checklist",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		s.refreshRequestCard(ctx, request)
+		return fmt.Errorf("user is not authorized to tick this request's checklist")
+	}
+
+	err = request.TickChecklistItems(doneItemIds, userId)
+	if err != nil {
+		return fmt.Errorf("failed to tick checklist: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save request checklist",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	done, total := request.ChecklistProgress()
+	slog.InfoContext(ctx, "Request checklist updated",
+		slog.String("requestId", requestId),
+		slog.String("userId", userId),
+		slog.Int("done", done),
+		slog.Int("total", total))
+
+	s.refreshRequestCard(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) SetRequestBlockers(ctx context.Context, requestId, userId string, blockerIds []string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanManageBlockers() {
+		slog.WarnContext(ctx, "Unauthorized attempt to change request blockers",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to change this request's blockers")
+	}
+
+	blockers := make([]*domain.Request, 0, len(blockerIds))
+	for _, blockerId := range blockerIds {
+		blocker, err := s.requestsReader.GetById(ctx, blockerId)
+		if err != nil {
+			return fmt.Errorf("blocking request not found: %w", err)
+		}
+		blockers = append(blockers, blocker)
+	}
+
+	graph, err := s.requestsReader.LoadDependencyGraph(ctx, blockerIds)
+	if err != nil {
+		return fmt.Errorf("failed to load dependencies: %w", err)
+	}
+
+	err = request.SetBlockers(blockers, graph)
+	if err != nil {
+		return fmt.Errorf("failed to set blockers: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save request blockers",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request blockers updated",
+		slog.String("requestId", requestId),
+		slog.String("userId", userId),
+		slog.Int("blockerCount", len(request.BlockedBy)))
+
+	s.refreshRequestCard(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) MarkRequestDuplicate(ctx context.Context, requestId, canonicalId, userId string) error {
+	if requestId == "" || canonicalId == "" {
+		return fmt.Errorf("request and canonical request IDs are required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	duplicate, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	canonical, err := s.requestsReader.GetById(ctx, canonicalId)
+	if err != nil {
+		return fmt.Errorf("canonical request not found: %w", err)
+	}
+
+	for _, request := range []*domain.Request{duplicate, canonical} {
+		authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+		if err != nil {
+			return fmt.Errorf("failed to build authorization context: %w", err)
+		}
+
+		if !authCtx.CanMarkDuplicate() {
+			slog.WarnContext(ctx, "Unauthorized attempt to merge requests",
+				slog.String("requestId", requestId),
+				slog.String("canonicalId", canonicalId),
+				slog.String("deniedOn", request.ID),
+				slog.String("userId", userId))
+			return fmt.Errorf("user is not authorized to merge these requests")
+		}
+	}
+
+	err = duplicate.MarkDuplicateOf(canonical)
+	if err != nil {
+		return fmt.Errorf("failed to mark request as duplicate: %w", err)
+	}
+
+	err = canonical.AbsorbDuplicate(duplicate)
+	if err != nil {
+		return fmt.Errorf("failed to merge duplicate: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, canonical)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save canonical request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", canonicalId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, duplicate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save duplicate request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request merged as duplicate",
+		slog.String("requestId", requestId),
+		slog.String("canonicalId", canonicalId),
+		slog.String("mergedBy", userId))
+
+	s.moveDependents(ctx, duplicate, canonical)
+	s.refreshRequestCard(ctx, duplicate)
+	s.refreshRequestCard(ctx, canonical)
+
+	err = s.notifyRequestStakeholders(ctx, duplicate, fmt.Sprintf("closed as a duplicate of '%s'", canonical.Title), userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	if duplicate.CreatedByID != userId && canonical.IsWatcher(duplicate.CreatedByID) {
+		message := fmt.Sprintf("You'll now receive status updates for '%s'", canonical.Title)
+		_, _, err := s.messenger.SendDirectMessage(ctx, duplicate.CreatedByID, message)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to notify duplicate creator",
+				slog.String("err", err.Error()),
+				slog.String("userId", duplicate.CreatedByID))
+		}
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) TransferRequest(ctx context.Context, requestId, queueId, userId, reason string) error {
+	if requestId == "" || queueId == "" {
+		return fmt.Errorf("request and queue IDs are required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanTransfer() {
+		slog.WarnContext(ctx, "Unauthorized attempt to transfer request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to transfer this request")
+	}
+
+	target, err := s.queuesReader.GetById(ctx, queueId)
+	if err != nil {
+		return fmt.Errorf("queue not found: %w", err)
+	}
+
+	previousAssigneeIds := request.AssigneeIDs()
+	previousCard := request.Notification
+
+	err = request.TransferTo(authCtx.Queue, target, userId, reason, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to transfer request: %w", err)
+	}
+
+	if request.AcceptedByID != "" {
+		err = checkWIPLimit(ctx, s.requestsReader, target, request.AcceptedByID, userId)
+		if err != nil {
+			return fmt.Errorf("failed to transfer request: %w", err)
+		}
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save transferred request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request transferred",
+		slog.String("requestId", requestId),
+		slog.String("fromQueueId", authCtx.Queue.ID),
+		slog.String("toQueueId", target.ID),
+		slog.String("transferredBy", userId))
+
+	if previousCard != nil {
+		err = s.msgRenderer.UpdateTransferredNotification(ctx, previousCard.ChannelID, previousCard.Ts, request)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to update previous request card",
+				slog.String("err", err.Error()),
+				slog.String("requestId", requestId))
+		}
+	}
+
+	action := fmt.Sprintf("moved to %s", target.Name)
+	if request.Status == domain.RequestAwaitingApproval {
+		request.Notification = nil
+		action = fmt.Sprintf("moved to %s and is awaiting approval from %s", target.Name, mentions(request.CurrentApproverIDs()))
+		s.requestNextApprovals(ctx, request, request.CurrentApproverIDs())
+	} else {
+		s.postTransferredCard(ctx, request, target)
+	}
+
+	for _, assigneeId := range previousAssigneeIds {
+		if request.IsAssignee(assigneeId) || assigneeId == userId {
+			continue
+		}
+
+		message := fmt.Sprintf("The request '%s' you were working on has been moved to %s and is no longer assigned to you", request.Title, target.Name)
+		_, _, err := s.messenger.SendDirectMessage(ctx, assigneeId, message)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to notify previous assignee",
+				slog.String("err", err.Error()),
+				slog.String("userId", assigneeId))
+		}
+	}
+
+	err = s.notifyRequestStakeholders(ctx, request, action, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify stakeholders",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) postTransferredCard(ctx context.Context, request *domain.Request, queue *domain.Queue) {
+	if queue.ChannelId == "" {
+		request.Notification = nil
+	} else {
+		messageTs, err := s.msgRenderer.RenderRequestNotification(ctx, queue.ChannelId, request)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to post transferred request card",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("queueId", queue.ID))
+			request.Notification = nil
+		} else {
+			request.Notification = &domain.MessageRef{ChannelID: queue.ChannelId, Ts: messageTs}
+		}
+	}
+
+	if err := s.requestsWriter.Save(ctx, request); err != nil {
+		slog.ErrorContext(ctx, "Failed to save request notification reference",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func (s *RequestResponseService) ToggleWatch(ctx context.Context, requestId, userId string) (bool, error) {
+	if requestId == "" {
+		return false, fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return false, fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return false, fmt.Errorf("request not found: %w", err)
+	}
+
+	watching := !request.IsWatcher(userId)
+	if watching {
+		err = request.AddWatcher(userId)
+	} else {
+		err = request.RemoveWatcher(userId)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to update watchers: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save request watchers",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return false, fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request watchers updated",
+		slog.String("requestId", requestId),
+		slog.String("userId", userId),
+		slog.Bool("watching", watching))
+
+	s.refreshRequestCard(ctx, request)
+
+	return watching, nil
+}
+
+func (s *RequestResponseService) LabelRequest(ctx context.Context, requestId, userId string, labels []string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanLabel() {
+		slog.WarnContext(ctx, "Unauthorized attempt to label request",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId))
+		return fmt.Errorf("user is not authorized to label this request")
+	}
+
+	err = request.SetLabels(labels, authCtx.Queue)
+	if err != nil {
+		return fmt.Errorf("failed to label request: %w", err)
+	}
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save labelled request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request labels updated",
+		slog.String("requestId", requestId),
+		slog.String("labelledBy", userId),
+		slog.Int("labelCount", len(request.Labels)))
+
+	s.refreshRequestCard(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) PutRequestOnHold(ctx context.Context, requestId, userId, question string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if question == "" {
+		return fmt.Errorf("a question for the requester is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanPutOnHold() {
+		slog.WarnContext(ctx, "Unauthorized attempt to put request on hold",
+			slog.String("requestId", requestId),
+			slog.String("userId", userId),
+			slog.String("acceptedBy", request.AcceptedByID))
+		return fmt.Errorf("user is not authorized to put this request on hold")
+	}
+
+	err = request.PutOnHold(question)
+	if err != nil {
+		return fmt.Errorf("failed to put request on hold: %w", err)
+	}
+
+	holdMessage, err := s.askRequester(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to send hold question to requester",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+	}
+	request.HoldMessage = holdMessage
+
+	s.notifyWatchers(ctx, request, "put on hold", userId)
+
+	err = s.requestsWriter.Save(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save on hold request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return fmt.Errorf("failed to save request: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Request put on hold",
+		slog.String("requestId", requestId),
+		slog.String("heldBy", userId),
+		slog.String("createdBy", request.CreatedByID))
+
+	s.refreshRequestCard(ctx, request)
+
+	return nil
+}
+
+func (s *RequestResponseService) ResumeRequest(ctx context.Context, requestId, userId, reply string) error {
+	if requestId == "" {
+		return fmt.Errorf("request ID is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		return fmt.Errorf("request not found: %w", err)
+	}
+
+	return s.resumeRequest(ctx, request, userId, reply)
+}
+
+func (s *RequestResponseService) ResumeRequestFromThread(ctx context.Context, channelId, threadTs, userId, reply string) error {
+	if channelId == "" || threadTs == "" {
+		return fmt.Errorf("thread reference is required")
+	}
+
+	if userId == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	request, err := s.requestsReader.FindByHoldMessage(ctx, channelId, threadTs)
+	if err != nil {
+		return fmt.Errorf("failed to find request for thread: %w", err)
+	}
+
+	if request == nil {
+		slog.DebugContext(ctx, "Thread reply does not belong to an on hold request",
+			slog.String("channelId", channelId),
+			slog.String("threadTs", threadTs))
+		return nil
+	}
+
+	return s.resumeRequest(ctx, request, userId, reply)
+}
+
+func (s *RequestResponseService) resumeRequest(ctx context.Context, request *domain.Request, userId, reply string) error {
+	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
+	if err != nil {
+		return fmt.Errorf("failed to build authorization context: %w", err)
+	}
+
+	if !authCtx.CanResume() {
+		slog.WarnContext(ctx, "Unauthorized attempt to resume request",
+			slog.String("requestId", request.ID),
 			slog.String("userId", userId),
-			slog.String("recipientType", string(request.Recipient.Type)),
-			slog.String("recipientId", request.Recipient.ID))
-		return fmt.Errorf("user is not authorized to respond to this request")
+			slog.String("createdBy", request.CreatedByID))
+		return fmt.Errorf("user is not authorized to resume this request")
 	}
 
-	err = request.Accept(userId)
+	err = request.Resume(reply)
 	if err != nil {
-		return fmt.Errorf("failed to accept request: %w", err)
+		return fmt.Errorf("failed to resume request: %w", err)
 	}
 
 	err = s.requestsWriter.Save(ctx, request)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to save accepted request",
+		slog.ErrorContext(ctx, "Failed to save resumed request",
 			slog.String("err", err.Error()),
-			slog.String("requestId", requestId))
+			slog.String("requestId", request.ID))
 		return fmt.Errorf("failed to save request: %w", err)
 	}
 
-	slog.InfoContext(ctx, "Request accepted",
-		slog.String("requestId", requestId),
-		slog.String("acceptedBy", userId),
-		slog.String("createdBy", request.CreatedByID))
+	slog.InfoContext(ctx, "Request resumed",
+		slog.String("requestId", request.ID),
+		slog.String("resumedBy", userId),
+		slog.Duration("onHoldFor", request.OnHoldDuration))
 
-	err = s.notifyRequestCreator(ctx, request, "accepted")
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyAcceptorOfReply(ctx, request)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to notify request creator",
+		slog.ErrorContext(ctx, "Failed to notify request acceptor",
 			slog.String("err", err.Error()),
-			slog.String("requestId", requestId))
+			slog.String("requestId", request.ID))
 	}
 
+	s.notifyWatchers(ctx, request, "resumed", userId)
+
 	return nil
 }
 
-func (s *RequestResponseService) RejectRequest(ctx context.Context, requestId, userId, reason string) error {
+func (s *RequestResponseService) KeepRequestOpen(ctx context.Context, requestId, userId string) error {
 	if requestId == "" {
 		return fmt.Errorf("request ID is required")
 	}
@@ -98,48 +1251,32 @@ func (s *RequestResponseService) RejectRequest(ctx context.Context, requestId, u
 		return fmt.Errorf("user ID is required")
 	}
 
-	if reason == "" {
-		return fmt.Errorf("rejection reason is required")
-	}
-
 	request, err := s.requestsReader.GetById(ctx, requestId)
 	if err != nil {
 		return fmt.Errorf("request not found: %w", err)
 	}
 
-	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
-	if err != nil {
-		return fmt.Errorf("failed to build authorization context: %w", err)
-	}
-
-	if !authCtx.CanReject() {
-		slog.WarnContext(ctx, "Unauthorized attempt to reject request",
-			slog.String("requestId", requestId),
-			slog.String("userId", userId))
-		return fmt.Errorf("user is not authorized to respond to this request")
-	}
-
-	err = request.Reject(reason)
+	err = request.KeepOpen(userId, time.Now())
 	if err != nil {
-		return fmt.Errorf("failed to reject request: %w", err)
+		return fmt.Errorf("failed to keep request open: %w", err)
 	}
 
 	err = s.requestsWriter.Save(ctx, request)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to save rejected request",
+		slog.ErrorContext(ctx, "Failed to save request kept open",
 			slog.String("err", err.Error()),
 			slog.String("requestId", requestId))
 		return fmt.Errorf("failed to save request: %w", err)
 	}
 
-	slog.InfoContext(ctx, "Request rejected",
+	slog.InfoContext(ctx, "Request kept open",
 		slog.String("requestId", requestId),
-		slog.String("rejectedBy", userId),
-		slog.String("createdBy", request.CreatedByID))
+		slog.String("keptOpenBy", userId))
 
-	err = s.notifyRequestCreator(ctx, request, "rejected")
+	message := fmt.Sprintf("Your request '%s' will stay open", request.Title)
+	_, _, err = s.messenger.SendDirectMessage(ctx, userId, message)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to notify request creator",
+		slog.ErrorContext(ctx, "Failed to confirm request kept open",
 			slog.String("err", err.Error()),
 			slog.String("requestId", requestId))
 	}
@@ -147,7 +1284,7 @@ func (s *RequestResponseService) RejectRequest(ctx context.Context, requestId, u
 	return nil
 }
 
-func (s *RequestResponseService) CompleteRequest(ctx context.Context, requestId, userId string) error {
+func (s *RequestResponseService) CancelRequest(ctx context.Context, requestId, userId string) error {
 	if requestId == "" {
 		return fmt.Errorf("request ID is required")
 	}
@@ -161,49 +1298,152 @@ func (s *RequestResponseService) CompleteRequest(ctx context.Context, requestId,
 		return fmt.Errorf("request not found: %w", err)
 	}
 
-	authCtx, err := s.buildAuthorizationContext(ctx, request, userId)
-	if err != nil {
-		return fmt.Errorf("failed to build authorization context: %w", err)
-	}
-
-	if !authCtx.CanComplete() {
-		slog.WarnContext(ctx, "Unauthorized attempt to complete request",
-			slog.String("requestId", requestId),
-			slog.String("userId", userId),
-			slog.String("acceptedBy", request.AcceptedByID),
-			slog.String("createdBy", request.CreatedByID))
-		return fmt.Errorf("user is not authorized to complete this request")
-	}
-
-	err = request.Complete()
+	err = request.Cancel(userId)
 	if err != nil {
-		return fmt.Errorf("failed to complete request: %w", err)
+		return fmt.Errorf("failed to cancel request: %w", err)
 	}
 
 	err = s.requestsWriter.Save(ctx, request)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to save completed request",
+		slog.ErrorContext(ctx, "Failed to save cancelled request",
 			slog.String("err", err.Error()),
 			slog.String("requestId", requestId))
 		return fmt.Errorf("failed to save request: %w", err)
 	}
 
-	slog.InfoContext(ctx, "Request completed",
+	slog.InfoContext(ctx, "Request cancelled",
 		slog.String("requestId", requestId),
-		slog.String("completedBy", userId),
-		slog.String("createdBy", request.CreatedByID),
-		slog.String("acceptedBy", request.AcceptedByID))
+		slog.String("cancelledBy", userId))
 
-	err = s.notifyRequestStakeholders(ctx, request, "completed", userId)
+	s.refreshRequestCard(ctx, request)
+	err = s.notifyRequestStakeholders(ctx, request, "cancelled", userId)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to notify stakeholders",
 			slog.String("err", err.Error()),
 			slog.String("requestId", requestId))
 	}
 
+	s.notifyDependents(ctx, request)
+
 	return nil
 }
 
+func (s *RequestResponseService) ExpireStaleRequests(ctx context.Context, at time.Time) (int, error) {
+	requests, err := s.requestsReader.FindByStatuses(ctx, []domain.RequestStatus{domain.RequestPending})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find pending requests: %w", err)
+	}
+
+	queues := map[string]*domain.Queue{}
+	expired := 0
+	for _, request := range requests {
+		var queue *domain.Queue
+		if request.Recipient != nil && request.Recipient.Type == domain.RequestRecipientQueue {
+			if cached, ok := queues[request.Recipient.ID]; ok {
+				queue = cached
+			} else if queue, err = s.queuesReader.GetById(ctx, request.Recipient.ID); err != nil {
+				slog.WarnContext(ctx, "Queue of pending request not found",
+					slog.String("requestId", request.ID),
+					slog.String("queueId", request.Recipient.ID))
+			}
+			queues[request.Recipient.ID] = queue
+		}
+
+		policy := domain.EffectiveExpiryPolicy(queue, s.settings)
+
+		switch {
+		case request.ExpiryDue(policy, at):
+			if s.expireRequest(ctx, request, at) {
+				expired++
+			}
+		case request.ExpiryWarningDue(policy, at):
+			s.warnOfExpiry(ctx, request, request.ExpiresAt(policy), at)
+		}
+	}
+
+	return expired, nil
+}
+
+func (s *RequestResponseService) expireRequest(ctx context.Context, request *domain.Request, at time.Time) bool {
+	lastUpdatedAt := request.UpdatedAt
+	if err := request.Expire(at); err != nil {
+		slog.ErrorContext(ctx, "Failed to expire request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return false
+	}
+
+	err := s.requestsWriter.RecordExpiry(ctx, request, lastUpdatedAt)
+	if errors.Is(err, domain.ErrRequestTouched) {
+		slog.InfoContext(ctx, "Request had activity before it could expire",
+			slog.String("requestId", request.ID))
+		return false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save expired request",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return false
+	}
+
+	slog.InfoContext(ctx, "Request expired",
+		slog.String("requestId", request.ID),
+		slog.Time("lastActivityAt", request.LastActivityAt()))
+
+	s.refreshRequestCard(ctx, request)
+
+	err = s.notifyRequestCreator(ctx, request, "closed as expired after no activity")
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify request creator",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+
+	s.notifyWatchers(ctx, request, "closed as expired", request.CreatedByID)
+	s.notifyDependents(ctx, request)
+
+	return true
+}
+
+func (s *RequestResponseService) warnOfExpiry(ctx context.Context, request *domain.Request, expiresAt, at time.Time) {
+	request.RecordExpiryWarning(at)
+
+	err := s.requestsWriter.RecordExpiryWarning(ctx, request.ID, request.ExpiryWarnedAt)
+	if errors.Is(err, domain.ErrRequestTouched) {
+		slog.InfoContext(ctx, "Request changed before its expiry warning was sent",
+			slog.String("requestId", request.ID))
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to save expiry warning",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return
+	}
+
+	message := fmt.Sprintf("Your request '%s' hasn't had any activity and will be closed soon", request.Title)
+
+	channelId, _, err := s.messenger.SendDirectMessage(ctx, request.CreatedByID, message)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to send expiry warning",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return
+	}
+
+	_, err = s.msgRenderer.RenderExpiryWarning(ctx, channelId, request, expiresAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to render expiry warning",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+		return
+	}
+
+	slog.InfoContext(ctx, "Expiry warning sent",
+		slog.String("requestId", request.ID),
+		slog.Time("expiresAt", expiresAt))
+}
+
 func (s *RequestResponseService) GetRequestDetails(ctx context.Context, requestId string) (*domain.Request, error) {
 	if requestId == "" {
 		return nil, fmt.Errorf("request ID is required")
@@ -282,7 +1522,31 @@ func (s *RequestResponseService) buildAuthorizationContext(ctx context.Context,
 		queue = q
 	}
 
-	return domain.NewAuthorizationContext(request, queue, userId), nil
+	authCtx := domain.NewAuthorizationContext(request, queue, userId)
+	authCtx.OnDecision = logPolicyDecision(ctx)
+
+	if request.Recipient.Type == domain.RequestRecipientChannel {
+		member, err := s.isChannelMember(ctx, request.Recipient.ID, userId)
+		if err != nil {
+			return nil, err
+		}
+		authCtx.ChannelMember = member
+	}
+
+	return authCtx, nil
+}
+
+func (s *RequestResponseService) isChannelMember(ctx context.Context, channelId, userId string) (bool, error) {
+	if s.settings.OpenChannelRequests {
+		return true, nil
+	}
+
+	member, err := s.channelMembership.IsChannelMember(ctx, channelId, userId)
+	if err != nil {
+		return false, fmt.Errorf("failed to check channel membership: %w", err)
+	}
+
+	return member, nil
 }
 
 func (s *RequestResponseService) notifyRequestCreator(ctx context.Context, request *domain.Request, action string) error {
@@ -317,5 +1581,210 @@ func (s *RequestResponseService) notifyRequestStakeholders(ctx context.Context,
 		}
 	}
 
+	for _, collaboratorId := range request.CollaboratorIDs {
+		if collaboratorId == actionBy {
+			continue
+		}
+
+		message := fmt.Sprintf("The request '%s' you are collaborating on has been %s", request.Title, action)
+		_, _, err := s.messenger.SendDirectMessage(ctx, collaboratorId, message)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to notify collaborator",
+				slog.String("err", err.Error()),
+				slog.String("userId", collaboratorId))
+		}
+	}
+
+	s.notifyWatchers(ctx, request, action, actionBy)
+
+	return nil
+}
+
+func (s *RequestResponseService) notifyWatchers(ctx context.Context, request *domain.Request, action, actionBy string) {
+	for _, watcherId := range request.NotifiableWatcherIDs() {
+		if watcherId == actionBy {
+			continue
+		}
+
+		message := fmt.Sprintf("The request '%s' you are following has been %s", request.Title, action)
+		_, _, err := s.messenger.SendDirectMessage(ctx, watcherId, message)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to notify watcher",
+				slog.String("err", err.Error()),
+				slog.String("userId", watcherId))
+		}
+	}
+}
+
+func (s *RequestResponseService) refreshApprovalRequests(ctx context.Context, request *domain.Request) {
+	for _, message := range request.ApprovalMessages {
+		err := s.msgRenderer.UpdateApprovalRequest(ctx, message.Message.ChannelID, message.Message.Ts, request, message.ApproverID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to update approval request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("approverId", message.ApproverID))
+		}
+	}
+}
+
+func (s *RequestResponseService) requestNextApprovals(ctx context.Context, request *domain.Request, approverIds []string) {
+	sendApprovalRequests(ctx, s.messenger, s.msgRenderer, request, approverIds)
+
+	if err := s.requestsWriter.Save(ctx, request); err != nil {
+		slog.ErrorContext(ctx, "Failed to save approval message references",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func (s *RequestResponseService) notifyApprovalDecision(ctx context.Context, request *domain.Request, action string) {
+	err := s.notifyRequestCreator(ctx, request, action)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to notify request creator",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func (s *RequestResponseService) refreshRequestCard(ctx context.Context, request *domain.Request) {
+	if request.Notification == nil {
+		return
+	}
+
+	err := s.msgRenderer.UpdateRequestNotification(ctx, request.Notification.ChannelID, request.Notification.Ts, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update request card",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func (s *RequestResponseService) moveDependents(ctx context.Context, duplicate, canonical *domain.Request) {
+	dependents, err := s.requestsReader.FindBlockedBy(ctx, duplicate.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find dependent requests",
+			slog.String("err", err.Error()),
+			slog.String("requestId", duplicate.ID))
+		return
+	}
+
+	for _, dependent := range dependents {
+		if !dependent.IsOpen() {
+			continue
+		}
+
+		blockerIds := []string{canonical.ID}
+		for _, blocker := range dependent.BlockedBy {
+			blockerIds = append(blockerIds, blocker.RequestID)
+		}
+
+		graph, err := s.requestsReader.LoadDependencyGraph(ctx, blockerIds)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to load dependencies",
+				slog.String("err", err.Error()))
+			return
+		}
+
+		err = dependent.ReplaceBlocker(duplicate.ID, canonical, graph)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to move blocker to canonical request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", dependent.ID),
+				slog.String("canonicalId", canonical.ID))
+			continue
+		}
+
+		err = s.requestsWriter.Save(ctx, dependent)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to save dependent request",
+				slog.String("err", err.Error()),
+				slog.String("requestId", dependent.ID))
+			continue
+		}
+
+		s.refreshRequestCard(ctx, dependent)
+	}
+}
+
+func (s *RequestResponseService) warnAcceptorOfBlockers(ctx context.Context, request *domain.Request) error {
+	message := fmt.Sprintf("Heads up: the request '%s' you accepted is still blocked by %s", request.Title, blockersText(request.OpenBlockers()))
+
+	_, _, err := s.messenger.SendDirectMessage(ctx, request.AcceptedByID, message)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RequestResponseService) notifyDependents(ctx context.Context, blocker *domain.Request) {
+	dependents, err := s.requestsReader.FindBlockedBy(ctx, blocker.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find dependent requests",
+			slog.String("err", err.Error()),
+			slog.String("requestId", blocker.ID))
+		return
+	}
+
+	for _, dependent := range dependents {
+		if !dependent.IsOpen() {
+			continue
+		}
+
+		s.refreshRequestCard(ctx, dependent)
+
+		message := fmt.Sprintf("'%s' has been %s, so the request '%s' is no longer blocked", blocker.Title, blocker.Status, dependent.Title)
+		if open := dependent.OpenBlockers(); len(open) > 0 {
+			message = fmt.Sprintf("'%s' has been %s, but the request '%s' is still blocked by %s", blocker.Title, blocker.Status, dependent.Title, blockersText(open))
+		}
+
+		for _, assigneeId := range dependent.AssigneeIDs() {
+			_, _, err := s.messenger.SendDirectMessage(ctx, assigneeId, message)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to notify assignee of unblocked request",
+					slog.String("err", err.Error()),
+					slog.String("requestId", dependent.ID),
+					slog.String("userId", assigneeId))
+			}
+		}
+	}
+}
+
+func blockersText(blockers []domain.Blocker) string {
+	titles := make([]string, len(blockers))
+	for i, blocker := range blockers {
+		titles[i] = fmt.Sprintf("'%s' (%s)", blocker.Title, blocker.Status)
+	}
+	return strings.Join(titles, ", ")
+}
+
+func (s *RequestResponseService) askRequester(ctx context.Context, request *domain.Request) (*domain.MessageRef, error) {
+	message := fmt.Sprintf("<@%s> needs more information before they can continue with your request '%s'", request.AcceptedByID, request.Title)
+
+	channelId, _, err := s.messenger.SendDirectMessage(ctx, request.CreatedByID, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send notification: %w", err)
+	}
+
+	messageTs, err := s.msgRenderer.RenderHoldQuestion(ctx, channelId, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render hold question: %w", err)
+	}
+
+	return &domain.MessageRef{ChannelID: channelId, Ts: messageTs}, nil
+}
+
+func (s *RequestResponseService) notifyAcceptorOfReply(ctx context.Context, request *domain.Request) error {
+	message := fmt.Sprintf("<@%s> replied to your question on '%s' and the request has been resumed", request.CreatedByID, request.Title)
+	if request.HoldReply != "" {
+		message += fmt.Sprintf("\n>%s", request.HoldReply)
+	}
+
+	_, _, err := s.messenger.SendDirectMessage(ctx, request.AcceptedByID, message)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"request/internal/app/ports/secondaryports"
+	"request/internal/app/services"
+	"request/internal/domain"
+)
+
+// memoryRequests keeps requests in memory and fills in blocker statuses on
+// read, the way the database store preloads them.
+type memoryRequests struct {
+	secondaryports.ForStoringRequests
+	secondaryports.ForReadingRequests
+	requests map[string]domain.Request
+}
+
+func newMemoryRequests(requests ...*domain.Request) *memoryRequests {
+	store := &memoryRequests{requests: map[string]domain.Request{}}
+	for _, request := range requests {
+		store.requests[request.ID] = *request
+	}
+	return store
+}
+
+func (m *memoryRequests) Save(ctx context.Context, request *domain.Request) error {
+	m.requests[request.ID] = *request
+	return nil
+}
+
+func (m *memoryRequests) GetById(ctx context.Context, requestId string) (*domain.Request, error) {
+	request, ok := m.requests[requestId]
+	if !ok {
+		return nil, errors.New("request not found")
+	}
+	return m.load(request), nil
+}
+
+func (m *memoryRequests) FindBlockedBy(ctx context.Context, blockerId string) ([]*domain.Request, error) {
+	dependents := []*domain.Request{}
+	for _, request := range m.requests {
+		if request.IsBlockedBy(blockerId) {
+			dependents = append(dependents, m.load(request))
+		}
+	}
+	return dependents, nil
+}
+
+func (m *memoryRequests) load(request domain.Request) *domain.Request {
+	request.BlockedBy = slices.Clone(request.BlockedBy)
+	for i, blocker := range request.BlockedBy {
+		request.BlockedBy[i].Status = m.requests[blocker.RequestID].Status
+	}
+	return &request
+}
+
+// directMessages records the direct messages sent to each user.
+type directMessages struct {
+	secondaryports.ForMessagingUsers
+	sent map[string][]string
+}
+
+func (d *directMessages) SendDirectMessage(ctx context.Context, userId, message string) (string, string, error) {
+	d.sent[userId] = append(d.sent[userId], message)
+	return "D" + userId, "1", nil
+}
+
+func newAcceptedRequest(t *testing.T, id, title, assigneeId string) *domain.Request {
+	t.Helper()
+
+	r, err := domain.NewRequest(id, title, "creator", &domain.RequestRecipient{
+		ID:   "recipient",
+		Type: domain.RequestRecipientUser,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if err := r.Accept(assigneeId); err != nil {
+		t.Fatalf("Failed to accept request: %v", err)
+	}
+
+	return &r
+}
+
+func TestCompleteRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should tell the dependent's assignees it is no longer blocked", func(t *testing.T) {
+		blocker := newAcceptedRequest(t, "blocker", "Provision database", "dba")
+		dependent := newAcceptedRequest(t, "dependent", "Deploy service", "engineer")
+		if err := dependent.SetBlockers([]*domain.Request{blocker}, domain.DependencyGraph{}); err != nil {
+			t.Fatalf("Failed to set blockers: %v", err)
+		}
+
+		store := newMemoryRequests(blocker, dependent)
+		messenger := &directMessages{sent: map[string][]string{}}
+		service := services.NewRequestResponseService(store, store, nil, nil, messenger, nil, nil, domain.WorkspaceSettings{})
+
+		if err := service.CompleteRequest(ctx, "blocker", "dba"); err != nil {
+			t.Fatalf("Failed to complete request: %v", err)
+		}
+
+		sent := messenger.sent["engineer"]
+		if len(sent) != 1 || !strings.Contains(sent[0], "is no longer blocked") {
+			t.Errorf("Expected the dependent's assignee to hear it is unblocked, got %v", sent)
+		}
+	})
+
+	t.Run("should list the blockers still open", func(t *testing.T) {
+		blocker := newAcceptedRequest(t, "blocker", "Provision database", "dba")
+		other := newAcceptedRequest(t, "other", "Open firewall", "netops")
+		dependent := newAcceptedRequest(t, "dependent", "Deploy service", "engineer")
+		if err := dependent.SetBlockers([]*domain.Request{blocker, other}, domain.DependencyGraph{}); err != nil {
+			t.Fatalf("Failed to set blockers: %v", err)
+		}
+
+		store := newMemoryRequests(blocker, other, dependent)
+		messenger := &directMessages{sent: map[string][]string{}}
+		service := services.NewRequestResponseService(store, store, nil, nil, messenger, nil, nil, domain.WorkspaceSettings{})
+
+		if err := service.CompleteRequest(ctx, "blocker", "dba"); err != nil {
+			t.Fatalf("Failed to complete request: %v", err)
+		}
+
+		sent := messenger.sent["engineer"]
+		if len(sent) != 1 || !strings.Contains(sent[0], "still blocked by 'Open firewall'") {
+			t.Errorf("Expected the dependent's assignee to hear what still blocks it, got %v", sent)
+		}
+		if len(messenger.sent["netops"]) != 0 {
+			t.Errorf("Expected the other blocker's assignee not to be told, got %v", messenger.sent["netops"])
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+)
+
+type RequestRouter struct {
+	queuesReader secondaryports.ForReadingQueues
+	userGroups   secondaryports.ForCheckingUserGroups
+}
+
+var _ primaryports.ForRoutingRequests = (*RequestRouter)(nil)
+
+func NewRequestRouter(
+	queuesReader secondaryports.ForReadingQueues,
+	userGroups secondaryports.ForCheckingUserGroups,
+) *RequestRouter {
+	return &RequestRouter{
+		queuesReader: queuesReader,
+		userGroups:   userGroups,
+	}
+}
+
+func (r *RequestRouter) RouteRequest(ctx context.Context, candidate domain.RoutingCandidate) (domain.RoutingMatch, bool, error) {
+	if candidate.ChannelID == "" {
+		return domain.RoutingMatch{}, false, fmt.Errorf("channel ID is required")
+	}
+
+	queues, err := r.queuesReader.FindAll(ctx)
+	if err != nil {
+		return domain.RoutingMatch{}, false, fmt.Errorf("failed to get queues: %w", err)
+	}
+
+	// A user group that can't be looked up counts as one the requester isn't in.
+	inGroup := func(userGroup string) bool {
+		member, err := r.userGroups.IsUserGroupMember(ctx, userGroup, candidate.CreatorID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to check user group membership",
+				slog.String("err", err.Error()),
+				slog.String("userGroup", userGroup),
+				slog.String("userId", candidate.CreatorID))
+			return false
+		}
+		return member
+	}
+
+	match, ok := domain.Route(queues, candidate, inGroup)
+	return match, ok, nil
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"request/internal/app/ports/primaryports"
+	"request/internal/app/ports/secondaryports"
+	"request/internal/domain"
+)
+
+// Requests resolved within this window are evaluated once more, so breaches
+// that happened between two runs are still recorded.
+const slaResolvedLookback = 24 * time.Hour
+
+type SLAService struct {
+	requestsWriter secondaryports.ForStoringRequests
+	requestsReader secondaryports.ForReadingRequests
+	queuesReader   secondaryports.ForReadingQueues
+	messenger      secondaryports.ForMessagingUsers
+	msgRenderer    secondaryports.ForRenderingMessages
+}
+
+var _ primaryports.ForEvaluatingSLAs = (*SLAService)(nil)
+
+func NewSLAService(
+	requestsWriter secondaryports.ForStoringRequests,
+	requestsReader secondaryports.ForReadingRequests,
+	queuesReader secondaryports.ForReadingQueues,
+	messenger secondaryports.ForMessagingUsers,
+	msgRenderer secondaryports.ForRenderingMessages,
+) *SLAService {
+	return &SLAService{
+		requestsWriter: requestsWriter,
+		requestsReader: requestsReader,
+		queuesReader:   queuesReader,
+		messenger:      messenger,
+		msgRenderer:    msgRenderer,
+	}
+}
+
+func (s *SLAService) EvaluateSLAs(ctx context.Context, at time.Time) (int, error) {
+	queues, err := s.queuesReader.FindAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	breached := 0
+	for _, queue := range queues {
+		if queue.SLA == nil {
+			continue
+		}
+
+		requests, err := s.findTrackedRequests(ctx, queue, at)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to find requests for SLA evaluation",
+				slog.String("err", err.Error()),
+				slog.String("queueId", queue.ID))
+			continue
+		}
+
+		for _, request := range requests {
+			previous := request.SLAState
+			newBreaches := request.ApplySLA(queue.SLAClocks(request, at), at)
+			if request.SLAState == previous && len(newBreaches) == 0 {
+				continue
+			}
+
+			breaches := make([]domain.SLABreach, len(newBreaches))
+			for i, target := range newBreaches {
+				breaches[i] = domain.SLABreach{Target: target, BreachedAt: at}
+			}
+
+			if err := s.requestsWriter.RecordSLAState(ctx, request.ID, request.SLAState, breaches); err != nil {
+				slog.ErrorContext(ctx, "Failed to save SLA state",
+					slog.String("err", err.Error()),
+					slog.String("requestId", request.ID))
+				continue
+			}
+
+			s.refreshRequestCard(ctx, request.ID)
+
+			for _, target := range newBreaches {
+				slog.InfoContext(ctx, "SLA breached",
+					slog.String("requestId", request.ID),
+					slog.String("queueId", queue.ID),
+					slog.String("target", string(target)))
+
+				s.notifyQueueAdmins(ctx, queue, request, target)
+				breached++
+			}
+		}
+	}
+
+	return breached, nil
+}
+
+func (s *SLAService) findTrackedRequests(ctx context.Context, queue *domain.Queue, at time.Time) ([]*domain.Request, error) {
+	open, err := s.requestsReader.FindByRecipientAndStatuses(ctx, queue.ID, domain.RequestRecipientQueue, domain.OpenRequestStatuses(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open requests: %w", err)
+	}
+
+	resolved, err := s.requestsReader.FindByRecipientResolvedSince(ctx, queue.ID, domain.RequestRecipientQueue, at.Add(-slaResolvedLookback))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resolved requests: %w", err)
+	}
+
+	return append(open, resolved...), nil
+}
+
+func (s *SLAService) refreshRequestCard(ctx context.Context, requestId string) {
+	request, err := s.requestsReader.GetById(ctx, requestId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to reload request for card update",
+			slog.String("err", err.Error()),
+			slog.String("requestId", requestId))
+		return
+	}
+
+	if request.Notification == nil {
+		return
+	}
+
+	err = s.msgRenderer.UpdateRequestNotification(ctx, request.Notification.ChannelID, request.Notification.Ts, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update request card",
+			slog.String("err", err.Error()),
+			slog.String("requestId", request.ID))
+	}
+}
+
+func (s *SLAService) notifyQueueAdmins(ctx context.Context, queue *domain.Queue, request *domain.Request, target domain.SLATarget) {
+	message := fmt.Sprintf("🚨 '%s' in *%s* was not accepted within %s.", request.Title, queue.Name, formatSLATarget(queue, queue.SLA.AcceptWithin))
+	if target == domain.SLATargetComplete {
+		message = fmt.Sprintf("🚨 '%s' in *%s* was not completed within %s.", request.Title, queue.Name, formatSLATarget(queue, queue.SLA.CompleteWithin))
+	}
+
+	for _, adminId := range queue.AdminIds {
+		if _, _, err := s.messenger.SendDirectMessage(ctx, adminId, message); err != nil {
+			slog.ErrorContext(ctx, "Failed to notify queue admin of SLA breach",
+				slog.String("err", err.Error()),
+				slog.String("requestId", request.ID),
+				slog.String("adminId", adminId))
+		}
+	}
+}
+
+func formatSLATarget(queue *domain.Queue, target time.Duration) string {
+	text := fmt.Sprintf("%dh", int(target/time.Hour))
+	if minutes := int((target % time.Hour) / time.Minute); minutes > 0 {
+		text += fmt.Sprintf("%02dm", minutes)
+	}
+
+	if queue.BusinessHours != nil {
+		text += " of business time"
+	}
+	return text
+}
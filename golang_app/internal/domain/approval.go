@@ -0,0 +1,381 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type QuorumRule string
+
+const (
+	QuorumAny   QuorumRule = "any"
+	QuorumAll   QuorumRule = "all"
+	QuorumCount QuorumRule = "count"
+)
+
+func (qr QuorumRule) Valid() bool {
+	switch qr {
+	case QuorumAny, QuorumAll, QuorumCount:
+		return true
+	default:
+		return false
+	}
+}
+
+const MaxApprovalStages = 3
+
+var (
+	ErrNotAnApprover = errors.New("user is not an approver for this request")
+	ErrNoApprover    = errors.New("nobody but the requester can approve this request")
+)
+
+type ApprovalStage struct {
+	Name               string
+	ApproverIDs        []string
+	Rule               QuorumRule
+	Quorum             int
+	Timeout            time.Duration
+	FallbackApproverID string
+}
+
+type ApprovalDecision struct {
+	Stage      int
+	ApproverID string
+	Approved   bool
+	Comment    string
+	DecidedAt  time.Time
+}
+
+type ApprovalMessage struct {
+	ApproverID string
+	Message    MessageRef
+}
+
+func NewApprovalStage(name string, approverIds []string, rule QuorumRule, quorum int, timeout time.Duration, fallbackApproverId string) (ApprovalStage, error) {
+	stage := ApprovalStage{
+		Name:               name,
+		Rule:               rule,
+		Quorum:             quorum,
+		Timeout:            timeout,
+		FallbackApproverID: fallbackApproverId,
+	}
+
+	seen := make(map[string]bool, len(approverIds))
+	for _, approverId := range approverIds {
+		if approverId != "" && !seen[approverId] {
+			seen[approverId] = true
+			stage.ApproverIDs = append(stage.ApproverIDs, approverId)
+		}
+	}
+
+	if rule != QuorumCount {
+		stage.Quorum = 0
+	}
+
+	if err := stage.validate(); err != nil {
+		return ApprovalStage{}, err
+	}
+
+	return stage, nil
+}
+
+func (s ApprovalStage) validate() error {
+	if s.Name == "" {
+		return errors.New("approval stage name is required")
+	}
+
+	if len(s.ApproverIDs) == 0 {
+		return fmt.Errorf("approval stage %q needs at least one approver", s.Name)
+	}
+
+	if !s.Rule.Valid() {
+		return fmt.Errorf("approval stage %q has an invalid rule %q", s.Name, s.Rule)
+	}
+
+	if s.Rule == QuorumCount && (s.Quorum < 1 || s.Quorum > len(s.ApproverIDs)) {
+		return fmt.Errorf("approval stage %q needs between 1 and %d approvals", s.Name, len(s.ApproverIDs))
+	}
+
+	if s.Timeout < 0 {
+		return fmt.Errorf("approval stage %q has a negative timeout", s.Name)
+	}
+
+	if (s.Timeout > 0) != (s.FallbackApproverID != "") {
+		return fmt.Errorf("approval stage %q needs both a timeout and a fallback approver to escalate", s.Name)
+	}
+
+	return nil
+}
+
+func (s ApprovalStage) RequiredApprovals() int {
+	switch s.Rule {
+	case QuorumAll:
+		return len(s.ApproverIDs)
+	case QuorumCount:
+		return s.Quorum
+	default:
+		return 1
+	}
+}
+
+func (s ApprovalStage) RuleText() string {
+	switch s.Rule {
+	case QuorumAll:
+		return "all"
+	case QuorumCount:
+		return fmt.Sprintf("%d of %d", s.Quorum, len(s.ApproverIDs))
+	default:
+		return "any one"
+	}
+}
+
+func (q *Queue) SetApprovalChain(stages []ApprovalStage) error {
+	if len(stages) > MaxApprovalStages {
+		return fmt.Errorf("an approval chain can have at most %d stages", MaxApprovalStages)
+	}
+
+	for _, stage := range stages {
+		if err := stage.validate(); err != nil {
+			return err
+		}
+	}
+
+	q.ApprovalChain = stages
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) RequiresApproval() bool {
+	return len(q.ApprovalChain) > 0
+}
+
+func (r *Request) RequireApproval(chain []ApprovalStage, adminIds []string) error {
+	if r.Status != RequestPending {
+		return errors.New("approval can only be required for a new request")
+	}
+
+	if len(chain) == 0 {
+		return errors.New("approval chain needs at least one stage")
+	}
+
+	stages := make([]ApprovalStage, len(chain))
+	for i, stage := range chain {
+		stage, ok := stage.withoutApprover(r.CreatedByID, adminIds)
+		if !ok {
+			return fmt.Errorf("%w: ask a queue admin to add another approver to stage %q", ErrNoApprover, stage.Name)
+		}
+
+		if err := stage.validate(); err != nil {
+			return fmt.Errorf("approval chain cannot be used for this requester: %w", err)
+		}
+		stages[i] = stage
+	}
+
+	now := time.Now()
+	r.Status = RequestAwaitingApproval
+	r.ApprovalChain = stages
+	r.ApprovalStage = 0
+	r.ApprovalStageStartedAt = now
+	r.ApprovalEscalated = false
+	r.UpdatedAt = now
+	return nil
+}
+
+func (s ApprovalStage) withoutApprover(userId string, adminIds []string) (ApprovalStage, bool) {
+	approvers := []string{}
+	for _, approverId := range s.ApproverIDs {
+		if approverId != userId {
+			approvers = append(approvers, approverId)
+		}
+	}
+
+	if s.FallbackApproverID == userId {
+		s.FallbackApproverID = ""
+		s.Timeout = 0
+	}
+
+	if len(approvers) == 0 {
+		if s.FallbackApproverID != "" {
+			approvers = append(approvers, s.FallbackApproverID)
+		} else {
+			for _, adminId := range adminIds {
+				if adminId != userId {
+					approvers = append(approvers, adminId)
+				}
+			}
+		}
+
+		s.Rule = QuorumAny
+		s.Quorum = 0
+		s.FallbackApproverID = ""
+		s.Timeout = 0
+	}
+
+	if len(approvers) == 0 {
+		return s, false
+	}
+
+	if s.Rule == QuorumCount && s.Quorum > len(approvers) {
+		s.Quorum = len(approvers)
+	}
+
+	s.ApproverIDs = approvers
+	return s, true
+}
+
+func (r *Request) CurrentApprovalStage() (ApprovalStage, bool) {
+	if r.Status != RequestAwaitingApproval || r.ApprovalStage >= len(r.ApprovalChain) {
+		return ApprovalStage{}, false
+	}
+	return r.ApprovalChain[r.ApprovalStage], true
+}
+
+func (r *Request) CurrentApproverIDs() []string {
+	stage, ok := r.CurrentApprovalStage()
+	if !ok {
+		return nil
+	}
+
+	approvers := append([]string{}, stage.ApproverIDs...)
+	if r.ApprovalEscalated && !r.isStageApprover(stage, stage.FallbackApproverID) {
+		approvers = append(approvers, stage.FallbackApproverID)
+	}
+	return approvers
+}
+
+func (r *Request) IsApprover(userId string) bool {
+	for _, approverId := range r.CurrentApproverIDs() {
+		if approverId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) HasDecided(userId string) bool {
+	for _, decision := range r.Approvals {
+		if decision.Stage == r.ApprovalStage && decision.ApproverID == userId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) CanBeApprovedBy(userId string) bool {
+	return r.Status == RequestAwaitingApproval && userId != r.CreatedByID && r.IsApprover(userId) && !r.HasDecided(userId)
+}
+
+func (r *Request) StageApprovals(stage int) []ApprovalDecision {
+	decisions := []ApprovalDecision{}
+	for _, decision := range r.Approvals {
+		if decision.Stage == stage && decision.Approved {
+			decisions = append(decisions, decision)
+		}
+	}
+	return decisions
+}
+
+func (r *Request) Approve(userId, comment string) error {
+	if err := r.checkApprover(userId); err != nil {
+		return err
+	}
+
+	stage, _ := r.CurrentApprovalStage()
+	now := time.Now()
+	r.Approvals = append(r.Approvals, ApprovalDecision{Stage: r.ApprovalStage, ApproverID: userId, Approved: true, Comment: comment, DecidedAt: now})
+	r.UpdatedAt = now
+
+	byFallback := r.ApprovalEscalated && userId == stage.FallbackApproverID
+	if byFallback || len(r.StageApprovals(r.ApprovalStage)) >= stage.RequiredApprovals() {
+		r.advanceApprovalStage(now)
+	}
+
+	return nil
+}
+
+func (r *Request) Deny(userId, comment string) error {
+	if err := r.checkApprover(userId); err != nil {
+		return err
+	}
+
+	if comment == "" {
+		return errors.New("a comment is required when denying a request")
+	}
+
+	now := time.Now()
+	r.Approvals = append(r.Approvals, ApprovalDecision{Stage: r.ApprovalStage, ApproverID: userId, Approved: false, Comment: comment, DecidedAt: now})
+	r.Status = RequestDenied
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) ApprovalDeadline() (time.Time, bool) {
+	stage, ok := r.CurrentApprovalStage()
+	if !ok || stage.Timeout == 0 {
+		return time.Time{}, false
+	}
+	return r.ApprovalStageStartedAt.Add(stage.Timeout), true
+}
+
+func (r *Request) EscalateApproval(at time.Time) bool {
+	if r.ApprovalEscalated {
+		return false
+	}
+
+	deadline, ok := r.ApprovalDeadline()
+	if !ok || at.Before(deadline) {
+		return false
+	}
+
+	r.ApprovalEscalated = true
+	r.UpdatedAt = at
+	return true
+}
+
+func (r *Request) HasApprovalMessage(approverId string) bool {
+	for _, message := range r.ApprovalMessages {
+		if message.ApproverID == approverId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) LastApprovalDecision() (ApprovalDecision, bool) {
+	if len(r.Approvals) == 0 {
+		return ApprovalDecision{}, false
+	}
+	return r.Approvals[len(r.Approvals)-1], true
+}
+
+func (r *Request) advanceApprovalStage(at time.Time) {
+	r.ApprovalStage++
+	r.ApprovalStageStartedAt = at
+	r.ApprovalEscalated = false
+
+	if r.ApprovalStage >= len(r.ApprovalChain) {
+		r.Status = RequestPending
+	}
+}
+
+func (r *Request) isStageApprover(stage ApprovalStage, userId string) bool {
+	for _, approverId := range stage.ApproverIDs {
+		if approverId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) checkApprover(userId string) error {
+	if r.Status != RequestAwaitingApproval {
+		return errors.New("request is not awaiting approval")
+	}
+
+	if !r.CanBeApprovedBy(userId) {
+		return ErrNotAnApprover
+	}
+
+	return nil
+}
@@ -0,0 +1,378 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newApprovalStage(t *testing.T, name string, rule domain.QuorumRule, quorum int, approverIds ...string) domain.ApprovalStage {
+	t.Helper()
+
+	stage, err := domain.NewApprovalStage(name, approverIds, rule, quorum, 0, "")
+	if err != nil {
+		t.Fatalf("Failed to create approval stage: %v", err)
+	}
+
+	return stage
+}
+
+func newAwaitingApprovalRequest(t *testing.T, chain ...domain.ApprovalStage) *domain.Request {
+	t.Helper()
+
+	r := newPendingRequest(t, "a", "Production DB access")
+	if err := r.RequireApproval(chain, nil); err != nil {
+		t.Fatalf("Failed to require approval: %v", err)
+	}
+
+	return r
+}
+
+func TestApprovalStage(t *testing.T) {
+	t.Run("NewApprovalStage", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			stageName   string
+			approverIds []string
+			rule        domain.QuorumRule
+			quorum      int
+			timeout     time.Duration
+			fallback    string
+		}{
+			{"should require a name", "", []string{"manager"}, domain.QuorumAny, 0, 0, ""},
+			{"should require an approver", "Manager", nil, domain.QuorumAny, 0, 0, ""},
+			{"should reject unknown rules", "Manager", []string{"manager"}, domain.QuorumRule("most"), 0, 0, ""},
+			{"should reject a quorum above the number of approvers", "Security", []string{"alice", "bob"}, domain.QuorumCount, 3, 0, ""},
+			{"should reject a zero quorum", "Security", []string{"alice", "bob"}, domain.QuorumCount, 0, 0, ""},
+			{"should reject a negative timeout", "Manager", []string{"manager"}, domain.QuorumAny, 0, -time.Hour, "director"},
+			{"should require a fallback with a timeout", "Manager", []string{"manager"}, domain.QuorumAny, 0, time.Hour, ""},
+			{"should require a timeout with a fallback", "Manager", []string{"manager"}, domain.QuorumAny, 0, 0, "director"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.NewApprovalStage(tt.stageName, tt.approverIds, tt.rule, tt.quorum, tt.timeout, tt.fallback); err == nil {
+					t.Error("Expected an error for an invalid stage")
+				}
+			})
+		}
+
+		t.Run("should drop duplicate approvers", func(t *testing.T) {
+			stage := newApprovalStage(t, "Security", domain.QuorumAll, 0, "alice", "bob", "alice")
+
+			if len(stage.ApproverIDs) != 2 || stage.RequiredApprovals() != 2 {
+				t.Errorf("Unexpected stage: %+v", stage)
+			}
+		})
+	})
+
+	t.Run("RequiredApprovals", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			rule     domain.QuorumRule
+			quorum   int
+			expected int
+			text     string
+		}{
+			{"should need one approval for any", domain.QuorumAny, 0, 1, "any one"},
+			{"should need every approval for all", domain.QuorumAll, 0, 3, "all"},
+			{"should need the quorum for count", domain.QuorumCount, 2, 2, "2 of 3"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				stage := newApprovalStage(t, "Security", tt.rule, tt.quorum, "alice", "bob", "carol")
+
+				if stage.RequiredApprovals() != tt.expected || stage.RuleText() != tt.text {
+					t.Errorf("Expected %d (%s), got %d (%s)", tt.expected, tt.text, stage.RequiredApprovals(), stage.RuleText())
+				}
+			})
+		}
+	})
+
+	t.Run("SetApprovalChain", func(t *testing.T) {
+		t.Run("should limit the number of stages", func(t *testing.T) {
+			q := domain.NewQueue("q", "Access", "admin")
+			stage := newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager")
+
+			if err := q.SetApprovalChain([]domain.ApprovalStage{stage, stage, stage, stage}); err == nil {
+				t.Error("Expected an error for too many stages")
+			}
+
+			if err := q.SetApprovalChain([]domain.ApprovalStage{stage}); err != nil || !q.RequiresApproval() {
+				t.Errorf("Expected the chain to be set, got %v", err)
+			}
+		})
+	})
+}
+
+func TestRequestApproval(t *testing.T) {
+	t.Run("RequireApproval", func(t *testing.T) {
+		t.Run("should hold a new request until it is approved", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"))
+
+			if r.Status != domain.RequestAwaitingApproval || r.IsOpen() {
+				t.Errorf("Expected an awaiting approval request outside the open statuses, got %s", r.Status)
+			}
+		})
+
+		t.Run("should not let the creator approve their own request", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "Production DB access")
+
+			if err := r.RequireApproval([]domain.ApprovalStage{newApprovalStage(t, "Manager", domain.QuorumAll, 0, "creator", "manager")}, nil); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.IsApprover("creator") || !r.IsApprover("manager") {
+				t.Errorf("Unexpected approvers: %v", r.CurrentApproverIDs())
+			}
+		})
+
+		t.Run("should lower the quorum when the creator is one of the approvers", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "Production DB access")
+
+			if err := r.RequireApproval([]domain.ApprovalStage{newApprovalStage(t, "Leads", domain.QuorumCount, 2, "creator", "lead")}, nil); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if stage, _ := r.CurrentApprovalStage(); stage.RequiredApprovals() != 1 {
+				t.Errorf("Expected 1 required approval, got %d", stage.RequiredApprovals())
+			}
+		})
+
+		t.Run("should hand a stage only the creator could approve to someone else", func(t *testing.T) {
+			withFallback, _ := domain.NewApprovalStage("Manager", []string{"creator"}, domain.QuorumAny, 0, time.Hour, "lead")
+
+			tests := []struct {
+				name     string
+				stage    domain.ApprovalStage
+				adminIds []string
+				want     string
+			}{
+				{"should fall back to the stage's fallback approver", withFallback, []string{"admin"}, "lead"},
+				{"should fall back to the queue admins", newApprovalStage(t, "Manager", domain.QuorumAny, 0, "creator"), []string{"creator", "admin"}, "admin"},
+			}
+
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					r := newPendingRequest(t, "a", "Production DB access")
+
+					if err := r.RequireApproval([]domain.ApprovalStage{tt.stage}, tt.adminIds); err != nil {
+						t.Fatalf("Expected no error, got %v", err)
+					}
+
+					if approvers := r.CurrentApproverIDs(); len(approvers) != 1 || approvers[0] != tt.want {
+						t.Errorf("Expected only %s to approve, got %v", tt.want, approvers)
+					}
+				})
+			}
+		})
+
+		t.Run("should reject the request when nobody but the creator can approve a stage", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "Production DB access")
+			chain := []domain.ApprovalStage{
+				newApprovalStage(t, "Team", domain.QuorumAny, 0, "lead"),
+				newApprovalStage(t, "Manager", domain.QuorumAny, 0, "creator"),
+			}
+
+			if err := r.RequireApproval(chain, []string{"creator"}); !errors.Is(err, domain.ErrNoApprover) {
+				t.Fatalf("Expected ErrNoApprover, got %v", err)
+			}
+
+			if r.Status != domain.RequestPending || len(r.ApprovalChain) != 0 {
+				t.Errorf("Expected the request to be left alone, got %s with %d stages", r.Status, len(r.ApprovalChain))
+			}
+		})
+
+		t.Run("should not escalate to the creator", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "Production DB access")
+			stage, _ := domain.NewApprovalStage("Manager", []string{"manager"}, domain.QuorumAny, 0, time.Hour, "creator")
+
+			if err := r.RequireApproval([]domain.ApprovalStage{stage}, nil); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if _, ok := r.ApprovalDeadline(); ok {
+				t.Error("Expected the stage not to escalate")
+			}
+		})
+
+		t.Run("should require at least one stage", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "Production DB access")
+
+			if err := r.RequireApproval(nil, nil); err == nil {
+				t.Error("Expected an error without stages")
+			}
+		})
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			rule      domain.QuorumRule
+			quorum    int
+			approvers []string
+			awaiting  bool
+		}{
+			{"should pass an any stage with one approval", domain.QuorumAny, 0, []string{"alice"}, false},
+			{"should hold an all stage until everyone approves", domain.QuorumAll, 0, []string{"alice", "bob"}, true},
+			{"should pass an all stage once everyone approves", domain.QuorumAll, 0, []string{"alice", "bob", "carol"}, false},
+			{"should hold a count stage below the quorum", domain.QuorumCount, 2, []string{"alice"}, true},
+			{"should pass a count stage at the quorum", domain.QuorumCount, 2, []string{"alice", "carol"}, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Security", tt.rule, tt.quorum, "alice", "bob", "carol"))
+
+				for _, approverId := range tt.approvers {
+					if err := r.Approve(approverId, ""); err != nil {
+						t.Fatalf("Expected no error, got %v", err)
+					}
+				}
+
+				if awaiting := r.Status == domain.RequestAwaitingApproval; awaiting != tt.awaiting {
+					t.Errorf("Expected awaiting approval to be %v, got %s", tt.awaiting, r.Status)
+				}
+			})
+		}
+
+		t.Run("should move through the stages in order", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t,
+				newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"),
+				newApprovalStage(t, "Security", domain.QuorumAll, 0, "alice", "bob"),
+			)
+
+			if err := r.Approve("alice", ""); !errors.Is(err, domain.ErrNotAnApprover) {
+				t.Errorf("Expected a later stage approver to wait their turn, got %v", err)
+			}
+
+			r.Approve("manager", "Go ahead")
+			if r.ApprovalStage != 1 || r.Status != domain.RequestAwaitingApproval {
+				t.Fatalf("Expected the security stage, got %d %s", r.ApprovalStage, r.Status)
+			}
+
+			r.Approve("alice", "")
+			r.Approve("bob", "")
+
+			decision, ok := r.LastApprovalDecision()
+			if r.Status != domain.RequestPending || !ok || decision.Stage != 1 || decision.ApproverID != "bob" {
+				t.Errorf("Unexpected state: %s %+v", r.Status, decision)
+			}
+		})
+
+		t.Run("should reject users who are not approvers", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"))
+
+			if err := r.Approve("someone", ""); !errors.Is(err, domain.ErrNotAnApprover) {
+				t.Errorf("Expected ErrNotAnApprover, got %v", err)
+			}
+		})
+
+		t.Run("should only count each approver once", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Security", domain.QuorumCount, 2, "alice", "bob"))
+			r.Approve("alice", "")
+
+			if err := r.Approve("alice", ""); !errors.Is(err, domain.ErrNotAnApprover) {
+				t.Errorf("Expected ErrNotAnApprover, got %v", err)
+			}
+
+			if r.Status != domain.RequestAwaitingApproval {
+				t.Errorf("Expected the request to still await approval, got %s", r.Status)
+			}
+		})
+	})
+
+	t.Run("Deny", func(t *testing.T) {
+		t.Run("should stop the chain with the approver's comment", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t,
+				newApprovalStage(t, "Security", domain.QuorumAll, 0, "alice", "bob"),
+				newApprovalStage(t, "Director", domain.QuorumAny, 0, "director"),
+			)
+			r.Approve("alice", "")
+
+			if err := r.Deny("bob", "Use the read replica"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			decision, _ := r.LastApprovalDecision()
+			if r.Status != domain.RequestDenied || decision.Approved || decision.Comment != "Use the read replica" {
+				t.Errorf("Unexpected state: %s %+v", r.Status, decision)
+			}
+
+			if err := r.Approve("director", ""); err == nil {
+				t.Error("Expected later stages to be closed after a denial")
+			}
+		})
+
+		t.Run("should require a comment", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"))
+
+			if err := r.Deny("manager", ""); err == nil {
+				t.Error("Expected an error without a comment")
+			}
+		})
+	})
+
+	t.Run("EscalateApproval", func(t *testing.T) {
+		newEscalatingRequest := func(t *testing.T) *domain.Request {
+			stage, err := domain.NewApprovalStage("Security", []string{"alice", "bob"}, domain.QuorumAll, 0, time.Hour, "director")
+			if err != nil {
+				t.Fatalf("Failed to create approval stage: %v", err)
+			}
+			return newAwaitingApprovalRequest(t, stage, newApprovalStage(t, "Finance", domain.QuorumAny, 0, "cfo"))
+		}
+
+		t.Run("should wait for the stage timeout", func(t *testing.T) {
+			r := newEscalatingRequest(t)
+
+			if r.EscalateApproval(r.ApprovalStageStartedAt.Add(30*time.Minute)) || r.IsApprover("director") {
+				t.Error("Expected no escalation before the timeout")
+			}
+		})
+
+		t.Run("should add the fallback approver once", func(t *testing.T) {
+			r := newEscalatingRequest(t)
+			at := r.ApprovalStageStartedAt.Add(time.Hour)
+
+			if !r.EscalateApproval(at) || !r.IsApprover("director") {
+				t.Fatal("Expected the fallback approver to be added")
+			}
+
+			if r.EscalateApproval(at.Add(time.Hour)) {
+				t.Error("Expected a stage to escalate only once")
+			}
+		})
+
+		t.Run("should let the fallback approver pass the stage alone", func(t *testing.T) {
+			r := newEscalatingRequest(t)
+			r.EscalateApproval(r.ApprovalStageStartedAt.Add(time.Hour))
+
+			if err := r.Approve("director", ""); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.ApprovalStage != 1 || r.ApprovalEscalated || r.IsApprover("director") {
+				t.Errorf("Expected the finance stage without escalation, got %d %v", r.ApprovalStage, r.ApprovalEscalated)
+			}
+		})
+
+		t.Run("should not escalate stages without a timeout", func(t *testing.T) {
+			r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"))
+
+			if r.EscalateApproval(time.Now().Add(24 * time.Hour)) {
+				t.Error("Expected no escalation without a timeout")
+			}
+		})
+	})
+
+	t.Run("should not let responders accept before approval", func(t *testing.T) {
+		r := newAwaitingApprovalRequest(t, newApprovalStage(t, "Manager", domain.QuorumAny, 0, "manager"))
+
+		if err := r.Accept("recipient"); err == nil {
+			t.Error("Expected an error accepting a request awaiting approval")
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrAssignmentConflict = errors.New("queue assigned another request at the same time")
+
+type AssignmentStrategy string
+
+const (
+	AssignmentNone       AssignmentStrategy = "none"
+	AssignmentRoundRobin AssignmentStrategy = "round_robin"
+	AssignmentLeastOpen  AssignmentStrategy = "least_open"
+	AssignmentRandom     AssignmentStrategy = "random"
+	AssignmentOnCall     AssignmentStrategy = "on_call"
+)
+
+func (s AssignmentStrategy) Valid() bool {
+	switch s {
+	case AssignmentNone, AssignmentRoundRobin, AssignmentLeastOpen, AssignmentRandom, AssignmentOnCall:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *Queue) SetAssignmentStrategy(strategy AssignmentStrategy) error {
+	if strategy == "" {
+		strategy = AssignmentNone
+	}
+
+	if !strategy.Valid() {
+		return fmt.Errorf("invalid assignment strategy %q", strategy)
+	}
+
+	if strategy == AssignmentOnCall && q.OnCall == nil {
+		return fmt.Errorf("cannot assign to on-call: %w", ErrNoOnCallRotation)
+	}
+
+	q.AssignmentStrategy = strategy
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) AutoAssigns() bool {
+	return q.AssignmentStrategy != "" && q.AssignmentStrategy != AssignmentNone
+}
+
+func (q *Queue) AssignmentCandidates(request *Request) []string {
+	candidates := []string{}
+	for _, memberId := range q.MemberIds {
+		if memberId != request.CreatedByID && !request.HasDeclined(memberId) {
+			candidates = append(candidates, memberId)
+		}
+	}
+	return candidates
+}
+
+// NextAssignee picks who should work on the request at the given instant,
+// skipping anyone at their work in progress limit. openCounts is only used by
+// the least-open strategy and pick only by the random strategy.
+func (q *Queue) NextAssignee(request *Request, at time.Time, openCounts map[string]int, load WIPLoad, pick func(n int) int) (string, bool) {
+	if q.AssignmentStrategy == AssignmentOnCall {
+		shift, ok := q.OnCallAt(at)
+		if !ok || shift.UserID == request.CreatedByID || request.HasDeclined(shift.UserID) || !q.RoleOf(shift.UserID).Allows(PermissionAccept) {
+			return "", false
+		}
+		if q.CheckWIPLimit(shift.UserID, load) != nil {
+			return "", false
+		}
+		return shift.UserID, true
+	}
+
+	candidates := []string{}
+	for _, candidate := range q.AssignmentCandidates(request) {
+		if q.CheckWIPLimit(candidate, load) == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if !q.AutoAssigns() || len(candidates) == 0 {
+		return "", false
+	}
+
+	switch q.AssignmentStrategy {
+	case AssignmentRoundRobin:
+		start := 0
+		for i, memberId := range q.MemberIds {
+			if memberId == q.LastAssigneeID {
+				start = i + 1
+				break
+			}
+		}
+
+		isCandidate := make(map[string]bool, len(candidates))
+		for _, candidate := range candidates {
+			isCandidate[candidate] = true
+		}
+
+		for i := range q.MemberIds {
+			memberId := q.MemberIds[(start+i)%len(q.MemberIds)]
+			if isCandidate[memberId] {
+				return memberId, true
+			}
+		}
+		return "", false
+
+	case AssignmentLeastOpen:
+		best := candidates[0]
+		for _, candidate := range candidates[1:] {
+			if openCounts[candidate] < openCounts[best] {
+				best = candidate
+			}
+		}
+		return best, true
+
+	case AssignmentRandom:
+		return candidates[pick(len(candidates))], true
+
+	default:
+		return "", false
+	}
+}
+
+func (q *Queue) RecordAssignment(userId string) {
+	q.LastAssigneeID = userId
+	q.UpdatedAt = time.Now()
+}
+
+func (r *Request) DeclineAssignment(userId string) error {
+	if r.Status != RequestAccepted || r.AcceptedByID != userId {
+		return errors.New("only the assignee of an accepted request can decline it")
+	}
+
+	if len(r.CollaboratorIDs) > 0 {
+		return errors.New("a request with collaborators cannot be declined")
+	}
+
+	r.Status = RequestPending
+	r.AcceptedByID = ""
+	r.AcceptedAt = time.Time{}
+	if r.HasWorkflow() {
+		r.WorkflowStatus = r.Workflow.Initial().Key
+	}
+	if !r.HasDeclined(userId) {
+		r.DeclinedByIDs = append(r.DeclinedByIDs, userId)
+	}
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) HasDeclined(userId string) bool {
+	for _, declinedById := range r.DeclinedByIDs {
+		if declinedById == userId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newAssigningQueue(t *testing.T, strategy domain.AssignmentStrategy, memberIds ...string) *domain.Queue {
+	t.Helper()
+
+	q := domain.NewQueue("queue-1", "Support", "admin")
+	for _, memberId := range memberIds {
+		if err := q.AddMember(memberId); err != nil {
+			t.Fatalf("Failed to add member: %v", err)
+		}
+	}
+	if err := q.SetAssignmentStrategy(strategy); err != nil {
+		t.Fatalf("Failed to set assignment strategy: %v", err)
+	}
+
+	return &q
+}
+
+func newQueuedRequest(t *testing.T, createdById string) *domain.Request {
+	t.Helper()
+
+	r, err := domain.NewRequest("req-1", "Laptop broken", createdById, &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	return &r
+}
+
+func TestAssignment(t *testing.T) {
+	t.Run("SetAssignmentStrategy", func(t *testing.T) {
+		t.Run("should reject unknown strategies", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "Support", "admin")
+
+			if err := q.SetAssignmentStrategy("busiest"); err == nil {
+				t.Error("Expected an error for an unknown strategy")
+			}
+		})
+
+		t.Run("should default to no assignment", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "Support", "admin")
+
+			if err := q.SetAssignmentStrategy(""); err != nil || q.AutoAssigns() {
+				t.Errorf("Expected no auto-assignment, got %s %v", q.AssignmentStrategy, err)
+			}
+		})
+	})
+
+	t.Run("NextAssignee", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			strategy   domain.AssignmentStrategy
+			lastId     string
+			openCounts map[string]int
+			declined   []string
+			want       string
+			ok         bool
+		}{
+			{"should not assign without a strategy", domain.AssignmentNone, "", nil, nil, "", false},
+			{"should start round robin with the first member", domain.AssignmentRoundRobin, "", nil, nil, "alice", true},
+			{"should continue round robin after the last assignee", domain.AssignmentRoundRobin, "alice", nil, nil, "bob", true},
+			{"should wrap round robin around", domain.AssignmentRoundRobin, "carol", nil, nil, "alice", true},
+			{"should skip members who declined", domain.AssignmentRoundRobin, "alice", nil, []string{"bob"}, "carol", true},
+			{"should pick the member with the fewest open requests", domain.AssignmentLeastOpen, "", map[string]int{"alice": 3, "bob": 1, "carol": 2}, nil, "bob", true},
+			{"should break least open ties by member order", domain.AssignmentLeastOpen, "", map[string]int{"alice": 1}, nil, "bob", true},
+			{"should pick a random member", domain.AssignmentRandom, "", nil, nil, "carol", true},
+			{"should give up when everyone declined", domain.AssignmentRoundRobin, "", nil, []string{"alice", "bob", "carol"}, "", false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				q := newAssigningQueue(t, tt.strategy, "alice", "bob", "carol")
+				q.LastAssigneeID = tt.lastId
+				r := newQueuedRequest(t, "requester")
+				r.DeclinedByIDs = tt.declined
+
+				got, ok := q.NextAssignee(r, time.Now(), tt.openCounts, domain.WIPLoad{}, func(n int) int { return n - 1 })
+				if got != tt.want || ok != tt.ok {
+					t.Errorf("Expected %q %v, got %q %v", tt.want, tt.ok, got, ok)
+				}
+			})
+		}
+
+		t.Run("should never assign the requester", func(t *testing.T) {
+			q := newAssigningQueue(t, domain.AssignmentRoundRobin, "alice", "bob")
+
+			if got, _ := q.NextAssignee(newQueuedRequest(t, "alice"), time.Now(), nil, domain.WIPLoad{}, nil); got != "bob" {
+				t.Errorf("Expected bob, got %s", got)
+			}
+		})
+	})
+
+	t.Run("DeclineAssignment", func(t *testing.T) {
+		t.Run("should return the request to pending and remember who declined", func(t *testing.T) {
+			r := newQueuedRequest(t, "requester")
+			r.Accept("alice")
+
+			if err := r.DeclineAssignment("alice"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.Status != domain.RequestPending || r.AcceptedByID != "" || !r.HasDeclined("alice") {
+				t.Errorf("Unexpected state: %s %q %v", r.Status, r.AcceptedByID, r.DeclinedByIDs)
+			}
+		})
+
+		t.Run("should only let the assignee decline", func(t *testing.T) {
+			r := newQueuedRequest(t, "requester")
+			r.Accept("alice")
+
+			if err := r.DeclineAssignment("bob"); err == nil {
+				t.Error("Expected an error for someone else declining")
+			}
+		})
+
+		t.Run("should not decline once collaborators joined", func(t *testing.T) {
+			r := newQueuedRequest(t, "requester")
+			r.Accept("alice")
+			r.AddCollaborator("bob")
+
+			if err := r.DeclineAssignment("alice"); err == nil {
+				t.Error("Expected an error with collaborators")
+			}
+		})
+
+		t.Run("should move round robin to the next candidate", func(t *testing.T) {
+			q := newAssigningQueue(t, domain.AssignmentRoundRobin, "alice", "bob", "carol")
+			r := newQueuedRequest(t, "requester")
+
+			first, _ := q.NextAssignee(r, time.Now(), nil, domain.WIPLoad{}, nil)
+			r.Accept(first)
+			q.RecordAssignment(first)
+			r.DeclineAssignment(first)
+
+			next, ok := q.NextAssignee(r, time.Now(), nil, domain.WIPLoad{}, nil)
+			if !ok || next != "bob" {
+				t.Errorf("Expected bob after alice declined, got %q", next)
+			}
+		})
+	})
+}
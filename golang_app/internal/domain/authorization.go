@@ -1,9 +1,11 @@
 package domain
 
 type AuthorizationContext struct {
-	Request *Request
-	Queue   *Queue
-	ActorID string
+	Request       *Request
+	Queue         *Queue
+	ActorID       string
+	ChannelMember bool
+	OnDecision    func(PolicyDecision)
 }
 
 func NewAuthorizationContext(request *Request, queue *Queue, actorID string) *AuthorizationContext {
@@ -23,24 +25,81 @@ func (ctx *AuthorizationContext) CanAccept() bool {
 		return false
 	}
 
+	return ctx.Allows(PermissionAccept)
+}
+
+func (ctx *AuthorizationContext) CanJoin() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.Status != RequestAccepted && ctx.Request.Status != RequestOnHold {
+		return false
+	}
+
+	if ctx.Request.CreatedByID == ctx.ActorID || ctx.Request.IsAssignee(ctx.ActorID) {
+		return false
+	}
+
+	return ctx.Allows(PermissionAccept)
+}
+
+func (ctx *AuthorizationContext) CanAssign() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.Status != RequestPending {
+		return false
+	}
+
+	return ctx.Allows(PermissionAssign)
+}
+
+func (ctx *AuthorizationContext) Role() Role {
+	if ctx.Request == nil {
+		if ctx.Queue != nil {
+			return ctx.Queue.RoleOf(ctx.ActorID)
+		}
+		return RoleViewer
+	}
+
 	switch ctx.Request.Recipient.Type {
 	case RequestRecipientUser:
-		return ctx.Request.Recipient.ID == ctx.ActorID
+		if ctx.Request.Recipient.ID == ctx.ActorID {
+			return RoleResponder
+		}
+		return RoleViewer
 
 	case RequestRecipientChannel:
-		return true
+		if ctx.ChannelMember {
+			return RoleResponder
+		}
+		return RoleViewer
 
 	case RequestRecipientQueue:
 		if ctx.Queue == nil {
-			return false
+			return RoleViewer
 		}
-		return ctx.Queue.CanRespondToRequests(ctx.ActorID)
+		return ctx.Queue.RoleOf(ctx.ActorID)
 
 	default:
-		return false
+		return RoleViewer
 	}
 }
 
+func (ctx *AuthorizationContext) Allows(permission Permission) bool {
+	decision := Decide(ctx.ActorID, ctx.Role(), permission)
+	if ctx.OnDecision != nil {
+		ctx.OnDecision(decision)
+	}
+	return decision.Allowed
+}
+
+func (ctx *AuthorizationContext) worksOnRequests() bool {
+	return ctx.Allows(PermissionAccept) || ctx.Allows(PermissionAssign)
+}
+
 func (ctx *AuthorizationContext) CanComplete() bool {
 	if ctx.Request == nil || ctx.ActorID == "" {
 		return false
@@ -50,5 +109,119 @@ func (ctx *AuthorizationContext) CanComplete() bool {
 }
 
 func (ctx *AuthorizationContext) CanReject() bool {
-	return ctx.CanAccept() || ctx.CanComplete()
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.CreatedByID != ctx.ActorID && ctx.Allows(PermissionReject) {
+		return true
+	}
+
+	return ctx.CanComplete()
+}
+
+func (ctx *AuthorizationContext) CanPutOnHold() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	return ctx.Request.CanBePutOnHoldBy(ctx.ActorID)
+}
+
+func (ctx *AuthorizationContext) CanResume() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	return ctx.Request.CanBeResumedBy(ctx.ActorID)
+}
+
+func (ctx *AuthorizationContext) CanLabel() bool {
+	if ctx.Request == nil || ctx.Queue == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.Recipient.Type != RequestRecipientQueue {
+		return false
+	}
+
+	return ctx.Request.CreatedByID == ctx.ActorID || ctx.worksOnRequests()
+}
+
+func (ctx *AuthorizationContext) CanTickChecklist() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	return ctx.Request.CanTickChecklistBy(ctx.ActorID)
+}
+
+func (ctx *AuthorizationContext) CanManageBlockers() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.CanManageBlockersBy(ctx.ActorID) {
+		return true
+	}
+
+	return ctx.Request.IsOpen() && ctx.worksOnRequests()
+}
+
+func (ctx *AuthorizationContext) CanMarkDuplicate() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if !ctx.Request.IsOpen() {
+		return false
+	}
+
+	return ctx.Request.IsAssignee(ctx.ActorID) || ctx.worksOnRequests()
+}
+
+// CanTransfer allows anyone who works on requests in the source queue to move
+// an open request to another queue.
+func (ctx *AuthorizationContext) CanTransfer() bool {
+	if ctx.Request == nil || ctx.Queue == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if ctx.Request.Recipient.Type != RequestRecipientQueue || !ctx.Request.IsOpen() {
+		return false
+	}
+
+	return ctx.worksOnRequests()
+}
+
+func (ctx *AuthorizationContext) CanSnoozeReminders() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if !ctx.Request.IsOpen() {
+		return false
+	}
+
+	return ctx.Request.IsAssignee(ctx.ActorID) || ctx.worksOnRequests()
+}
+
+func (ctx *AuthorizationContext) CanTransition() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	if !ctx.Request.HasWorkflow() {
+		return false
+	}
+
+	return ctx.Request.IsAssignee(ctx.ActorID) || ctx.CanAccept()
+}
+
+func (ctx *AuthorizationContext) CanDecideApproval() bool {
+	if ctx.Request == nil || ctx.ActorID == "" {
+		return false
+	}
+
+	return ctx.Request.CanBeApprovedBy(ctx.ActorID)
 }
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const HolidayDateLayout = "2006-01-02"
+
+// WorkingHours is an opening window on one weekday, in minutes after local
+// midnight. End may be 24*60 for a window that runs until midnight.
+type WorkingHours struct {
+	Weekday time.Weekday
+	Start   int
+	End     int
+}
+
+type Holiday struct {
+	Date string
+	Name string
+}
+
+type BusinessCalendar struct {
+	TimeZone string
+	Hours    []WorkingHours
+	Holidays []Holiday
+}
+
+func NewWorkingHours(weekday time.Weekday, start, end int) (WorkingHours, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return WorkingHours{}, fmt.Errorf("invalid weekday %d", weekday)
+	}
+
+	if start < 0 || end > 24*60 || start >= end {
+		return WorkingHours{}, fmt.Errorf("working hours on %s must open before they close", weekday)
+	}
+
+	return WorkingHours{Weekday: weekday, Start: start, End: end}, nil
+}
+
+func NewBusinessCalendar(timeZone string, hours []WorkingHours) (*BusinessCalendar, error) {
+	if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "" {
+		return nil, fmt.Errorf("unknown time zone %q", timeZone)
+	}
+
+	if len(hours) == 0 {
+		return nil, errors.New("business hours need at least one working window")
+	}
+
+	sorted := slices.Clone(hours)
+	slices.SortFunc(sorted, func(a, b WorkingHours) int {
+		if a.Weekday != b.Weekday {
+			return int(a.Weekday) - int(b.Weekday)
+		}
+		return a.Start - b.Start
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Weekday == sorted[i-1].Weekday && sorted[i].Start < sorted[i-1].End {
+			return nil, fmt.Errorf("working hours on %s overlap", sorted[i].Weekday)
+		}
+	}
+
+	return &BusinessCalendar{TimeZone: timeZone, Hours: sorted}, nil
+}
+
+func NewHoliday(date time.Time, name string) Holiday {
+	return Holiday{Date: date.Format(HolidayDateLayout), Name: name}
+}
+
+func (c *BusinessCalendar) Location() *time.Location {
+	loc, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (c *BusinessCalendar) SetHolidays(holidays []Holiday) {
+	c.Holidays = holidays
+}
+
+func (c *BusinessCalendar) HolidayOn(day time.Time) (Holiday, bool) {
+	date := day.In(c.Location()).Format(HolidayDateLayout)
+	for _, holiday := range c.Holidays {
+		if holiday.Date == date {
+			return holiday, true
+		}
+	}
+	return Holiday{}, false
+}
+
+type openWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+func (c *BusinessCalendar) windowsOn(day time.Time) []openWindow {
+	if _, ok := c.HolidayOn(day); ok {
+		return nil
+	}
+
+	year, month, date := day.Date()
+	windows := []openWindow{}
+	for _, hours := range c.Hours {
+		if hours.Weekday != day.Weekday() {
+			continue
+		}
+		windows = append(windows, openWindow{
+			start: time.Date(year, month, date, 0, hours.Start, 0, 0, day.Location()),
+			end:   time.Date(year, month, date, 0, hours.End, 0, 0, day.Location()),
+		})
+	}
+	return windows
+}
+
+func (c *BusinessCalendar) startOfDay(at time.Time) time.Time {
+	local := at.In(c.Location())
+	year, month, day := local.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, local.Location())
+}
+
+func (c *BusinessCalendar) IsOpen(at time.Time) bool {
+	for _, window := range c.windowsOn(c.startOfDay(at)) {
+		if !at.Before(window.start) && at.Before(window.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpening returns the instant at which the queue is next open, which is
+// at itself during working hours. It gives up after a year without openings.
+func (c *BusinessCalendar) NextOpening(at time.Time) (time.Time, bool) {
+	day := c.startOfDay(at)
+	for range 366 + 7 {
+		for _, window := range c.windowsOn(day) {
+			if at.Before(window.end) {
+				if at.Before(window.start) {
+					return window.start, true
+				}
+				return at, true
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
+
+// Elapsed returns how much business time passed between from and to.
+func (c *BusinessCalendar) Elapsed(from, to time.Time) time.Duration {
+	var elapsed time.Duration
+	for day := c.startOfDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, window := range c.windowsOn(day) {
+			start, end := window.start, window.end
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				elapsed += end.Sub(start)
+			}
+		}
+	}
+	return elapsed
+}
+
+func (q *Queue) SetBusinessHours(calendar *BusinessCalendar) {
+	q.BusinessHours = calendar
+	q.UpdatedAt = time.Now()
+}
+
+func (q *Queue) IsOpenAt(at time.Time) bool {
+	return q.BusinessHours == nil || q.BusinessHours.IsOpen(at)
+}
+
+// BusinessTimeBetween treats queues without business hours as always open.
+func (q *Queue) BusinessTimeBetween(from, to time.Time) time.Duration {
+	if q.BusinessHours == nil {
+		if to.Before(from) {
+			return 0
+		}
+		return to.Sub(from)
+	}
+	return q.BusinessHours.Elapsed(from, to)
+}
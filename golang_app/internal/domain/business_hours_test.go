@@ -0,0 +1,163 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newLondonCalendar(t *testing.T) *domain.BusinessCalendar {
+	t.Helper()
+
+	hours := []domain.WorkingHours{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		window, err := domain.NewWorkingHours(day, 9*60, 17*60+30)
+		if err != nil {
+			t.Fatalf("Failed to create working hours: %v", err)
+		}
+		hours = append(hours, window)
+	}
+
+	calendar, err := domain.NewBusinessCalendar("Europe/London", hours)
+	if err != nil {
+		t.Fatalf("Failed to create calendar: %v", err)
+	}
+	calendar.SetHolidays([]domain.Holiday{{Date: "2025-12-25", Name: "Christmas Day"}})
+
+	return calendar
+}
+
+func london(t *testing.T, month time.Month, day, hour, minute int) time.Time {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("Failed to load time zone: %v", err)
+	}
+	return time.Date(2025, month, day, hour, minute, 0, 0, loc)
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	t.Run("NewBusinessCalendar", func(t *testing.T) {
+		t.Run("should reject windows that close before they open", func(t *testing.T) {
+			if _, err := domain.NewWorkingHours(time.Monday, 17*60, 9*60); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+
+		t.Run("should reject overlapping windows", func(t *testing.T) {
+			hours := []domain.WorkingHours{
+				{Weekday: time.Monday, Start: 9 * 60, End: 13 * 60},
+				{Weekday: time.Monday, Start: 12 * 60, End: 17 * 60},
+			}
+
+			if _, err := domain.NewBusinessCalendar("UTC", hours); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+
+		t.Run("should reject unknown time zones", func(t *testing.T) {
+			if _, err := domain.NewBusinessCalendar("London", []domain.WorkingHours{{Weekday: time.Monday, Start: 0, End: 60}}); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+
+		t.Run("should require working hours", func(t *testing.T) {
+			if _, err := domain.NewBusinessCalendar("UTC", nil); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	})
+
+	t.Run("IsOpen", func(t *testing.T) {
+		tests := []struct {
+			name string
+			at   time.Time
+			want bool
+		}{
+			{"should be open at opening time", london(t, 11, 17, 9, 0), true},
+			{"should be closed before opening time", london(t, 11, 17, 8, 59), false},
+			{"should be closed at closing time", london(t, 11, 17, 17, 30), false},
+			{"should be closed at weekends", london(t, 11, 22, 12, 0), false},
+			{"should be closed on holidays", london(t, 12, 25, 12, 0), false},
+			{"should use local time during summer time", london(t, 6, 2, 9, 30), true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := newLondonCalendar(t).IsOpen(tt.at); got != tt.want {
+					t.Errorf("Expected %v, got %v", tt.want, got)
+				}
+			})
+		}
+	})
+
+	t.Run("NextOpening", func(t *testing.T) {
+		tests := []struct {
+			name string
+			at   time.Time
+			want time.Time
+		}{
+			{"should be now during working hours", london(t, 11, 17, 10, 0), london(t, 11, 17, 10, 0)},
+			{"should be later the same day before opening", london(t, 11, 17, 3, 0), london(t, 11, 17, 9, 0)},
+			{"should skip the weekend", london(t, 11, 21, 18, 0), london(t, 11, 24, 9, 0)},
+			{"should skip holidays", london(t, 12, 24, 18, 0), london(t, 12, 26, 9, 0)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, ok := newLondonCalendar(t).NextOpening(tt.at)
+				if !ok || !got.Equal(tt.want) {
+					t.Errorf("Expected %s, got %s", tt.want, got)
+				}
+			})
+		}
+	})
+
+	t.Run("Elapsed", func(t *testing.T) {
+		tests := []struct {
+			name string
+			from time.Time
+			to   time.Time
+			want time.Duration
+		}{
+			{"should count time within one window", london(t, 11, 17, 9, 30), london(t, 11, 17, 11, 0), 90 * time.Minute},
+			{"should skip nights and weekends", london(t, 11, 21, 16, 30), london(t, 11, 24, 10, 0), 2 * time.Hour},
+			{"should skip holidays", london(t, 12, 24, 17, 0), london(t, 12, 26, 9, 30), time.Hour},
+			{"should count nothing outside working hours", london(t, 11, 22, 0, 0), london(t, 11, 23, 23, 0), 0},
+			{"should count nothing for a reversed range", london(t, 11, 17, 11, 0), london(t, 11, 17, 10, 0), 0},
+			{"should count full working days", london(t, 11, 17, 0, 0), london(t, 11, 19, 0, 0), 17 * time.Hour},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := newLondonCalendar(t).Elapsed(tt.from, tt.to); got != tt.want {
+					t.Errorf("Expected %s, got %s", tt.want, got)
+				}
+			})
+		}
+	})
+
+	t.Run("BusinessTimeBetween", func(t *testing.T) {
+		t.Run("should treat queues without business hours as always open", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "Ops", "admin")
+
+			if got := q.BusinessTimeBetween(london(t, 11, 22, 0, 0), london(t, 11, 23, 0, 0)); got != 24*time.Hour {
+				t.Errorf("Expected 24h, got %s", got)
+			}
+			if !q.IsOpenAt(london(t, 11, 22, 3, 0)) {
+				t.Error("Expected the queue to be open")
+			}
+		})
+
+		t.Run("should use the queue's business hours", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "Ops", "admin")
+			q.SetBusinessHours(newLondonCalendar(t))
+
+			if got := q.BusinessTimeBetween(london(t, 11, 22, 0, 0), london(t, 11, 23, 0, 0)); got != 0 {
+				t.Errorf("Expected no business time at the weekend, got %s", got)
+			}
+		})
+	})
+}
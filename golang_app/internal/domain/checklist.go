@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const MaxChecklistItems = 10
+
+type ChecklistItem struct {
+	ID       string
+	Text     string
+	Required bool
+	Done     bool
+	DoneByID string
+	DoneAt   time.Time
+}
+
+func NewChecklistItem(itemId, text string, required bool) (ChecklistItem, error) {
+	text = strings.TrimSpace(text)
+	if itemId == "" {
+		return ChecklistItem{}, errors.New("checklist item ID is required")
+	}
+
+	if text == "" {
+		return ChecklistItem{}, errors.New("checklist item text is required")
+	}
+
+	return ChecklistItem{
+		ID:       itemId,
+		Text:     text,
+		Required: required,
+	}, nil
+}
+
+func (r *Request) SetChecklist(items []ChecklistItem) error {
+	if r.Status != RequestPending {
+		return errors.New("checklist can only be set while the request is pending")
+	}
+
+	if len(items) > MaxChecklistItems {
+		return fmt.Errorf("checklist can have at most %d items", MaxChecklistItems)
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item.ID] {
+			return fmt.Errorf("checklist item %q is defined more than once", item.ID)
+		}
+		seen[item.ID] = true
+	}
+
+	r.Checklist = items
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) TickChecklistItems(doneItemIds []string, userId string) error {
+	if !r.CanTickChecklistBy(userId) {
+		return errors.New("only assignees can tick checklist items on an accepted or on hold request")
+	}
+
+	done := make(map[string]bool, len(doneItemIds))
+	for _, itemId := range doneItemIds {
+		if !r.hasChecklistItem(itemId) {
+			return fmt.Errorf("checklist item not found: %s", itemId)
+		}
+		done[itemId] = true
+	}
+
+	now := time.Now()
+	for i := range r.Checklist {
+		item := &r.Checklist[i]
+		switch {
+		case done[item.ID] && !item.Done:
+			item.Done = true
+			item.DoneByID = userId
+			item.DoneAt = now
+		case !done[item.ID] && item.Done:
+			item.Done = false
+			item.DoneByID = ""
+			item.DoneAt = time.Time{}
+		}
+	}
+
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) hasChecklistItem(itemId string) bool {
+	for _, item := range r.Checklist {
+		if item.ID == itemId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) CanTickChecklistBy(userId string) bool {
+	if r.Status != RequestAccepted && r.Status != RequestOnHold {
+		return false
+	}
+	return r.IsAssignee(userId)
+}
+
+func (r *Request) ChecklistProgress() (done int, total int) {
+	for _, item := range r.Checklist {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(r.Checklist)
+}
+
+func (r *Request) OpenRequiredItems() []ChecklistItem {
+	open := []ChecklistItem{}
+	for _, item := range r.Checklist {
+		if item.Required && !item.Done {
+			open = append(open, item)
+		}
+	}
+	return open
+}
@@ -0,0 +1,117 @@
+package domain_test
+
+import (
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newRequestWithChecklist(t *testing.T) *domain.Request {
+	t.Helper()
+
+	r, err := domain.NewRequest("req-1", "Onboard Sam", "creator", &domain.RequestRecipient{
+		ID:   "recipient",
+		Type: domain.RequestRecipientUser,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	laptop, _ := domain.NewChecklistItem("item-1", "Order laptop", true)
+	accounts, _ := domain.NewChecklistItem("item-2", "Create accounts", false)
+	if err := r.SetChecklist([]domain.ChecklistItem{laptop, accounts}); err != nil {
+		t.Fatalf("Failed to set checklist: %v", err)
+	}
+
+	if err := r.Accept("recipient"); err != nil {
+		t.Fatalf("Failed to accept request: %v", err)
+	}
+
+	return &r
+}
+
+func TestRequestChecklist(t *testing.T) {
+	t.Run("SetChecklist", func(t *testing.T) {
+		t.Run("should only be allowed while pending", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+
+			if err := r.SetChecklist(nil); err == nil {
+				t.Error("Expected an error for an accepted request")
+			}
+		})
+
+		t.Run("should reject more than the maximum number of items", func(t *testing.T) {
+			r, _ := domain.NewRequest("req-1", "Big", "creator", &domain.RequestRecipient{ID: "recipient", Type: domain.RequestRecipientUser})
+
+			items := make([]domain.ChecklistItem, domain.MaxChecklistItems+1)
+			for i := range items {
+				items[i], _ = domain.NewChecklistItem(string(rune('a'+i)), "step", false)
+			}
+
+			if err := r.SetChecklist(items); err == nil {
+				t.Error("Expected an error for too many items")
+			}
+		})
+	})
+
+	t.Run("TickChecklistItems", func(t *testing.T) {
+		t.Run("should tick the given items and untick the rest", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+
+			if err := r.TickChecklistItems([]string{"item-1", "item-2"}, "recipient"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if err := r.TickChecklistItems([]string{"item-2"}, "recipient"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.Checklist[0].Done || r.Checklist[0].DoneByID != "" {
+				t.Errorf("Expected item-1 to be unticked, got %+v", r.Checklist[0])
+			}
+			if !r.Checklist[1].Done || r.Checklist[1].DoneByID != "recipient" || r.Checklist[1].DoneAt.IsZero() {
+				t.Errorf("Expected item-2 to be ticked, got %+v", r.Checklist[1])
+			}
+
+			done, total := r.ChecklistProgress()
+			if done != 1 || total != 2 {
+				t.Errorf("Expected 1/2 progress, got %d/%d", done, total)
+			}
+		})
+
+		t.Run("should only allow assignees", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+
+			if err := r.TickChecklistItems([]string{"item-1"}, "creator"); err == nil {
+				t.Error("Expected an error for a non assignee")
+			}
+		})
+
+		t.Run("should reject unknown items", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+
+			if err := r.TickChecklistItems([]string{"item-9"}, "recipient"); err == nil {
+				t.Error("Expected an error for an unknown item")
+			}
+		})
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		t.Run("should refuse while required items are open", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+			r.TickChecklistItems([]string{"item-2"}, "recipient")
+
+			if err := r.Complete(); err == nil {
+				t.Error("Expected an error while a required item is open")
+			}
+		})
+
+		t.Run("should allow optional items to stay open", func(t *testing.T) {
+			r := newRequestWithChecklist(t)
+			r.TickChecklistItems([]string{"item-1"}, "recipient")
+
+			if err := r.Complete(); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	})
+}
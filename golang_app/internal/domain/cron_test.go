@@ -0,0 +1,95 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	t.Run("should reject invalid expressions", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			expression string
+		}{
+			{"should require five fields", "* * * *"},
+			{"should reject values out of range", "60 * * * *"},
+			{"should reject backwards ranges", "0 17-9 * * *"},
+			{"should reject a zero step", "*/0 * * * *"},
+			{"should reject unknown names", "0 9 * * funday"},
+			{"should reject unknown time zones", "CRON_TZ=Mars/Olympus 0 9 * * *"},
+			{"should reject intervals under a second", "@every 10ms"},
+			{"should reject malformed intervals", "@every soon"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.ParseCronSchedule(tt.expression); err == nil {
+					t.Errorf("Expected an error for %q", tt.expression)
+				}
+			})
+		}
+	})
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		want       time.Time
+	}{
+		{"should step through minutes", "*/15 * * * *", utc(11, 17, 9, 7), utc(11, 17, 9, 15)},
+		{"should run strictly after the given time", "*/15 * * * *", utc(11, 17, 9, 15), utc(11, 17, 9, 30)},
+		{"should skip to the next matching weekday", "0 9 * * 1-5", utc(11, 21, 10, 0), utc(11, 24, 9, 0)},
+		{"should accept weekday names", "30 8 * * mon,wed", utc(11, 17, 9, 0), utc(11, 19, 8, 30)},
+		{"should treat 7 as sunday", "0 0 * * 7", utc(11, 17, 9, 0), utc(11, 23, 0, 0)},
+		{"should accept 7 at the end of a range", "0 0 * * 6-7", utc(11, 17, 9, 0), utc(11, 22, 0, 0)},
+		{"should match either day of month or day of week", "0 0 28 * mon", utc(11, 25, 9, 0), utc(11, 28, 0, 0)},
+		{"should skip to the next matching month", "0 0 1 jan *", utc(11, 17, 9, 0), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"should expand shorthands", "@daily", utc(11, 17, 9, 0), utc(11, 18, 0, 0)},
+		{"should add fixed intervals", "@every 1m", utc(11, 17, 9, 0).Add(30 * time.Second), utc(11, 17, 9, 1).Add(30 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := domain.ParseCronSchedule(tt.expression)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tt.expression, err)
+			}
+
+			if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("should run in the schedule's time zone across daylight saving changes", func(t *testing.T) {
+		schedule, err := domain.ParseCronSchedule("CRON_TZ=Europe/London 0 9 * * *")
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		before := time.Date(2025, 10, 25, 12, 0, 0, 0, time.UTC)
+		if got, want := schedule.Next(before), time.Date(2025, 10, 26, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+
+		summer := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+		if got, want := schedule.Next(summer), time.Date(2025, 10, 25, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("should return the zero time when nothing matches", func(t *testing.T) {
+		schedule, err := domain.ParseCronSchedule("0 0 31 feb *")
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		if got := schedule.Next(utc(11, 17, 9, 0)); !got.IsZero() {
+			t.Errorf("Expected the zero time, got %s", got)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrDependencyCycle = errors.New("dependency would create a cycle")
+
+type Blocker struct {
+	RequestID string
+	Title     string
+	Status    RequestStatus
+}
+
+func (b Blocker) IsResolved() bool {
+	switch b.Status {
+	case RequestCompleted, RequestRejected, RequestDuplicate, RequestDenied, RequestExpired, RequestCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+type DependencyGraph map[string][]string
+
+func (g DependencyGraph) WouldCreateCycle(requestId, blockerId string) bool {
+	if requestId == blockerId {
+		return true
+	}
+
+	visited := map[string]bool{}
+	stack := []string{blockerId}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == requestId {
+			return true
+		}
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		stack = append(stack, g[current]...)
+	}
+
+	return false
+}
+
+func (r *Request) SetBlockers(blockers []*Request, graph DependencyGraph) error {
+	if !r.IsOpen() {
+		return errors.New("blockers can only be changed on an open request")
+	}
+
+	current := map[string]bool{}
+	for _, blocker := range r.BlockedBy {
+		current[blocker.RequestID] = true
+	}
+
+	edges := make(DependencyGraph, len(graph))
+	for requestId, blockerIds := range graph {
+		edges[requestId] = blockerIds
+	}
+	edges[r.ID] = nil
+
+	updated := make([]Blocker, 0, len(blockers))
+	for _, blocker := range blockers {
+		if blocker.ID == r.ID {
+			return errors.New("a request cannot block itself")
+		}
+
+		if !current[blocker.ID] && !blocker.IsOpen() {
+			return fmt.Errorf("request '%s' is already %s and cannot block", blocker.Title, blocker.Status)
+		}
+
+		if edges.WouldCreateCycle(r.ID, blocker.ID) {
+			return fmt.Errorf("%w: '%s' already depends on '%s'", ErrDependencyCycle, blocker.Title, r.Title)
+		}
+
+		edges[r.ID] = append(edges[r.ID], blocker.ID)
+		updated = append(updated, Blocker{
+			RequestID: blocker.ID,
+			Title:     blocker.Title,
+			Status:    blocker.Status,
+		})
+	}
+
+	r.BlockedBy = updated
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) ReplaceBlocker(oldBlockerId string, replacement *Request, graph DependencyGraph) error {
+	if !r.IsBlockedBy(oldBlockerId) {
+		return fmt.Errorf("request is not blocked by %s", oldBlockerId)
+	}
+
+	blockers := []*Request{}
+	for _, blocker := range r.BlockedBy {
+		if blocker.RequestID == oldBlockerId || blocker.RequestID == replacement.ID {
+			continue
+		}
+		blockers = append(blockers, &Request{ID: blocker.RequestID, Title: blocker.Title, Status: blocker.Status})
+	}
+
+	return r.SetBlockers(append(blockers, replacement), graph)
+}
+
+func (r *Request) IsBlockedBy(requestId string) bool {
+	for _, blocker := range r.BlockedBy {
+		if blocker.RequestID == requestId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) OpenBlockers() []Blocker {
+	open := []Blocker{}
+	for _, blocker := range r.BlockedBy {
+		if !blocker.IsResolved() {
+			open = append(open, blocker)
+		}
+	}
+	return open
+}
+
+func (r *Request) IsBlocked() bool {
+	return len(r.OpenBlockers()) > 0
+}
+
+func (r *Request) CanManageBlockersBy(userId string) bool {
+	if !r.IsOpen() || userId == "" {
+		return false
+	}
+	return r.CreatedByID == userId || r.IsAssignee(userId)
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newPendingRequest(t *testing.T, id, title string) *domain.Request {
+	t.Helper()
+
+	r, err := domain.NewRequest(id, title, "creator", &domain.RequestRecipient{
+		ID:   "recipient",
+		Type: domain.RequestRecipientUser,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	return &r
+}
+
+func TestDependencyGraph(t *testing.T) {
+	graph := domain.DependencyGraph{
+		"b": {"a"},
+		"c": {"b"},
+	}
+
+	tests := []struct {
+		name      string
+		requestId string
+		blockerId string
+		want      bool
+	}{
+		{"should detect a self dependency", "a", "a", true},
+		{"should detect a direct cycle", "a", "b", true},
+		{"should detect a transitive cycle", "a", "c", true},
+		{"should allow a new chain", "d", "c", false},
+		{"should allow an existing direction", "c", "a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := graph.WouldCreateCycle(tt.requestId, tt.blockerId); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRequestBlockers(t *testing.T) {
+	t.Run("should record open blockers", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+		b := newPendingRequest(t, "b", "Set up laptop")
+
+		if err := b.SetBlockers([]*domain.Request{a}, domain.DependencyGraph{}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !b.IsBlocked() || !b.IsBlockedBy("a") {
+			t.Error("Expected the request to be blocked by a")
+		}
+	})
+
+	t.Run("should reject a request blocking itself", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+
+		if err := a.SetBlockers([]*domain.Request{a}, domain.DependencyGraph{}); err == nil {
+			t.Error("Expected an error for a self dependency")
+		}
+	})
+
+	t.Run("should reject cycles", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+		b := newPendingRequest(t, "b", "Set up laptop")
+
+		err := a.SetBlockers([]*domain.Request{b}, domain.DependencyGraph{"b": {"a"}})
+		if !errors.Is(err, domain.ErrDependencyCycle) {
+			t.Errorf("Expected a cycle error, got %v", err)
+		}
+	})
+
+	t.Run("should ignore the request's own existing edges when replacing blockers", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+		b := newPendingRequest(t, "b", "Set up laptop")
+
+		if err := b.SetBlockers([]*domain.Request{a}, domain.DependencyGraph{"b": {"a"}}); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("should reject resolved requests as new blockers", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+		a.Reject("not needed")
+		b := newPendingRequest(t, "b", "Set up laptop")
+
+		if err := b.SetBlockers([]*domain.Request{a}, domain.DependencyGraph{}); err == nil {
+			t.Error("Expected an error for a rejected blocker")
+		}
+	})
+
+	t.Run("should stop being blocked once blockers are resolved", func(t *testing.T) {
+		b := newPendingRequest(t, "b", "Set up laptop")
+		b.BlockedBy = []domain.Blocker{{RequestID: "a", Title: "Order laptop", Status: domain.RequestCompleted}}
+
+		if b.IsBlocked() {
+			t.Error("Expected a completed blocker not to block")
+		}
+	})
+
+	t.Run("should not prevent accepting a blocked request", func(t *testing.T) {
+		a := newPendingRequest(t, "a", "Order laptop")
+		b := newPendingRequest(t, "b", "Set up laptop")
+		b.SetBlockers([]*domain.Request{a}, domain.DependencyGraph{})
+
+		if err := b.Accept("recipient"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
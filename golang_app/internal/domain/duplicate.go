@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func (r *Request) MarkDuplicateOf(canonical *Request) error {
+	if !r.IsOpen() {
+		return errors.New("only open requests can be marked as duplicates")
+	}
+
+	if canonical == nil || canonical.ID == r.ID {
+		return errors.New("a request cannot be a duplicate of itself")
+	}
+
+	if !canonical.IsOpen() {
+		return fmt.Errorf("request '%s' is already %s and cannot absorb duplicates", canonical.Title, canonical.Status)
+	}
+
+	now := time.Now()
+	if r.Status == RequestOnHold {
+		r.endHold(now)
+	}
+
+	r.Status = RequestDuplicate
+	r.DuplicateOfID = canonical.ID
+	r.DuplicateOfTitle = canonical.Title
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) AbsorbDuplicate(duplicate *Request) error {
+	if duplicate.Status != RequestDuplicate || duplicate.DuplicateOfID != r.ID {
+		return errors.New("request must be marked as a duplicate of this request first")
+	}
+
+	if description := strings.TrimSpace(duplicate.Description); description != "" {
+		merged := fmt.Sprintf("Merged from '%s' by <@%s>:\n%s", duplicate.Title, duplicate.CreatedByID, description)
+		if r.Description == "" {
+			r.Description = merged
+		} else {
+			r.Description += "\n\n" + merged
+		}
+	}
+
+	if err := r.AddWatcher(duplicate.CreatedByID); err != nil && !errors.Is(err, ErrAlreadyFollowing) {
+		return err
+	}
+
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) IsDuplicate() bool {
+	return r.Status == RequestDuplicate
+}
@@ -0,0 +1,109 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"request/internal/domain"
+)
+
+func TestRequestDuplicates(t *testing.T) {
+	t.Run("MarkDuplicateOf", func(t *testing.T) {
+		t.Run("should close the request with a pointer to the canonical one", func(t *testing.T) {
+			canonical := newPendingRequest(t, "a", "VPN access")
+			duplicate := newPendingRequest(t, "b", "Need VPN")
+
+			if err := duplicate.MarkDuplicateOf(canonical); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !duplicate.IsDuplicate() || duplicate.IsOpen() || duplicate.DuplicateOfID != "a" {
+				t.Errorf("Unexpected duplicate state: %s %s", duplicate.Status, duplicate.DuplicateOfID)
+			}
+		})
+
+		t.Run("should reject merging into itself", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+
+			if err := r.MarkDuplicateOf(r); err == nil {
+				t.Error("Expected an error for a self merge")
+			}
+		})
+
+		t.Run("should reject merging into a closed request", func(t *testing.T) {
+			canonical := newPendingRequest(t, "a", "VPN access")
+			canonical.Reject("no")
+			duplicate := newPendingRequest(t, "b", "Need VPN")
+
+			if err := duplicate.MarkDuplicateOf(canonical); err == nil {
+				t.Error("Expected an error for a rejected canonical request")
+			}
+		})
+	})
+
+	t.Run("AbsorbDuplicate", func(t *testing.T) {
+		t.Run("should append the description and follow the duplicate's creator", func(t *testing.T) {
+			canonical := newPendingRequest(t, "a", "VPN access")
+			canonical.Description = "For the new laptop"
+			duplicate, _ := domain.NewRequest("b", "Need VPN", "someone-else", &domain.RequestRecipient{ID: "recipient", Type: domain.RequestRecipientUser})
+			duplicate.Description = "Working from home next week"
+			duplicate.MarkDuplicateOf(canonical)
+
+			if err := canonical.AbsorbDuplicate(&duplicate); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !strings.HasPrefix(canonical.Description, "For the new laptop") || !strings.Contains(canonical.Description, "Working from home next week") {
+				t.Errorf("Unexpected description: %q", canonical.Description)
+			}
+			if !canonical.IsWatcher("someone-else") {
+				t.Error("Expected the duplicate's creator to follow the canonical request")
+			}
+		})
+
+		t.Run("should not add the canonical creator as a watcher", func(t *testing.T) {
+			canonical := newPendingRequest(t, "a", "VPN access")
+			duplicate := newPendingRequest(t, "b", "Need VPN")
+			duplicate.MarkDuplicateOf(canonical)
+
+			if err := canonical.AbsorbDuplicate(duplicate); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(canonical.WatcherIDs) != 0 {
+				t.Errorf("Expected no watchers, got %v", canonical.WatcherIDs)
+			}
+		})
+
+		t.Run("should require the duplicate to be marked first", func(t *testing.T) {
+			canonical := newPendingRequest(t, "a", "VPN access")
+			duplicate := newPendingRequest(t, "b", "Need VPN")
+
+			if err := canonical.AbsorbDuplicate(duplicate); err == nil {
+				t.Error("Expected an error for an unmarked duplicate")
+			}
+		})
+	})
+
+	t.Run("CanMarkDuplicate", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			actorId string
+			want    bool
+		}{
+			{"should allow the recipient", "recipient", true},
+			{"should deny the creator", "creator", false},
+			{"should deny anyone else", "stranger", false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				r := newPendingRequest(t, "a", "VPN access")
+				authCtx := domain.NewAuthorizationContext(r, nil, tt.actorId)
+
+				if got := authCtx.CanMarkDuplicate(); got != tt.want {
+					t.Errorf("Expected %v, got %v", tt.want, got)
+				}
+			})
+		}
+	})
+}
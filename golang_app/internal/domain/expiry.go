@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// ExpiryWarningLead is how long before expiry the creator is warned.
+	ExpiryWarningLead = 48 * time.Hour
+	MinExpiryAfter    = 3 * 24 * time.Hour
+)
+
+var ErrRequestTouched = errors.New("request had activity before it could expire")
+
+// ExpiryPolicy expires pending requests that have had no activity for After.
+type ExpiryPolicy struct {
+	After time.Duration
+}
+
+func NewExpiryPolicy(after time.Duration) (*ExpiryPolicy, error) {
+	if after < MinExpiryAfter {
+		return nil, errors.New("requests must be left for at least 3 days before they expire")
+	}
+
+	return &ExpiryPolicy{After: after}, nil
+}
+
+func (q *Queue) SetExpiryPolicy(policy *ExpiryPolicy) {
+	q.Expiry = policy
+	q.UpdatedAt = time.Now()
+}
+
+// EffectiveExpiryPolicy falls back to the workspace policy; pass a nil queue for other recipients.
+func EffectiveExpiryPolicy(queue *Queue, settings WorkspaceSettings) *ExpiryPolicy {
+	if queue != nil && queue.Expiry != nil {
+		return queue.Expiry
+	}
+	return settings.RequestExpiry
+}
+
+// LastActivityAt ignores reminders, SLA checks and expiry warnings.
+func (r *Request) LastActivityAt() time.Time {
+	if r.UpdatedAt.After(r.CreatedAt) {
+		return r.UpdatedAt
+	}
+	return r.CreatedAt
+}
+
+// ExpiresAt is zero when the request can't expire, and never sooner than ExpiryWarningLead after a warning.
+func (r *Request) ExpiresAt(policy *ExpiryPolicy) time.Time {
+	if policy == nil || r.Status != RequestPending {
+		return time.Time{}
+	}
+
+	expiresAt := r.LastActivityAt().Add(policy.After)
+	if r.ExpiryWarned() {
+		if earliest := r.ExpiryWarnedAt.Add(ExpiryWarningLead); earliest.After(expiresAt) {
+			expiresAt = earliest
+		}
+	}
+	return expiresAt
+}
+
+// ExpiryWarned reports whether the creator was warned since the last activity.
+func (r *Request) ExpiryWarned() bool {
+	return !r.ExpiryWarnedAt.IsZero() && !r.ExpiryWarnedAt.Before(r.LastActivityAt())
+}
+
+func (r *Request) ExpiryWarningDue(policy *ExpiryPolicy, at time.Time) bool {
+	expiresAt := r.ExpiresAt(policy)
+	if expiresAt.IsZero() || r.ExpiryWarned() {
+		return false
+	}
+	return !at.Before(expiresAt.Add(-ExpiryWarningLead))
+}
+
+func (r *Request) RecordExpiryWarning(at time.Time) {
+	r.ExpiryWarnedAt = at
+}
+
+func (r *Request) ExpiryDue(policy *ExpiryPolicy, at time.Time) bool {
+	expiresAt := r.ExpiresAt(policy)
+	return !expiresAt.IsZero() && r.ExpiryWarned() && !at.Before(expiresAt)
+}
+
+// KeepOpen counts as activity, so the expiry clock starts over.
+func (r *Request) KeepOpen(userId string, at time.Time) error {
+	if r.Status != RequestPending {
+		return errors.New("only pending requests can be kept open")
+	}
+
+	if userId != r.CreatedByID {
+		return errors.New("only the requester can keep a request open")
+	}
+
+	r.ExpiryWarnedAt = time.Time{}
+	r.UpdatedAt = at
+	return nil
+}
+
+func (r *Request) Expire(at time.Time) error {
+	if r.Status != RequestPending {
+		return errors.New("only pending requests can expire")
+	}
+
+	r.Status = RequestExpired
+	r.ResolvedAt = at
+	r.UpdatedAt = at
+	return nil
+}
+
+// Cancel withdraws an open request on behalf of its creator.
+func (r *Request) Cancel(userId string) error {
+	if !r.IsOpen() {
+		return errors.New("only open requests can be cancelled")
+	}
+
+	if userId != r.CreatedByID {
+		return errors.New("only the requester can cancel a request")
+	}
+
+	now := time.Now()
+	if r.Status == RequestOnHold {
+		r.endHold(now)
+	}
+
+	r.Status = RequestCancelled
+	r.ResolvedAt = now
+	r.UpdatedAt = now
+	return nil
+}
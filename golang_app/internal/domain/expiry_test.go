@@ -0,0 +1,193 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+const day = 24 * time.Hour
+
+func newExpiryRequest(createdAt time.Time) *domain.Request {
+	return &domain.Request{
+		ID:          "req-1",
+		Title:       "Stale request",
+		CreatedByID: "creator",
+		Recipient:   &domain.RequestRecipient{ID: "C1", Type: domain.RequestRecipientChannel},
+		Status:      domain.RequestPending,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+func TestNewExpiryPolicy(t *testing.T) {
+	t.Run("should reject policies shorter than three days", func(t *testing.T) {
+		if _, err := domain.NewExpiryPolicy(2 * day); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestEffectiveExpiryPolicy(t *testing.T) {
+	workspace := &domain.ExpiryPolicy{After: 30 * day}
+	settings := domain.WorkspaceSettings{RequestExpiry: workspace}
+
+	t.Run("should prefer the queue's policy", func(t *testing.T) {
+		queue := &domain.Queue{Expiry: &domain.ExpiryPolicy{After: 14 * day}}
+
+		if got := domain.EffectiveExpiryPolicy(queue, settings); got != queue.Expiry {
+			t.Errorf("Expected the queue policy, got %+v", got)
+		}
+	})
+
+	t.Run("should fall back to the workspace policy", func(t *testing.T) {
+		if got := domain.EffectiveExpiryPolicy(&domain.Queue{}, settings); got != workspace {
+			t.Errorf("Expected the workspace policy, got %+v", got)
+		}
+		if got := domain.EffectiveExpiryPolicy(nil, settings); got != workspace {
+			t.Errorf("Expected the workspace policy, got %+v", got)
+		}
+	})
+}
+
+func TestRequestExpiry(t *testing.T) {
+	policy := &domain.ExpiryPolicy{After: 14 * day}
+	created := utc(11, 1, 9, 0)
+
+	t.Run("should warn 48h before expiry", func(t *testing.T) {
+		r := newExpiryRequest(created)
+
+		if r.ExpiryWarningDue(policy, created.Add(12*day-time.Minute)) {
+			t.Error("Expected no warning before the lead time")
+		}
+		if !r.ExpiryWarningDue(policy, created.Add(12*day)) {
+			t.Error("Expected a warning 48h before expiry")
+		}
+	})
+
+	t.Run("should only warn once per idle period", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		r.RecordExpiryWarning(created.Add(12 * day))
+
+		if r.ExpiryWarningDue(policy, created.Add(13*day)) {
+			t.Error("Expected no second warning")
+		}
+	})
+
+	t.Run("should not expire without a warning", func(t *testing.T) {
+		r := newExpiryRequest(created)
+
+		if r.ExpiryDue(policy, created.Add(20*day)) {
+			t.Error("Expected the request to wait for its warning")
+		}
+	})
+
+	t.Run("should expire once the policy has passed after a warning", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		r.RecordExpiryWarning(created.Add(12 * day))
+
+		if r.ExpiryDue(policy, created.Add(14*day-time.Minute)) {
+			t.Error("Expected the request not to expire early")
+		}
+		if !r.ExpiryDue(policy, created.Add(14*day)) {
+			t.Error("Expected the request to expire")
+		}
+	})
+
+	t.Run("should give a late warning the full lead time", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		warnedAt := created.Add(20 * day)
+		r.RecordExpiryWarning(warnedAt)
+
+		if r.ExpiryDue(policy, warnedAt.Add(domain.ExpiryWarningLead-time.Minute)) {
+			t.Error("Expected the request to wait 48h after a late warning")
+		}
+		if !r.ExpiryDue(policy, warnedAt.Add(domain.ExpiryWarningLead)) {
+			t.Error("Expected the request to expire 48h after the warning")
+		}
+	})
+
+	t.Run("should restart the clock when kept open", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		r.RecordExpiryWarning(created.Add(12 * day))
+
+		keptAt := created.Add(13 * day)
+		if err := r.KeepOpen("creator", keptAt); err != nil {
+			t.Fatalf("Failed to keep open: %v", err)
+		}
+
+		if r.ExpiryDue(policy, created.Add(14*day)) {
+			t.Error("Expected the request not to expire after being kept open")
+		}
+		if !r.ExpiryWarningDue(policy, keptAt.Add(12*day)) {
+			t.Error("Expected a new warning after another idle period")
+		}
+	})
+
+	t.Run("should only let the creator keep a pending request open", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		if err := r.KeepOpen("someone-else", created); err == nil {
+			t.Error("Expected an error for another user")
+		}
+
+		r.Status = domain.RequestAccepted
+		if err := r.KeepOpen("creator", created); err == nil {
+			t.Error("Expected an error for an accepted request")
+		}
+	})
+
+	t.Run("should not expire requests that are no longer pending", func(t *testing.T) {
+		r := newExpiryRequest(created)
+		r.RecordExpiryWarning(created.Add(12 * day))
+		r.Status = domain.RequestAccepted
+
+		if r.ExpiryDue(policy, created.Add(30*day)) {
+			t.Error("Expected accepted requests not to expire")
+		}
+	})
+}
+
+func TestRequestExpire(t *testing.T) {
+	t.Run("should close the request as expired", func(t *testing.T) {
+		r := newExpiryRequest(utc(11, 1, 9, 0))
+		at := utc(11, 15, 9, 0)
+
+		if err := r.Expire(at); err != nil {
+			t.Fatalf("Failed to expire: %v", err)
+		}
+
+		if r.Status != domain.RequestExpired || r.IsOpen() || !r.ResolvedAt.Equal(at) {
+			t.Errorf("Expected an expired, closed request, got %s", r.Status)
+		}
+	})
+}
+
+func TestRequestCancel(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  domain.RequestStatus
+		userId  string
+		wantErr bool
+	}{
+		{"should let the creator cancel a pending request", domain.RequestPending, "creator", false},
+		{"should let the creator cancel an accepted request", domain.RequestAccepted, "creator", false},
+		{"should not let others cancel", domain.RequestPending, "someone-else", true},
+		{"should not cancel closed requests", domain.RequestCompleted, "creator", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newExpiryRequest(utc(11, 1, 9, 0))
+			r.Status = tt.status
+
+			err := r.Cancel(tt.userId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && r.Status != domain.RequestCancelled {
+				t.Errorf("Expected the request to be cancelled, got %s", r.Status)
+			}
+		})
+	}
+}
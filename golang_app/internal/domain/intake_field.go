@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type IntakeFieldType string
+
+const (
+	IntakeFieldText   IntakeFieldType = "text"
+	IntakeFieldNumber IntakeFieldType = "number"
+	IntakeFieldSelect IntakeFieldType = "select"
+	IntakeFieldUser   IntakeFieldType = "user"
+	IntakeFieldDate   IntakeFieldType = "date"
+)
+
+const IntakeFieldDateLayout = "2006-01-02"
+
+func (ft IntakeFieldType) Valid() bool {
+	switch ft {
+	case IntakeFieldText, IntakeFieldNumber, IntakeFieldSelect, IntakeFieldUser, IntakeFieldDate:
+		return true
+	default:
+		return false
+	}
+}
+
+type IntakeField struct {
+	Key      string
+	Label    string
+	Type     IntakeFieldType
+	Required bool
+	Options  []string
+}
+
+type FieldValue struct {
+	Key   string
+	Label string
+	Type  IntakeFieldType
+	Value string
+}
+
+func NewIntakeField(label string, fieldType IntakeFieldType, required bool, options []string) (IntakeField, error) {
+	field := IntakeField{
+		Key:      IntakeFieldKey(label),
+		Label:    strings.TrimSpace(label),
+		Type:     fieldType,
+		Required: required,
+		Options:  options,
+	}
+
+	if err := field.validateDefinition(); err != nil {
+		return IntakeField{}, err
+	}
+
+	return field, nil
+}
+
+func IntakeFieldKey(label string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(strings.TrimSpace(label)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastUnderscore = false
+		} else if !lastUnderscore && b.Len() > 0 {
+			b.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+func (f IntakeField) validateDefinition() error {
+	if f.Key == "" || f.Label == "" {
+		return errors.New("intake field label is required")
+	}
+
+	if !f.Type.Valid() {
+		return fmt.Errorf("intake field %q has an invalid type %q", f.Label, f.Type)
+	}
+
+	if f.Type == IntakeFieldSelect && len(f.Options) == 0 {
+		return fmt.Errorf("select field %q needs at least one option", f.Label)
+	}
+
+	if f.Type != IntakeFieldSelect && len(f.Options) > 0 {
+		return fmt.Errorf("only select fields can define options, %q is a %s field", f.Label, f.Type)
+	}
+
+	return nil
+}
+
+func (f IntakeField) Validate(value string) error {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		if f.Required {
+			return fmt.Errorf("%s is required", f.Label)
+		}
+		return nil
+	}
+
+	switch f.Type {
+	case IntakeFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s must be a number", f.Label)
+		}
+	case IntakeFieldSelect:
+		for _, option := range f.Options {
+			if option == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s must be one of: %s", f.Label, strings.Join(f.Options, ", "))
+	case IntakeFieldDate:
+		if _, err := time.Parse(IntakeFieldDateLayout, value); err != nil {
+			return fmt.Errorf("%s must be a date (YYYY-MM-DD)", f.Label)
+		}
+	}
+
+	return nil
+}
+
+func (q *Queue) SetIntakeFields(fields []IntakeField) error {
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if err := field.validateDefinition(); err != nil {
+			return err
+		}
+		if seen[field.Key] {
+			return fmt.Errorf("intake field %q is defined more than once", field.Label)
+		}
+		seen[field.Key] = true
+	}
+
+	q.IntakeFields = fields
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) ValidateIntakeValues(values map[string]string) ([]FieldValue, map[string]error) {
+	fieldValues := make([]FieldValue, 0, len(q.IntakeFields))
+	fieldErrors := map[string]error{}
+
+	for _, field := range q.IntakeFields {
+		value := strings.TrimSpace(values[field.Key])
+		if err := field.Validate(value); err != nil {
+			fieldErrors[field.Key] = err
+			continue
+		}
+
+		if value == "" {
+			continue
+		}
+
+		fieldValues = append(fieldValues, FieldValue{
+			Key:   field.Key,
+			Label: field.Label,
+			Type:  field.Type,
+			Value: value,
+		})
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors
+	}
+
+	return fieldValues, nil
+}
+
+func (r *Request) SetFieldValues(values map[string]string, queue *Queue) error {
+	if queue == nil || r.Recipient.Type != RequestRecipientQueue || queue.ID != r.Recipient.ID {
+		if len(values) > 0 {
+			return errors.New("intake fields can only be supplied for requests in a queue")
+		}
+		return nil
+	}
+
+	fieldValues, fieldErrors := queue.ValidateIntakeValues(values)
+	for _, field := range queue.IntakeFields {
+		if err, ok := fieldErrors[field.Key]; ok {
+			return err
+		}
+	}
+
+	r.Fields = fieldValues
+	r.UpdatedAt = time.Now()
+	return nil
+}
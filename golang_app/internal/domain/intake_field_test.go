@@ -0,0 +1,124 @@
+package domain_test
+
+import (
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newQueueWithIntakeFields(t *testing.T) *domain.Queue {
+	t.Helper()
+
+	q := domain.NewQueue("queue-1", "IT", "admin")
+
+	priority, err := domain.NewIntakeField("Priority", domain.IntakeFieldSelect, true, []string{"Low", "High"})
+	if err != nil {
+		t.Fatalf("Failed to create field: %v", err)
+	}
+	budget, err := domain.NewIntakeField("Budget (GBP)", domain.IntakeFieldNumber, false, nil)
+	if err != nil {
+		t.Fatalf("Failed to create field: %v", err)
+	}
+
+	if err := q.SetIntakeFields([]domain.IntakeField{priority, budget}); err != nil {
+		t.Fatalf("Failed to set intake fields: %v", err)
+	}
+
+	return &q
+}
+
+func TestIntakeFields(t *testing.T) {
+	t.Run("NewIntakeField", func(t *testing.T) {
+		t.Run("should derive a key from the label", func(t *testing.T) {
+			field, err := domain.NewIntakeField("  Budget (GBP) ", domain.IntakeFieldNumber, false, nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if field.Key != "budget_gbp" {
+				t.Errorf("Expected key budget_gbp, got %q", field.Key)
+			}
+		})
+
+		t.Run("should reject unknown types", func(t *testing.T) {
+			if _, err := domain.NewIntakeField("Colour", "colour", false, nil); err == nil {
+				t.Error("Expected an error for an unknown type")
+			}
+		})
+
+		t.Run("should require options for select fields", func(t *testing.T) {
+			if _, err := domain.NewIntakeField("Priority", domain.IntakeFieldSelect, false, nil); err == nil {
+				t.Error("Expected an error for a select without options")
+			}
+		})
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			field   domain.IntakeField
+			value   string
+			wantErr bool
+		}{
+			{"should accept a number", domain.IntakeField{Label: "Budget", Type: domain.IntakeFieldNumber}, "12.5", false},
+			{"should reject a non number", domain.IntakeField{Label: "Budget", Type: domain.IntakeFieldNumber}, "lots", true},
+			{"should accept a known option", domain.IntakeField{Label: "P", Type: domain.IntakeFieldSelect, Options: []string{"Low"}}, "Low", false},
+			{"should reject an unknown option", domain.IntakeField{Label: "P", Type: domain.IntakeFieldSelect, Options: []string{"Low"}}, "Urgent", true},
+			{"should accept an ISO date", domain.IntakeField{Label: "Due", Type: domain.IntakeFieldDate}, "2025-10-25", false},
+			{"should reject a malformed date", domain.IntakeField{Label: "Due", Type: domain.IntakeFieldDate}, "25/10/2025", true},
+			{"should reject a missing required value", domain.IntakeField{Label: "Due", Type: domain.IntakeFieldDate, Required: true}, " ", true},
+			{"should allow a missing optional value", domain.IntakeField{Label: "Due", Type: domain.IntakeFieldDate}, "", false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.field.Validate(tt.value)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+
+	t.Run("SetIntakeFields", func(t *testing.T) {
+		t.Run("should reject duplicate keys", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "IT", "admin")
+			field := domain.IntakeField{Key: "priority", Label: "Priority", Type: domain.IntakeFieldText}
+
+			if err := q.SetIntakeFields([]domain.IntakeField{field, field}); err == nil {
+				t.Error("Expected an error for duplicate fields")
+			}
+		})
+	})
+
+	t.Run("SetFieldValues", func(t *testing.T) {
+		t.Run("should store validated values in definition order", func(t *testing.T) {
+			q := newQueueWithIntakeFields(t)
+			r, _ := domain.NewRequest("req-1", "Laptop", "creator", &domain.RequestRecipient{ID: q.ID, Type: domain.RequestRecipientQueue})
+
+			err := r.SetFieldValues(map[string]string{"budget_gbp": "900", "priority": "High"}, q)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(r.Fields) != 2 || r.Fields[0].Key != "priority" || r.Fields[1].Value != "900" {
+				t.Errorf("Unexpected field values: %+v", r.Fields)
+			}
+		})
+
+		t.Run("should reject a missing required value", func(t *testing.T) {
+			q := newQueueWithIntakeFields(t)
+			r, _ := domain.NewRequest("req-1", "Laptop", "creator", &domain.RequestRecipient{ID: q.ID, Type: domain.RequestRecipientQueue})
+
+			if err := r.SetFieldValues(map[string]string{"budget_gbp": "900"}, q); err == nil {
+				t.Error("Expected an error for the missing priority")
+			}
+		})
+
+		t.Run("should reject values on requests outside a queue", func(t *testing.T) {
+			r, _ := domain.NewRequest("req-1", "Laptop", "creator", &domain.RequestRecipient{ID: "user", Type: domain.RequestRecipientUser})
+
+			if err := r.SetFieldValues(map[string]string{"priority": "High"}, nil); err == nil {
+				t.Error("Expected an error for a user request")
+			}
+		})
+	})
+}
@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type JobStatus string
+
+const (
+	JobScheduled JobStatus = "scheduled"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+var (
+	ErrJobExists       = errors.New("a job with this key already exists")
+	ErrJobConflict     = errors.New("job was changed by another worker")
+	ErrJobLeaseExpired = errors.New("job's lease expired on its last attempt")
+)
+
+const (
+	DefaultJobMaxAttempts = 5
+	jobBackoffBase        = 30 * time.Second
+	jobBackoffMax         = time.Hour
+)
+
+// Job is a unit of background work, leased to one worker at a time while it runs.
+type Job struct {
+	ID              string
+	Name            string
+	Key             string
+	Payload         string
+	Schedule        string
+	PendingSchedule string
+	PendingPayload  string
+	Status          JobStatus
+	RunAt           time.Time
+	Attempts        int
+	MaxAttempts     int
+	LastError       string
+	LockedBy        string
+	LockedUntil     time.Time
+	LastStartedAt   time.Time
+	LastFinishedAt  time.Time
+	Version         int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewJob creates a one-off job; at most one job exists per key.
+func NewJob(jobId, name, key, payload string, runAt time.Time) (*Job, error) {
+	if name == "" {
+		return nil, errors.New("job name is required")
+	}
+
+	if key == "" {
+		key = jobId
+	}
+
+	now := time.Now()
+	if runAt.IsZero() {
+		runAt = now
+	}
+
+	return &Job{
+		ID:          jobId,
+		Name:        name,
+		Key:         key,
+		Payload:     payload,
+		Status:      JobScheduled,
+		RunAt:       runAt,
+		MaxAttempts: DefaultJobMaxAttempts,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// NewRecurringJob creates a job that runs on a cron schedule.
+func NewRecurringJob(jobId, name, key, schedule, payload string, now time.Time) (*Job, error) {
+	cron, err := ParseCronSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	next := cron.Next(now)
+	if next.IsZero() {
+		return nil, errors.New("schedule never runs")
+	}
+
+	job, err := NewJob(jobId, name, key, payload, next)
+	if err != nil {
+		return nil, err
+	}
+	job.Schedule = cron.Expression
+	return job, nil
+}
+
+func (j *Job) IsRecurring() bool {
+	return j.Schedule != ""
+}
+
+// ChangeSchedule waits for a running job to finish before switching over.
+func (j *Job) ChangeSchedule(schedule, payload string, at time.Time) error {
+	cron, err := ParseCronSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	if j.Status == JobRunning {
+		j.PendingSchedule = cron.Expression
+		j.PendingPayload = payload
+		j.UpdatedAt = at
+		return nil
+	}
+
+	next := cron.Next(at)
+	if next.IsZero() {
+		return errors.New("schedule never runs")
+	}
+
+	j.Schedule = cron.Expression
+	j.Payload = payload
+	j.PendingSchedule = ""
+	j.PendingPayload = ""
+	j.Status = JobScheduled
+	j.RunAt = next
+	j.UpdatedAt = at
+	return nil
+}
+
+func (j *Job) UsesSchedule(schedule, payload string) bool {
+	if j.PendingSchedule != "" {
+		return j.PendingSchedule == schedule && j.PendingPayload == payload
+	}
+	return j.Schedule == schedule && j.Payload == payload
+}
+
+func (j *Job) IsDue(at time.Time) bool {
+	return j.Status == JobScheduled && !j.RunAt.After(at)
+}
+
+func (j *Job) IsLeaseExpired(at time.Time) bool {
+	return j.Status == JobRunning && at.After(j.LockedUntil)
+}
+
+// Claim leases a due job, or one whose lease ran out, to a worker.
+func (j *Job) Claim(workerId string, at time.Time, lease time.Duration) error {
+	if !j.IsDue(at) && !j.IsLeaseExpired(at) {
+		return errors.New("job is not due")
+	}
+
+	if j.IsLeaseExpired(at) && j.Attempts >= j.MaxAttempts {
+		j.Fail(ErrJobLeaseExpired, at)
+		return ErrJobLeaseExpired
+	}
+
+	j.Status = JobRunning
+	j.Attempts++
+	j.LockedBy = workerId
+	j.LockedUntil = at.Add(lease)
+	j.LastStartedAt = at
+	j.UpdatedAt = at
+	return nil
+}
+
+func (j *Job) Succeed(at time.Time) {
+	j.release(at)
+	j.LastError = ""
+	j.Attempts = 0
+
+	if j.reschedule(at) {
+		return
+	}
+	j.Status = JobSucceeded
+}
+
+// Fail retries the job with backoff until it runs out of attempts.
+func (j *Job) Fail(cause error, at time.Time) {
+	j.release(at)
+	j.LastError = cause.Error()
+
+	if j.Attempts < j.MaxAttempts {
+		j.Status = JobScheduled
+		j.RunAt = at.Add(JobBackoff(j.Attempts))
+		return
+	}
+
+	if j.reschedule(at) {
+		j.Attempts = 0
+		return
+	}
+	j.Status = JobFailed
+}
+
+func (j *Job) release(at time.Time) {
+	j.LockedBy = ""
+	j.LockedUntil = time.Time{}
+	j.LastFinishedAt = at
+	j.UpdatedAt = at
+
+	if j.PendingSchedule != "" {
+		j.Schedule = j.PendingSchedule
+		j.Payload = j.PendingPayload
+		j.PendingSchedule = ""
+		j.PendingPayload = ""
+	}
+}
+
+func (j *Job) reschedule(at time.Time) bool {
+	if !j.IsRecurring() {
+		return false
+	}
+
+	cron, err := ParseCronSchedule(j.Schedule)
+	if err != nil {
+		return false
+	}
+
+	next := cron.Next(at)
+	if next.IsZero() {
+		return false
+	}
+
+	j.Status = JobScheduled
+	j.RunAt = next
+	return true
+}
+
+// JobBackoff doubles from 30s with each failure, capped at an hour.
+func JobBackoff(attempts int) time.Duration {
+	delay := jobBackoffBase
+	for i := 1; i < attempts && delay < jobBackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > jobBackoffMax {
+		delay = jobBackoffMax
+	}
+	return delay
+}
@@ -0,0 +1,210 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func TestNewJob(t *testing.T) {
+	t.Run("should require a name", func(t *testing.T) {
+		if _, err := domain.NewJob("job-1", "", "", "", time.Time{}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("should default the key to the job id", func(t *testing.T) {
+		job, err := domain.NewJob("job-1", "send-digest", "", "", time.Time{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.Key != "job-1" || job.Status != domain.JobScheduled || job.RunAt.IsZero() {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should schedule a recurring job for its next run", func(t *testing.T) {
+		job, err := domain.NewRecurringJob("job-1", "send-digest", "digest", "0 9 * * *", "", utc(11, 17, 10, 0))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !job.IsRecurring() || !job.RunAt.Equal(utc(11, 18, 9, 0)) {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should reject invalid schedules", func(t *testing.T) {
+		if _, err := domain.NewRecurringJob("job-1", "send-digest", "digest", "every morning", "", utc(11, 17, 10, 0)); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestJobClaim(t *testing.T) {
+	t.Run("should only claim due jobs", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+
+		if err := job.Claim("worker-1", utc(11, 17, 9, 59), time.Minute); err == nil {
+			t.Error("Expected an error before the job is due")
+		}
+
+		if err := job.Claim("worker-1", utc(11, 17, 10, 0), time.Minute); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.Status != domain.JobRunning || job.LockedBy != "worker-1" || job.Attempts != 1 {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should let another worker take over an expired lease", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+		job.Claim("worker-1", utc(11, 17, 10, 0), time.Minute)
+
+		if err := job.Claim("worker-2", utc(11, 17, 10, 1), time.Minute); err == nil {
+			t.Error("Expected an error while the lease is held")
+		}
+
+		if err := job.Claim("worker-2", utc(11, 17, 10, 2), time.Minute); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.LockedBy != "worker-2" || job.Attempts != 2 {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should fail a job whose lease expired on its last attempt", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+		job.MaxAttempts = 1
+		job.Claim("worker-1", utc(11, 17, 10, 0), time.Minute)
+
+		if err := job.Claim("worker-2", utc(11, 17, 10, 2), time.Minute); !errors.Is(err, domain.ErrJobLeaseExpired) {
+			t.Fatalf("Expected ErrJobLeaseExpired, got %v", err)
+		}
+		if job.Status != domain.JobFailed || job.LockedBy != "" || job.LastError != domain.ErrJobLeaseExpired.Error() {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should move a recurring job on to its next run when its last lease expired", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "", "0 9 * * *", "", utc(11, 17, 8, 0))
+		job.MaxAttempts = 1
+		job.Claim("worker-1", utc(11, 17, 9, 0), time.Minute)
+
+		if err := job.Claim("worker-2", utc(11, 17, 9, 2), time.Minute); !errors.Is(err, domain.ErrJobLeaseExpired) {
+			t.Fatalf("Expected ErrJobLeaseExpired, got %v", err)
+		}
+		if job.Status != domain.JobScheduled || !job.RunAt.Equal(utc(11, 18, 9, 0)) || job.Attempts != 0 {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+}
+
+func TestJobOutcome(t *testing.T) {
+	cause := errors.New("slack is down")
+
+	t.Run("should mark one-off jobs as succeeded", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+		job.Claim("worker-1", utc(11, 17, 10, 0), time.Minute)
+		job.Succeed(utc(11, 17, 10, 0))
+
+		if job.Status != domain.JobSucceeded || job.LockedBy != "" || job.LastFinishedAt.IsZero() {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should retry failed jobs with backoff", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+		job.Claim("worker-1", utc(11, 17, 10, 0), time.Minute)
+		job.Fail(cause, utc(11, 17, 10, 0))
+
+		if job.Status != domain.JobScheduled || !job.RunAt.Equal(utc(11, 17, 10, 0).Add(30*time.Second)) || job.LastError != cause.Error() {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should fail one-off jobs after the last attempt", func(t *testing.T) {
+		job, _ := domain.NewJob("job-1", "send-digest", "", "", utc(11, 17, 10, 0))
+
+		for i := 0; i < job.MaxAttempts; i++ {
+			job.Claim("worker-1", job.RunAt, time.Minute)
+			job.Fail(cause, job.RunAt)
+		}
+
+		if job.Status != domain.JobFailed || job.Attempts != job.MaxAttempts {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should reschedule recurring jobs", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "digest", "0 9 * * *", "", utc(11, 17, 8, 0))
+		job.Claim("worker-1", utc(11, 17, 9, 0), time.Minute)
+		job.Succeed(utc(11, 17, 9, 0))
+
+		if job.Status != domain.JobScheduled || !job.RunAt.Equal(utc(11, 18, 9, 0)) || job.Attempts != 0 {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should move recurring jobs on to their next run after the last attempt", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "digest", "0 9 * * *", "", utc(11, 17, 8, 0))
+
+		for i := 0; i < job.MaxAttempts; i++ {
+			job.Claim("worker-1", job.RunAt, time.Minute)
+			job.Fail(cause, job.RunAt)
+		}
+
+		if job.Status != domain.JobScheduled || !job.RunAt.Equal(utc(11, 18, 9, 0)) || job.Attempts != 0 || job.LastError == "" {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+}
+
+func TestJobBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{10, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := domain.JobBackoff(tt.attempts); got != tt.want {
+			t.Errorf("JobBackoff(%d): expected %s, got %s", tt.attempts, tt.want, got)
+		}
+	}
+}
+
+func TestJobChangeSchedule(t *testing.T) {
+	t.Run("should reschedule a job that isn't running", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "", "0 9 * * *", "", utc(11, 17, 8, 0))
+
+		if err := job.ChangeSchedule("0 10 * * *", "", utc(11, 17, 8, 0)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.Schedule != "0 10 * * *" || !job.RunAt.Equal(utc(11, 17, 10, 0)) {
+			t.Errorf("Unexpected job: %+v", job)
+		}
+	})
+
+	t.Run("should hold the change for a running job until it finishes", func(t *testing.T) {
+		job, _ := domain.NewRecurringJob("job-1", "send-digest", "", "0 9 * * *", "", utc(11, 17, 8, 0))
+		job.Claim("worker-1", utc(11, 17, 9, 0), time.Minute)
+
+		if err := job.ChangeSchedule("0 10 * * *", "", utc(11, 17, 9, 0)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.Status != domain.JobRunning || job.Schedule != "0 9 * * *" || !job.UsesSchedule("0 10 * * *", "") {
+			t.Errorf("Expected the change to wait, got %+v", job)
+		}
+
+		job.Succeed(utc(11, 17, 9, 0))
+		if job.Schedule != "0 10 * * *" || job.PendingSchedule != "" || !job.RunAt.Equal(utc(11, 17, 10, 0)) {
+			t.Errorf("Expected the new schedule after the run, got %+v", job)
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrNoOnCallRotation = errors.New("queue has no on-call rotation")
+
+type OnCallOverride struct {
+	UserID string
+	Start  time.Time
+	End    time.Time
+}
+
+// OnCallRotation hands the pager to the next member every ShiftDays days, at
+// the wall-clock time of StartsAt in TimeZone. Overrides win over the rotation
+// and the most recently added override wins over older ones.
+type OnCallRotation struct {
+	MemberIds []string
+	StartsAt  time.Time
+	ShiftDays int
+	TimeZone  string
+	Overrides []OnCallOverride
+}
+
+type OnCallShift struct {
+	UserID   string
+	Start    time.Time
+	End      time.Time
+	Override bool
+}
+
+func NewOnCallRotation(memberIds []string, startsAt time.Time, shiftDays int, timeZone string) (*OnCallRotation, error) {
+	if len(memberIds) == 0 {
+		return nil, errors.New("an on-call rotation needs at least one member")
+	}
+
+	seen := map[string]bool{}
+	for _, memberId := range memberIds {
+		if memberId == "" {
+			return nil, errors.New("on-call member ID is required")
+		}
+		if seen[memberId] {
+			return nil, fmt.Errorf("<@%s> appears more than once in the rotation", memberId)
+		}
+		seen[memberId] = true
+	}
+
+	if startsAt.IsZero() {
+		return nil, errors.New("an on-call rotation needs a first handoff")
+	}
+
+	if shiftDays < 1 {
+		return nil, errors.New("on-call shifts must last at least one day")
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "" {
+		return nil, fmt.Errorf("unknown time zone %q", timeZone)
+	}
+
+	return &OnCallRotation{
+		MemberIds: memberIds,
+		StartsAt:  startsAt,
+		ShiftDays: shiftDays,
+		TimeZone:  timeZone,
+	}, nil
+}
+
+func (r *OnCallRotation) Includes(userId string) bool {
+	for _, memberId := range r.MemberIds {
+		if memberId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *OnCallRotation) Location() *time.Location {
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (r *OnCallRotation) handoff(shift int) time.Time {
+	return r.StartsAt.In(r.Location()).AddDate(0, 0, shift*r.ShiftDays)
+}
+
+func (r *OnCallRotation) rotationShiftAt(at time.Time) (OnCallShift, bool) {
+	if len(r.MemberIds) == 0 || r.ShiftDays < 1 || at.Before(r.StartsAt) {
+		return OnCallShift{}, false
+	}
+
+	shift := int(at.Sub(r.StartsAt) / (time.Duration(r.ShiftDays) * 24 * time.Hour))
+	for shift > 0 && r.handoff(shift).After(at) {
+		shift--
+	}
+	for !r.handoff(shift + 1).After(at) {
+		shift++
+	}
+
+	return OnCallShift{
+		UserID: r.MemberIds[shift%len(r.MemberIds)],
+		Start:  r.handoff(shift),
+		End:    r.handoff(shift + 1),
+	}, true
+}
+
+// ShiftAt resolves who is on call at the given instant. The returned shift is
+// cut short where an override starts or ends.
+func (r *OnCallRotation) ShiftAt(at time.Time) (OnCallShift, bool) {
+	newerThan := -1
+	var shift OnCallShift
+
+	for i := len(r.Overrides) - 1; i >= 0; i-- {
+		override := r.Overrides[i]
+		if !at.Before(override.Start) && at.Before(override.End) {
+			shift = OnCallShift{UserID: override.UserID, Start: override.Start, End: override.End, Override: true}
+			newerThan = i
+			break
+		}
+	}
+
+	if !shift.Override {
+		rotationShift, ok := r.rotationShiftAt(at)
+		if !ok {
+			return OnCallShift{}, false
+		}
+		shift = rotationShift
+	}
+
+	for i := newerThan + 1; i < len(r.Overrides); i++ {
+		override := r.Overrides[i]
+		if override.Start.After(at) && override.Start.Before(shift.End) {
+			shift.End = override.Start
+		}
+		if !shift.Override && override.End.After(shift.Start) && !override.End.After(at) {
+			shift.Start = override.End
+		}
+	}
+
+	return shift, true
+}
+
+// NextShift returns the first shift after the one at the given instant that is
+// covered by someone else.
+func (r *OnCallRotation) NextShift(at time.Time) (OnCallShift, bool) {
+	current, ok := r.ShiftAt(at)
+	if !ok {
+		if at.Before(r.StartsAt) {
+			return r.ShiftAt(r.StartsAt)
+		}
+		return OnCallShift{}, false
+	}
+
+	next := current
+	for range len(r.MemberIds) + len(r.Overrides) + 1 {
+		next, ok = r.ShiftAt(next.End)
+		if !ok {
+			return OnCallShift{}, false
+		}
+		if next.UserID != current.UserID {
+			break
+		}
+	}
+	return next, true
+}
+
+func (r *OnCallRotation) AddOverride(userId string, start, end time.Time) error {
+	if userId == "" {
+		return errors.New("override user ID is required")
+	}
+
+	if !end.After(start) {
+		return errors.New("an override must end after it starts")
+	}
+
+	r.Overrides = append(r.Overrides, OnCallOverride{UserID: userId, Start: start, End: end})
+	return nil
+}
+
+func (r *OnCallRotation) RemoveExpiredOverrides(at time.Time) {
+	overrides := []OnCallOverride{}
+	for _, override := range r.Overrides {
+		if override.End.After(at) {
+			overrides = append(overrides, override)
+		}
+	}
+	r.Overrides = overrides
+}
+
+func (q *Queue) SetOnCallRotation(rotation *OnCallRotation) error {
+	if rotation == nil {
+		if q.AssignmentStrategy == AssignmentOnCall {
+			return errors.New("switch off on-call assignment before removing the rotation")
+		}
+		q.OnCall = nil
+		q.UpdatedAt = time.Now()
+		return nil
+	}
+
+	for _, memberId := range rotation.MemberIds {
+		if !q.RoleOf(memberId).Allows(PermissionAccept) {
+			return fmt.Errorf("<@%s> must be a queue member to be on call", memberId)
+		}
+	}
+
+	if q.OnCall != nil && len(rotation.Overrides) == 0 {
+		rotation.Overrides = q.OnCall.Overrides
+	}
+
+	q.OnCall = rotation
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) AddOnCallOverride(userId string, start, end time.Time) error {
+	if q.OnCall == nil {
+		return ErrNoOnCallRotation
+	}
+
+	if !q.RoleOf(userId).Allows(PermissionAccept) {
+		return fmt.Errorf("<@%s> must be a queue member to be on call", userId)
+	}
+
+	q.OnCall.RemoveExpiredOverrides(time.Now())
+	if err := q.OnCall.AddOverride(userId, start, end); err != nil {
+		return err
+	}
+
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) OnCallAt(at time.Time) (OnCallShift, bool) {
+	if q.OnCall == nil {
+		return OnCallShift{}, false
+	}
+	return q.OnCall.ShiftAt(at)
+}
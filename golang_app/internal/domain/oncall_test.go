@@ -0,0 +1,243 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newWeeklyRotation(t *testing.T) *domain.OnCallRotation {
+	t.Helper()
+
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("Failed to load time zone: %v", err)
+	}
+
+	rotation, err := domain.NewOnCallRotation([]string{"alice", "bob", "carol"}, time.Date(2025, 11, 3, 9, 0, 0, 0, london), 7, "Europe/London")
+	if err != nil {
+		t.Fatalf("Failed to create rotation: %v", err)
+	}
+
+	return rotation
+}
+
+func utc(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestOnCallRotation(t *testing.T) {
+	t.Run("NewOnCallRotation", func(t *testing.T) {
+		start := utc(11, 3, 9, 0)
+
+		tests := []struct {
+			name      string
+			memberIds []string
+			startsAt  time.Time
+			shiftDays int
+			timeZone  string
+		}{
+			{"should require members", nil, start, 7, "UTC"},
+			{"should reject duplicate members", []string{"alice", "alice"}, start, 7, "UTC"},
+			{"should require a first handoff", []string{"alice"}, time.Time{}, 7, "UTC"},
+			{"should require shifts of at least a day", []string{"alice"}, start, 0, "UTC"},
+			{"should reject unknown time zones", []string{"alice"}, start, 7, "Mars/Olympus"},
+			{"should require a time zone", []string{"alice"}, start, 7, ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.NewOnCallRotation(tt.memberIds, tt.startsAt, tt.shiftDays, tt.timeZone); err == nil {
+					t.Error("Expected an error")
+				}
+			})
+		}
+	})
+
+	t.Run("ShiftAt", func(t *testing.T) {
+		tests := []struct {
+			name string
+			at   time.Time
+			want string
+			ok   bool
+		}{
+			{"should have nobody on call before the rotation starts", utc(11, 3, 8, 59), "", false},
+			{"should start with the first member", utc(11, 3, 9, 0), "alice", true},
+			{"should keep the member for the whole shift", utc(11, 10, 8, 59), "alice", true},
+			{"should hand off at the handoff time", utc(11, 10, 9, 0), "bob", true},
+			{"should wrap around to the first member", utc(11, 24, 9, 0), "alice", true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				shift, ok := newWeeklyRotation(t).ShiftAt(tt.at)
+				if shift.UserID != tt.want || ok != tt.ok {
+					t.Errorf("Expected %q %v, got %q %v", tt.want, tt.ok, shift.UserID, ok)
+				}
+			})
+		}
+
+		t.Run("should keep the local handoff time across daylight saving changes", func(t *testing.T) {
+			london, _ := time.LoadLocation("Europe/London")
+			rotation, _ := domain.NewOnCallRotation([]string{"alice", "bob"}, time.Date(2025, 3, 28, 9, 0, 0, 0, london), 1, "Europe/London")
+
+			shift, _ := rotation.ShiftAt(utc(3, 31, 8, 30))
+			if !shift.Start.Equal(utc(3, 31, 8, 0)) || shift.UserID != "bob" {
+				t.Errorf("Expected bob from 08:00 UTC, got %s from %s", shift.UserID, shift.Start.UTC())
+			}
+		})
+	})
+
+	t.Run("Overrides", func(t *testing.T) {
+		newOverriddenRotation := func(t *testing.T) *domain.OnCallRotation {
+			rotation := newWeeklyRotation(t)
+			if err := rotation.AddOverride("bob", utc(11, 5, 12, 0), utc(11, 5, 18, 0)); err != nil {
+				t.Fatalf("Failed to add override: %v", err)
+			}
+			return rotation
+		}
+
+		t.Run("should put the override on call", func(t *testing.T) {
+			shift, _ := newOverriddenRotation(t).ShiftAt(utc(11, 5, 13, 0))
+
+			if shift.UserID != "bob" || !shift.Override || !shift.End.Equal(utc(11, 5, 18, 0)) {
+				t.Errorf("Unexpected shift: %+v", shift)
+			}
+		})
+
+		t.Run("should cut the rotation shift short around the override", func(t *testing.T) {
+			rotation := newOverriddenRotation(t)
+
+			before, _ := rotation.ShiftAt(utc(11, 5, 10, 0))
+			if before.UserID != "alice" || !before.End.Equal(utc(11, 5, 12, 0)) {
+				t.Errorf("Unexpected shift before the override: %+v", before)
+			}
+
+			after, _ := rotation.ShiftAt(utc(11, 5, 19, 0))
+			if after.UserID != "alice" || !after.Start.Equal(utc(11, 5, 18, 0)) {
+				t.Errorf("Unexpected shift after the override: %+v", after)
+			}
+		})
+
+		t.Run("should let the newest override win", func(t *testing.T) {
+			rotation := newOverriddenRotation(t)
+			rotation.AddOverride("carol", utc(11, 5, 14, 0), utc(11, 5, 15, 0))
+
+			if shift, _ := rotation.ShiftAt(utc(11, 5, 14, 30)); shift.UserID != "carol" {
+				t.Errorf("Expected carol, got %s", shift.UserID)
+			}
+			if shift, _ := rotation.ShiftAt(utc(11, 5, 13, 0)); !shift.End.Equal(utc(11, 5, 14, 0)) {
+				t.Errorf("Expected the older override to end when the newer one starts, got %s", shift.End)
+			}
+		})
+
+		t.Run("should reject overrides that end before they start", func(t *testing.T) {
+			if err := newWeeklyRotation(t).AddOverride("bob", utc(11, 5, 18, 0), utc(11, 5, 12, 0)); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	})
+
+	t.Run("NextShift", func(t *testing.T) {
+		t.Run("should return the next person in the rotation", func(t *testing.T) {
+			next, ok := newWeeklyRotation(t).NextShift(utc(11, 5, 10, 0))
+
+			if !ok || next.UserID != "bob" || !next.Start.Equal(utc(11, 10, 9, 0)) {
+				t.Errorf("Unexpected next shift: %+v", next)
+			}
+		})
+
+		t.Run("should return an upcoming override", func(t *testing.T) {
+			rotation := newWeeklyRotation(t)
+			rotation.AddOverride("carol", utc(11, 5, 12, 0), utc(11, 5, 18, 0))
+
+			if next, _ := rotation.NextShift(utc(11, 5, 10, 0)); next.UserID != "carol" || !next.Override {
+				t.Errorf("Unexpected next shift: %+v", next)
+			}
+		})
+
+		t.Run("should return the first shift before the rotation starts", func(t *testing.T) {
+			if next, ok := newWeeklyRotation(t).NextShift(utc(11, 1, 0, 0)); !ok || next.UserID != "alice" {
+				t.Errorf("Unexpected next shift: %+v", next)
+			}
+		})
+	})
+}
+
+func TestQueueOnCall(t *testing.T) {
+	t.Run("should only put queue responders on call", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob")
+
+		if err := q.SetOnCallRotation(newWeeklyRotation(t)); err == nil {
+			t.Error("Expected an error for carol, who is not a member")
+		}
+	})
+
+	t.Run("should need a rotation to assign to on-call", func(t *testing.T) {
+		q := domain.NewQueue("queue-1", "Ops", "admin")
+
+		if err := q.SetAssignmentStrategy(domain.AssignmentOnCall); err == nil {
+			t.Error("Expected an error without a rotation")
+		}
+	})
+
+	t.Run("should keep overrides when the rotation changes", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob", "carol")
+		q.SetOnCallRotation(newWeeklyRotation(t))
+		if err := q.AddOnCallOverride("carol", time.Now(), time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("Failed to add override: %v", err)
+		}
+
+		q.SetOnCallRotation(newWeeklyRotation(t))
+
+		if len(q.OnCall.Overrides) != 1 {
+			t.Errorf("Expected the override to survive, got %v", q.OnCall.Overrides)
+		}
+	})
+
+	t.Run("should not remove the rotation while assigning to on-call", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob", "carol")
+		q.SetOnCallRotation(newWeeklyRotation(t))
+		q.SetAssignmentStrategy(domain.AssignmentOnCall)
+
+		if err := q.SetOnCallRotation(nil); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("NextAssignee", func(t *testing.T) {
+		newOnCallQueue := func(t *testing.T) *domain.Queue {
+			q := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob", "carol")
+			if err := q.SetOnCallRotation(newWeeklyRotation(t)); err != nil {
+				t.Fatalf("Failed to set rotation: %v", err)
+			}
+			if err := q.SetAssignmentStrategy(domain.AssignmentOnCall); err != nil {
+				t.Fatalf("Failed to set assignment strategy: %v", err)
+			}
+			return q
+		}
+
+		t.Run("should assign whoever is on call", func(t *testing.T) {
+			got, ok := newOnCallQueue(t).NextAssignee(newQueuedRequest(t, "requester"), utc(11, 12, 0, 0), nil, domain.WIPLoad{}, nil)
+			if !ok || got != "bob" {
+				t.Errorf("Expected bob, got %q", got)
+			}
+		})
+
+		t.Run("should not assign the requester to their own request", func(t *testing.T) {
+			if got, ok := newOnCallQueue(t).NextAssignee(newQueuedRequest(t, "bob"), utc(11, 12, 0, 0), nil, domain.WIPLoad{}, nil); ok {
+				t.Errorf("Expected no assignee, got %q", got)
+			}
+		})
+
+		t.Run("should leave the request pending once the on-call person declined", func(t *testing.T) {
+			r := newQueuedRequest(t, "requester")
+			r.DeclinedByIDs = []string{"bob"}
+
+			if got, ok := newOnCallQueue(t).NextAssignee(r, utc(11, 12, 0, 0), nil, domain.WIPLoad{}, nil); ok {
+				t.Errorf("Expected no assignee, got %q", got)
+			}
+		})
+	})
+}
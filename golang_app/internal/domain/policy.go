@@ -0,0 +1,81 @@
+package domain
+
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleAdmin     Role = "admin"
+	RoleTriager   Role = "triager"
+	RoleResponder Role = "responder"
+	RoleViewer    Role = "viewer"
+)
+
+type Permission string
+
+const (
+	PermissionAccept    Permission = "accept"
+	PermissionAssign    Permission = "assign"
+	PermissionReject    Permission = "reject"
+	PermissionEditQueue Permission = "edit-queue"
+	PermissionView      Permission = "view"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleOwner:     {PermissionAccept, PermissionAssign, PermissionReject, PermissionEditQueue, PermissionView},
+	RoleAdmin:     {PermissionAccept, PermissionAssign, PermissionReject, PermissionEditQueue, PermissionView},
+	RoleTriager:   {PermissionAssign, PermissionReject, PermissionView},
+	RoleResponder: {PermissionAccept, PermissionReject, PermissionView},
+	RoleViewer:    {PermissionView},
+}
+
+func Roles() []Role {
+	return []Role{RoleOwner, RoleAdmin, RoleTriager, RoleResponder, RoleViewer}
+}
+
+func Permissions() []Permission {
+	return []Permission{PermissionAccept, PermissionAssign, PermissionReject, PermissionEditQueue, PermissionView}
+}
+
+func (r Role) Allows(permission Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type PolicyDecision struct {
+	ActorID    string
+	Role       Role
+	Permission Permission
+	Allowed    bool
+}
+
+func Decide(actorId string, role Role, permission Permission) PolicyDecision {
+	return PolicyDecision{
+		ActorID:    actorId,
+		Role:       role,
+		Permission: permission,
+		Allowed:    actorId != "" && role.Allows(permission),
+	}
+}
+
+func (q *Queue) RoleOf(userId string) Role {
+	switch {
+	case userId == q.CreatedById:
+		return RoleOwner
+	case q.IsAdmin(userId):
+		return RoleAdmin
+	case q.IsTriager(userId):
+		return RoleTriager
+	case q.IsMember(userId):
+		return RoleResponder
+	default:
+		return RoleViewer
+	}
+}
+
+func (q *Queue) Decide(userId string, permission Permission) PolicyDecision {
+	return Decide(userId, q.RoleOf(userId), permission)
+}
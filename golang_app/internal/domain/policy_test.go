@@ -0,0 +1,193 @@
+package domain_test
+
+import (
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newRolesQueue(t *testing.T) *domain.Queue {
+	t.Helper()
+
+	q := domain.NewQueue("queue-1", "Access", "owner")
+	if err := q.AddAdmin("admin"); err != nil {
+		t.Fatalf("Failed to add admin: %v", err)
+	}
+	if err := q.AddTriager("triager"); err != nil {
+		t.Fatalf("Failed to add triager: %v", err)
+	}
+	if err := q.AddMember("responder"); err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+
+	return &q
+}
+
+func TestPolicy(t *testing.T) {
+	t.Run("Allows", func(t *testing.T) {
+		granted := map[domain.Role]map[domain.Permission]bool{
+			domain.RoleOwner: {
+				domain.PermissionAccept: true, domain.PermissionAssign: true, domain.PermissionReject: true, domain.PermissionEditQueue: true, domain.PermissionView: true,
+			},
+			domain.RoleAdmin: {
+				domain.PermissionAccept: true, domain.PermissionAssign: true, domain.PermissionReject: true, domain.PermissionEditQueue: true, domain.PermissionView: true,
+			},
+			domain.RoleTriager: {
+				domain.PermissionAssign: true, domain.PermissionReject: true, domain.PermissionView: true,
+			},
+			domain.RoleResponder: {
+				domain.PermissionAccept: true, domain.PermissionReject: true, domain.PermissionView: true,
+			},
+			domain.RoleViewer: {
+				domain.PermissionView: true,
+			},
+		}
+
+		for _, role := range domain.Roles() {
+			for _, permission := range domain.Permissions() {
+				want := granted[role][permission]
+				t.Run(string(role)+" "+string(permission), func(t *testing.T) {
+					if got := role.Allows(permission); got != want {
+						t.Errorf("Expected %s to %s to be %v, got %v", role, permission, want, got)
+					}
+				})
+			}
+		}
+
+		t.Run("should deny unknown roles", func(t *testing.T) {
+			if domain.Role("superuser").Allows(domain.PermissionView) {
+				t.Error("Expected an unknown role to have no permissions")
+			}
+		})
+	})
+
+	t.Run("RoleOf", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			userId string
+			want   domain.Role
+		}{
+			{"should make the creator the owner", "owner", domain.RoleOwner},
+			{"should resolve admins", "admin", domain.RoleAdmin},
+			{"should resolve triagers", "triager", domain.RoleTriager},
+			{"should treat members as responders", "responder", domain.RoleResponder},
+			{"should treat everyone else as a viewer", "stranger", domain.RoleViewer},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := newRolesQueue(t).RoleOf(tt.userId); got != tt.want {
+					t.Errorf("Expected %s, got %s", tt.want, got)
+				}
+			})
+		}
+	})
+
+	t.Run("Decide", func(t *testing.T) {
+		t.Run("should record the role and permission of the decision", func(t *testing.T) {
+			decision := newRolesQueue(t).Decide("triager", domain.PermissionEditQueue)
+
+			if decision.Allowed || decision.Role != domain.RoleTriager || decision.Permission != domain.PermissionEditQueue || decision.ActorID != "triager" {
+				t.Errorf("Unexpected decision: %+v", decision)
+			}
+		})
+
+		t.Run("should deny anonymous actors", func(t *testing.T) {
+			if domain.Decide("", domain.RoleOwner, domain.PermissionView).Allowed {
+				t.Error("Expected an empty actor to be denied")
+			}
+		})
+	})
+}
+
+func TestAuthorizationContextRoles(t *testing.T) {
+	newQueueRequest := func(t *testing.T) *domain.Request {
+		r, err := domain.NewRequest("req-1", "VPN access", "requester", &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue})
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		return &r
+	}
+
+	tests := []struct {
+		actorId   string
+		canAccept bool
+		canAssign bool
+		canReject bool
+		canLabel  bool
+	}{
+		{"owner", true, true, true, true},
+		{"admin", true, true, true, true},
+		{"triager", false, true, true, true},
+		{"responder", true, false, true, true},
+		{"stranger", false, false, false, false},
+		{"requester", false, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("should apply the policy for "+tt.actorId, func(t *testing.T) {
+			authCtx := domain.NewAuthorizationContext(newQueueRequest(t), newRolesQueue(t), tt.actorId)
+
+			if got := authCtx.CanAccept(); got != tt.canAccept {
+				t.Errorf("CanAccept: expected %v, got %v", tt.canAccept, got)
+			}
+			if got := authCtx.CanAssign(); got != tt.canAssign {
+				t.Errorf("CanAssign: expected %v, got %v", tt.canAssign, got)
+			}
+			if got := authCtx.CanReject(); got != tt.canReject {
+				t.Errorf("CanReject: expected %v, got %v", tt.canReject, got)
+			}
+			if got := authCtx.CanLabel(); got != tt.canLabel {
+				t.Errorf("CanLabel: expected %v, got %v", tt.canLabel, got)
+			}
+		})
+	}
+
+	t.Run("should report every policy decision", func(t *testing.T) {
+		authCtx := domain.NewAuthorizationContext(newQueueRequest(t), newRolesQueue(t), "triager")
+		decisions := []domain.PolicyDecision{}
+		authCtx.OnDecision = func(decision domain.PolicyDecision) {
+			decisions = append(decisions, decision)
+		}
+
+		authCtx.CanAccept()
+
+		if len(decisions) != 1 || decisions[0].Permission != domain.PermissionAccept || decisions[0].Allowed {
+			t.Errorf("Unexpected decisions: %+v", decisions)
+		}
+	})
+
+	t.Run("should only assign pending requests", func(t *testing.T) {
+		r := newQueueRequest(t)
+		r.Accept("responder")
+
+		if domain.NewAuthorizationContext(r, newRolesQueue(t), "triager").CanAssign() {
+			t.Error("Expected an accepted request not to be assignable")
+		}
+	})
+
+	t.Run("should only let channel members respond to channel requests", func(t *testing.T) {
+		r, _ := domain.NewRequest("req-3", "Refund", "requester", &domain.RequestRecipient{ID: "payments", Type: domain.RequestRecipientChannel})
+
+		member := domain.NewAuthorizationContext(&r, nil, "member")
+		member.ChannelMember = true
+		if !member.CanAccept() {
+			t.Error("Expected a channel member to be able to accept")
+		}
+
+		if domain.NewAuthorizationContext(&r, nil, "outsider").CanAccept() {
+			t.Error("Expected someone outside the channel not to be able to accept")
+		}
+	})
+
+	t.Run("should make the user recipient a responder", func(t *testing.T) {
+		r, _ := domain.NewRequest("req-2", "Review", "requester", &domain.RequestRecipient{ID: "reviewer", Type: domain.RequestRecipientUser})
+
+		if role := domain.NewAuthorizationContext(&r, nil, "reviewer").Role(); role != domain.RoleResponder {
+			t.Errorf("Expected responder, got %s", role)
+		}
+		if role := domain.NewAuthorizationContext(&r, nil, "someone").Role(); role != domain.RoleViewer {
+			t.Errorf("Expected viewer, got %s", role)
+		}
+	})
+}
@@ -2,29 +2,56 @@ package domain
 
 import (
 	"errors"
+	"strings"
 	"time"
 )
 
+var ErrQueueNotFound = errors.New("queue not found")
+
 type Queue struct {
-	ID          string
-	ChannelId   string
-	Name        string
-	Description string
-	CreatedById string
-	AdminIds    []string
-	MemberIds   []string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                 string
+	ChannelId          string
+	Name               string
+	Description        string
+	CreatedById        string
+	AdminIds           []string
+	MemberIds          []string
+	TriagerIds         []string
+	Labels             []string
+	IntakeFields       []IntakeField
+	Templates          []RequestTemplate
+	Workflow           *Workflow
+	ApprovalChain      []ApprovalStage
+	AssignmentStrategy AssignmentStrategy
+	LastAssigneeID     string
+	OnCall             *OnCallRotation
+	BusinessHours      *BusinessCalendar
+	SLA                *SLAPolicy
+	ReminderRules      []ReminderRule
+	Expiry             *ExpiryPolicy
+	WIP                *WIPLimits
+	RoutingRules       []RoutingRule
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type QueueSummary struct {
+	Queue        *Queue
+	OpenRequests int
+	LabelCounts  map[string]int
+	SLABreaches  map[SLATarget]int
+	Load         WIPLoad
 }
 
 func NewQueue(queueId string, name string, createdById string) Queue {
 	return Queue{
-		ID:          queueId,
-		Name:        name,
-		CreatedById: createdById,
-		AdminIds:    []string{createdById},
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:                 queueId,
+		Name:               name,
+		CreatedById:        createdById,
+		AdminIds:           []string{createdById},
+		AssignmentStrategy: AssignmentNone,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
 	}
 }
 
@@ -102,10 +129,75 @@ func (q *Queue) IsMember(userId string) bool {
 	return false
 }
 
-func (q *Queue) CanRespondToRequests(userId string) bool {
-	return q.IsAdmin(userId) || q.IsMember(userId)
+func (q *Queue) AddTriager(userId string) error {
+	if q.IsTriager(userId) {
+		return errors.New("user is already a triager of this queue")
+	}
+
+	q.TriagerIds = append(q.TriagerIds, userId)
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) RemoveTriager(userId string) error {
+	for i, triagerId := range q.TriagerIds {
+		if triagerId == userId {
+			q.TriagerIds = append(q.TriagerIds[:i], q.TriagerIds[i+1:]...)
+			q.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	return errors.New("user is not a triager of this queue")
+}
+
+func (q *Queue) IsTriager(userId string) bool {
+	for _, triagerId := range q.TriagerIds {
+		if triagerId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *Queue) AddLabel(label string) error {
+	label = NormalizeLabel(label)
+	if label == "" {
+		return errors.New("label cannot be empty")
+	}
+
+	if q.HasLabel(label) {
+		return errors.New("label already exists on this queue")
+	}
+
+	q.Labels = append(q.Labels, label)
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) RemoveLabel(label string) error {
+	label = NormalizeLabel(label)
+	for i, existing := range q.Labels {
+		if existing == label {
+			q.Labels = append(q.Labels[:i], q.Labels[i+1:]...)
+			q.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	return errors.New("label does not exist on this queue")
+}
+
+func (q *Queue) HasLabel(label string) bool {
+	label = NormalizeLabel(label)
+	for _, existing := range q.Labels {
+		if existing == label {
+			return true
+		}
+	}
+	return false
 }
 
-func (q *Queue) CanBeModifiedBy(userId string) bool {
-	return q.IsAdmin(userId)
+func NormalizeLabel(label string) string {
+	return strings.ToLower(strings.TrimSpace(label))
 }
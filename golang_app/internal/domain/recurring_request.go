@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrOccurrenceClaimed = errors.New("recurring request occurrence was already created")
+
+// RecurringPlaceholders are filled in per occurrence, in the schedule's time zone.
+var RecurringPlaceholders = []string{"date", "week", "month", "quarter", "year"}
+
+// RecurringRequest raises a fresh request each time its schedule comes round.
+type RecurringRequest struct {
+	ID          string
+	Title       string
+	Description string
+	Recipient   *RequestRecipient
+	Schedule    string
+	CreatedByID string
+	Paused      bool
+	NextRunAt   time.Time
+	LastRunAt   time.Time
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewRecurringRequest(
+	id, title, description string,
+	recipient *RequestRecipient,
+	schedule, createdById string,
+	now time.Time,
+) (*RecurringRequest, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, errors.New("recurring request title is required")
+	}
+
+	if recipient == nil || !recipient.Valid() {
+		return nil, errors.New("recurring request needs a valid recipient")
+	}
+
+	if createdById == "" {
+		return nil, errors.New("recurring request creator is required")
+	}
+
+	for _, name := range Placeholders(title, description) {
+		if !slices.Contains(RecurringPlaceholders, name) {
+			return nil, fmt.Errorf("unknown placeholder {{%s}}, use one of: %s", name, strings.Join(RecurringPlaceholders, ", "))
+		}
+	}
+
+	rr := &RecurringRequest{
+		ID:          id,
+		Title:       title,
+		Description: strings.TrimSpace(description),
+		Recipient:   recipient,
+		Schedule:    strings.TrimSpace(schedule),
+		CreatedByID: createdById,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	recurrence, err := rr.Recurrence()
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	rr.NextRunAt = recurrence.Next(now)
+	if rr.NextRunAt.IsZero() {
+		return nil, errors.New("schedule never runs")
+	}
+
+	return rr, nil
+}
+
+// Recurrence parses the schedule; rules without a DTSTART begin at CreatedAt.
+func (rr *RecurringRequest) Recurrence() (Recurrence, error) {
+	return ParseRecurrence(rr.Schedule, rr.CreatedAt)
+}
+
+func (rr *RecurringRequest) IsFinished() bool {
+	return rr.NextRunAt.IsZero()
+}
+
+func (rr *RecurringRequest) IsDue(at time.Time) bool {
+	return !rr.Paused && !rr.IsFinished() && !rr.NextRunAt.After(at)
+}
+
+// PlaceholderValues returns the values for the occurrence at the given time.
+func (rr *RecurringRequest) PlaceholderValues(occurrence time.Time) map[string]string {
+	if recurrence, err := rr.Recurrence(); err == nil {
+		occurrence = occurrence.In(recurrence.Location())
+	}
+
+	year, week := occurrence.ISOWeek()
+	return map[string]string{
+		"date":    occurrence.Format("2006-01-02"),
+		"week":    fmt.Sprintf("%d-W%02d", year, week),
+		"month":   occurrence.Format("January 2006"),
+		"quarter": fmt.Sprintf("Q%d %d", (int(occurrence.Month())-1)/3+1, occurrence.Year()),
+		"year":    occurrence.Format("2006"),
+	}
+}
+
+// Advance records a run and skips to the next occurrence after it.
+func (rr *RecurringRequest) Advance(at time.Time, runErr error) {
+	rr.LastRunAt = at
+	rr.LastError = ""
+	if runErr != nil {
+		rr.LastError = runErr.Error()
+	}
+
+	rr.NextRunAt = time.Time{}
+	if recurrence, err := rr.Recurrence(); err == nil {
+		rr.NextRunAt = recurrence.Next(at)
+	}
+	rr.UpdatedAt = at
+}
+
+func (rr *RecurringRequest) Pause(at time.Time) error {
+	if rr.Paused {
+		return errors.New("recurring request is already paused")
+	}
+
+	rr.Paused = true
+	rr.UpdatedAt = at
+	return nil
+}
+
+// Resume skips the occurrences that fell while paused.
+func (rr *RecurringRequest) Resume(at time.Time) error {
+	if !rr.Paused {
+		return errors.New("recurring request is not paused")
+	}
+
+	recurrence, err := rr.Recurrence()
+	if err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	rr.Paused = false
+	rr.NextRunAt = recurrence.Next(at)
+	rr.UpdatedAt = at
+	return nil
+}
+
+// CanBeManagedBy allows the creator and the queue's editors.
+func (rr *RecurringRequest) CanBeManagedBy(userId string, queue *Queue) bool {
+	if userId == rr.CreatedByID {
+		return true
+	}
+
+	return queue != nil && queue.Decide(userId, PermissionEditQueue).Allowed
+}
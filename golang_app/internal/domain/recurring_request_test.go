@@ -0,0 +1,171 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newRecurringRequest(t *testing.T, title, schedule string) *domain.RecurringRequest {
+	t.Helper()
+
+	recipient := &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue}
+	rr, err := domain.NewRecurringRequest("rr-1", title, "", recipient, schedule, "U1", utc(11, 17, 10, 0))
+	if err != nil {
+		t.Fatalf("Failed to create recurring request: %v", err)
+	}
+	return rr
+}
+
+func TestNewRecurringRequest(t *testing.T) {
+	t.Run("should schedule the first occurrence", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Weekly access review", "0 9 * * mon")
+
+		if !rr.NextRunAt.Equal(utc(11, 24, 9, 0)) {
+			t.Errorf("Expected first run on Nov 24, got %v", rr.NextRunAt)
+		}
+	})
+
+	t.Run("should reject invalid recurring requests", func(t *testing.T) {
+		queue := &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue}
+
+		tests := []struct {
+			name      string
+			title     string
+			recipient *domain.RequestRecipient
+			schedule  string
+		}{
+			{"should require a title", " ", queue, "0 9 * * *"},
+			{"should require a recipient", "Review", nil, "0 9 * * *"},
+			{"should reject unknown placeholders", "Review for {{team}}", queue, "0 9 * * *"},
+			{"should reject invalid schedules", "Review", queue, "every monday"},
+			{"should reject schedules that never run", "Review", queue, "FREQ=DAILY;UNTIL=20250101"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := domain.NewRecurringRequest("rr-1", tt.title, "", tt.recipient, tt.schedule, "U1", utc(11, 17, 10, 0))
+				if err == nil {
+					t.Error("Expected an error")
+				}
+			})
+		}
+	})
+}
+
+func TestRecurringRequestPlaceholderValues(t *testing.T) {
+	t.Run("should describe the occurrence", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Access review {{quarter}}", "0 9 * * mon")
+
+		values := rr.PlaceholderValues(utc(11, 24, 9, 0))
+
+		want := map[string]string{
+			"date":    "2025-11-24",
+			"week":    "2025-W48",
+			"month":   "November 2025",
+			"quarter": "Q4 2025",
+			"year":    "2025",
+		}
+		for name, value := range want {
+			if values[name] != value {
+				t.Errorf("Expected %s to be %q, got %q", name, value, values[name])
+			}
+		}
+	})
+}
+
+func TestRecurringRequestAdvance(t *testing.T) {
+	t.Run("should skip missed occurrences", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Daily check", "0 9 * * *")
+
+		rr.Advance(utc(11, 21, 12, 0), nil)
+
+		if !rr.NextRunAt.Equal(utc(11, 22, 9, 0)) {
+			t.Errorf("Expected next run on Nov 22, got %v", rr.NextRunAt)
+		}
+		if !rr.LastRunAt.Equal(utc(11, 21, 12, 0)) {
+			t.Errorf("Expected last run to be recorded, got %v", rr.LastRunAt)
+		}
+	})
+
+	t.Run("should record the last error", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Daily check", "0 9 * * *")
+
+		rr.Advance(utc(11, 18, 9, 0), errors.New("queue not found"))
+		if rr.LastError != "queue not found" {
+			t.Errorf("Expected last error to be recorded, got %q", rr.LastError)
+		}
+
+		rr.Advance(utc(11, 19, 9, 0), nil)
+		if rr.LastError != "" {
+			t.Errorf("Expected last error to be cleared, got %q", rr.LastError)
+		}
+	})
+
+	t.Run("should finish when the schedule ends", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Daily check", "FREQ=DAILY;BYHOUR=9;UNTIL=20251119")
+
+		rr.Advance(utc(11, 19, 9, 0), nil)
+
+		if !rr.IsFinished() || rr.IsDue(utc(11, 30, 9, 0)) {
+			t.Error("Expected the recurring request to be finished")
+		}
+	})
+}
+
+func TestRecurringRequestPauseResume(t *testing.T) {
+	t.Run("should not be due while paused", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Daily check", "0 9 * * *")
+
+		if err := rr.Pause(utc(11, 17, 11, 0)); err != nil {
+			t.Fatalf("Failed to pause: %v", err)
+		}
+		if rr.IsDue(utc(11, 18, 9, 0)) {
+			t.Error("Expected a paused recurring request not to be due")
+		}
+		if err := rr.Pause(utc(11, 17, 12, 0)); err == nil {
+			t.Error("Expected an error pausing twice")
+		}
+	})
+
+	t.Run("should resume from now", func(t *testing.T) {
+		rr := newRecurringRequest(t, "Daily check", "0 9 * * *")
+		_ = rr.Pause(utc(11, 17, 11, 0))
+
+		if err := rr.Resume(utc(11, 20, 12, 0)); err != nil {
+			t.Fatalf("Failed to resume: %v", err)
+		}
+
+		if !rr.NextRunAt.Equal(utc(11, 21, 9, 0)) {
+			t.Errorf("Expected next run on Nov 21, got %v", rr.NextRunAt)
+		}
+		if err := rr.Resume(utc(11, 20, 13, 0)); err == nil {
+			t.Error("Expected an error resuming a running recurring request")
+		}
+	})
+}
+
+func TestRecurringRequestCanBeManagedBy(t *testing.T) {
+	rr := newRecurringRequest(t, "Daily check", "0 9 * * *")
+	queue := &domain.Queue{ID: "queue-1", AdminIds: []string{"U2"}}
+
+	tests := []struct {
+		name   string
+		userId string
+		queue  *domain.Queue
+		want   bool
+	}{
+		{"should allow the creator", "U1", nil, true},
+		{"should allow queue admins", "U2", queue, true},
+		{"should deny other users", "U3", queue, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rr.CanBeManagedBy(tt.userId, tt.queue); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	MaxReminderRules       = 3
+	ReminderSnoozeDuration = 24 * time.Hour
+)
+
+type ReminderCondition string
+
+const (
+	ReminderPending  ReminderCondition = "pending"
+	ReminderNoUpdate ReminderCondition = "no_update"
+)
+
+type ReminderTarget string
+
+const (
+	ReminderTargetChannel  ReminderTarget = "channel"
+	ReminderTargetAssignee ReminderTarget = "assignee"
+	ReminderTargetAdmins   ReminderTarget = "admins"
+)
+
+// ReminderRule nudges the target once a queue request has been pending, or
+// accepted without any update, for longer than After.
+type ReminderRule struct {
+	Condition ReminderCondition
+	After     time.Duration
+	Target    ReminderTarget
+}
+
+// SentReminder records that a rule fired for the condition that started at
+// Since, so the same nudge is never sent twice.
+type SentReminder struct {
+	RuleKey string
+	Since   time.Time
+	SentAt  time.Time
+}
+
+type DueReminder struct {
+	Rule  ReminderRule
+	Since time.Time
+}
+
+func NewReminderRule(condition ReminderCondition, after time.Duration, target ReminderTarget) (ReminderRule, error) {
+	switch condition {
+	case ReminderPending, ReminderNoUpdate:
+	default:
+		return ReminderRule{}, fmt.Errorf("unknown reminder condition %q", condition)
+	}
+
+	switch target {
+	case ReminderTargetChannel, ReminderTargetAssignee, ReminderTargetAdmins:
+	default:
+		return ReminderRule{}, fmt.Errorf("unknown reminder target %q", target)
+	}
+
+	if after < time.Hour {
+		return ReminderRule{}, errors.New("reminders must wait at least an hour")
+	}
+
+	if condition == ReminderPending && target == ReminderTargetAssignee {
+		return ReminderRule{}, errors.New("pending requests have no assignee to remind")
+	}
+
+	return ReminderRule{Condition: condition, After: after, Target: target}, nil
+}
+
+func (rule ReminderRule) Key() string {
+	return fmt.Sprintf("%s:%s:%d", rule.Condition, rule.Target, int64(rule.After/time.Second))
+}
+
+func (q *Queue) SetReminderRules(rules []ReminderRule) error {
+	if len(rules) > MaxReminderRules {
+		return fmt.Errorf("a queue can have at most %d reminder rules", MaxReminderRules)
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if seen[rule.Key()] {
+			return errors.New("reminder rules must be different from each other")
+		}
+		seen[rule.Key()] = true
+	}
+
+	q.ReminderRules = rules
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+// DueReminders returns the rules of this queue that should fire for the
+// request now. Reminders wait while the request is snoozed or the queue is
+// outside its business hours.
+func (q *Queue) DueReminders(r *Request, at time.Time) []DueReminder {
+	if len(q.ReminderRules) == 0 || r.RemindersSnoozed(at) || !q.IsOpenAt(at) {
+		return nil
+	}
+
+	due := []DueReminder{}
+	for _, rule := range q.ReminderRules {
+		since, ok := r.reminderSince(rule.Condition)
+		if !ok || at.Sub(since) < rule.After {
+			continue
+		}
+		if r.HasBeenReminded(rule, since) {
+			continue
+		}
+		due = append(due, DueReminder{Rule: rule, Since: since})
+	}
+	return due
+}
+
+func (r *Request) reminderSince(condition ReminderCondition) (time.Time, bool) {
+	switch {
+	case condition == ReminderPending && r.Status == RequestPending:
+		since := r.CreatedAt
+		for _, approval := range r.Approvals {
+			if approval.DecidedAt.After(since) {
+				since = approval.DecidedAt
+			}
+		}
+		return since, true
+
+	case condition == ReminderNoUpdate && r.Status == RequestAccepted:
+		since := r.UpdatedAt
+		if r.AcceptedAt.After(since) {
+			since = r.AcceptedAt
+		}
+		return since, true
+
+	default:
+		return time.Time{}, false
+	}
+}
+
+func (r *Request) HasBeenReminded(rule ReminderRule, since time.Time) bool {
+	for _, sent := range r.SentReminders {
+		if sent.RuleKey == rule.Key() && sent.Since.Equal(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordReminder replaces any earlier record of the rule, since only the
+// latest occurrence of a condition can fire again.
+func (r *Request) RecordReminder(rule ReminderRule, since, at time.Time) {
+	sent := []SentReminder{}
+	for _, existing := range r.SentReminders {
+		if existing.RuleKey != rule.Key() {
+			sent = append(sent, existing)
+		}
+	}
+	r.SentReminders = append(sent, SentReminder{RuleKey: rule.Key(), Since: since, SentAt: at})
+}
+
+func (r *Request) SnoozeReminders(until time.Time) error {
+	if !r.IsOpen() {
+		return errors.New("only open requests can be snoozed")
+	}
+
+	r.RemindersSnoozedUntil = until
+	return nil
+}
+
+func (r *Request) RemindersSnoozed(at time.Time) bool {
+	return at.Before(r.RemindersSnoozedUntil)
+}
@@ -0,0 +1,188 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newReminderQueue(t *testing.T, rules ...domain.ReminderRule) *domain.Queue {
+	t.Helper()
+
+	q := domain.NewQueue("queue-1", "Ops", "admin")
+	if err := q.SetReminderRules(rules); err != nil {
+		t.Fatalf("Failed to set reminder rules: %v", err)
+	}
+	return &q
+}
+
+func newReminderRule(t *testing.T, condition domain.ReminderCondition, after time.Duration, target domain.ReminderTarget) domain.ReminderRule {
+	t.Helper()
+
+	rule, err := domain.NewReminderRule(condition, after, target)
+	if err != nil {
+		t.Fatalf("Failed to create reminder rule: %v", err)
+	}
+	return rule
+}
+
+func TestNewReminderRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition domain.ReminderCondition
+		after     time.Duration
+		target    domain.ReminderTarget
+	}{
+		{"should reject unknown conditions", "stale", 24 * time.Hour, domain.ReminderTargetChannel},
+		{"should reject unknown targets", domain.ReminderPending, 24 * time.Hour, "everyone"},
+		{"should reject delays under an hour", domain.ReminderPending, 30 * time.Minute, domain.ReminderTargetChannel},
+		{"should reject reminding the assignee of pending requests", domain.ReminderPending, 24 * time.Hour, domain.ReminderTargetAssignee},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := domain.NewReminderRule(tt.condition, tt.after, tt.target); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestQueueSetReminderRules(t *testing.T) {
+	t.Run("should reject duplicate rules", func(t *testing.T) {
+		rule := newReminderRule(t, domain.ReminderPending, 24*time.Hour, domain.ReminderTargetChannel)
+		q := domain.NewQueue("queue-1", "Ops", "admin")
+
+		if err := q.SetReminderRules([]domain.ReminderRule{rule, rule}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("should limit the number of rules", func(t *testing.T) {
+		rules := []domain.ReminderRule{}
+		for i := 1; i <= domain.MaxReminderRules+1; i++ {
+			rules = append(rules, newReminderRule(t, domain.ReminderPending, time.Duration(i)*time.Hour, domain.ReminderTargetChannel))
+		}
+		q := domain.NewQueue("queue-1", "Ops", "admin")
+
+		if err := q.SetReminderRules(rules); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestQueueDueReminders(t *testing.T) {
+	createdAt := utc(11, 17, 9, 0)
+	pendingRule := func(t *testing.T) domain.ReminderRule {
+		return newReminderRule(t, domain.ReminderPending, 24*time.Hour, domain.ReminderTargetChannel)
+	}
+	staleRule := func(t *testing.T) domain.ReminderRule {
+		return newReminderRule(t, domain.ReminderNoUpdate, 72*time.Hour, domain.ReminderTargetAssignee)
+	}
+
+	t.Run("should remind about requests pending for too long", func(t *testing.T) {
+		q := newReminderQueue(t, pendingRule(t))
+		r := newSLARequest(domain.RequestPending, createdAt)
+
+		if due := q.DueReminders(r, utc(11, 18, 8, 59)); len(due) != 0 {
+			t.Errorf("Expected no reminders yet, got %+v", due)
+		}
+
+		due := q.DueReminders(r, utc(11, 18, 9, 0))
+		if len(due) != 1 || !due[0].Since.Equal(createdAt) {
+			t.Errorf("Expected one reminder since creation, got %+v", due)
+		}
+	})
+
+	t.Run("should count pending time from the last approval", func(t *testing.T) {
+		q := newReminderQueue(t, pendingRule(t))
+		r := newSLARequest(domain.RequestPending, createdAt)
+		r.Approvals = []domain.ApprovalDecision{{ApproverID: "manager", Approved: true, DecidedAt: utc(11, 18, 9, 0)}}
+
+		if due := q.DueReminders(r, utc(11, 18, 10, 0)); len(due) != 0 {
+			t.Errorf("Expected no reminders yet, got %+v", due)
+		}
+	})
+
+	t.Run("should remind about accepted requests without updates", func(t *testing.T) {
+		q := newReminderQueue(t, pendingRule(t), staleRule(t))
+		r := newSLARequest(domain.RequestAccepted, createdAt)
+		r.AcceptedByID = "responder"
+		r.AcceptedAt = utc(11, 17, 10, 0)
+		r.UpdatedAt = utc(11, 18, 10, 0)
+
+		if due := q.DueReminders(r, utc(11, 21, 9, 0)); len(due) != 0 {
+			t.Errorf("Expected no reminders yet, got %+v", due)
+		}
+
+		due := q.DueReminders(r, utc(11, 21, 10, 0))
+		if len(due) != 1 || due[0].Rule.Condition != domain.ReminderNoUpdate || !due[0].Since.Equal(r.UpdatedAt) {
+			t.Errorf("Expected one no-update reminder, got %+v", due)
+		}
+	})
+
+	t.Run("should send each reminder once per condition", func(t *testing.T) {
+		rule := staleRule(t)
+		q := newReminderQueue(t, rule)
+		r := newSLARequest(domain.RequestAccepted, createdAt)
+		r.AcceptedAt = createdAt
+		r.UpdatedAt = createdAt
+
+		due := q.DueReminders(r, utc(11, 21, 9, 0))
+		if len(due) != 1 {
+			t.Fatalf("Expected one reminder, got %+v", due)
+		}
+		r.RecordReminder(rule, due[0].Since, utc(11, 21, 9, 0))
+
+		if due := q.DueReminders(r, utc(11, 22, 9, 0)); len(due) != 0 {
+			t.Errorf("Expected no repeated reminder, got %+v", due)
+		}
+
+		r.UpdatedAt = utc(11, 21, 12, 0)
+		if due := q.DueReminders(r, utc(11, 24, 12, 0)); len(due) != 1 {
+			t.Errorf("Expected a new reminder after a later update, got %+v", due)
+		}
+		r.RecordReminder(rule, r.UpdatedAt, utc(11, 24, 12, 0))
+		if len(r.SentReminders) != 1 {
+			t.Errorf("Expected the older record to be replaced, got %+v", r.SentReminders)
+		}
+	})
+
+	t.Run("should hold reminders while snoozed", func(t *testing.T) {
+		q := newReminderQueue(t, pendingRule(t))
+		r := newSLARequest(domain.RequestPending, createdAt)
+
+		if err := r.SnoozeReminders(utc(11, 19, 9, 0)); err != nil {
+			t.Fatalf("Failed to snooze: %v", err)
+		}
+
+		if due := q.DueReminders(r, utc(11, 18, 12, 0)); len(due) != 0 {
+			t.Errorf("Expected no reminders while snoozed, got %+v", due)
+		}
+		if due := q.DueReminders(r, utc(11, 19, 9, 0)); len(due) != 1 {
+			t.Errorf("Expected the reminder after the snooze, got %+v", due)
+		}
+	})
+
+	t.Run("should wait for business hours", func(t *testing.T) {
+		q := newReminderQueue(t, pendingRule(t))
+		q.SetBusinessHours(newLondonCalendar(t))
+		r := newSLARequest(domain.RequestPending, london(t, 11, 21, 16, 0))
+
+		if due := q.DueReminders(r, london(t, 11, 22, 16, 0)); len(due) != 0 {
+			t.Errorf("Expected no reminders on a Saturday, got %+v", due)
+		}
+		if due := q.DueReminders(r, london(t, 11, 24, 9, 0)); len(due) != 1 {
+			t.Errorf("Expected the reminder on Monday morning, got %+v", due)
+		}
+	})
+
+	t.Run("should not snooze closed requests", func(t *testing.T) {
+		r := newSLARequest(domain.RequestCompleted, createdAt)
+
+		if err := r.SnoozeReminders(utc(11, 19, 9, 0)); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -14,6 +15,13 @@ const (
 	RequestAccepted  RequestStatus = "accepted"
 	RequestRejected  RequestStatus = "rejected"
 	RequestCompleted RequestStatus = "completed"
+	RequestOnHold    RequestStatus = "on_hold"
+	RequestDuplicate RequestStatus = "duplicate"
+	RequestExpired   RequestStatus = "expired"
+	RequestCancelled RequestStatus = "cancelled"
+
+	RequestAwaitingApproval RequestStatus = "awaiting_approval"
+	RequestDenied           RequestStatus = "denied"
 )
 const (
 	RequestRecipientUser    RequestRecipientType = "user"
@@ -23,13 +31,27 @@ const (
 
 func (rs RequestStatus) Valid() bool {
 	switch rs {
-	case RequestPending, RequestAccepted, RequestRejected, RequestCompleted:
+	case RequestPending, RequestAccepted, RequestRejected, RequestCompleted, RequestOnHold, RequestDuplicate,
+		RequestExpired, RequestCancelled, RequestAwaitingApproval, RequestDenied:
 		return true
 	default:
 		return false
 	}
 }
 
+func OpenRequestStatuses() []RequestStatus {
+	return []RequestStatus{RequestPending, RequestAccepted, RequestOnHold}
+}
+
+func (r *Request) IsOpen() bool {
+	for _, status := range OpenRequestStatuses() {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
 func (rt RequestRecipientType) Valid() bool {
 	switch rt {
 	case RequestRecipientUser, RequestRecipientChannel, RequestRecipientQueue:
@@ -52,16 +74,55 @@ func (r *RequestRecipient) Valid() bool {
 }
 
 type Request struct {
-	ID              string
-	Title           string
-	Description     string
-	AcceptedByID    string
-	CreatedByID     string
-	Recipient       *RequestRecipient
-	Status          RequestStatus
-	RejectionReason string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID                     string
+	Title                  string
+	Description            string
+	AcceptedByID           string
+	CollaboratorIDs        []string
+	DeclinedByIDs          []string
+	CreatedByID            string
+	Recipient              *RequestRecipient
+	Status                 RequestStatus
+	Workflow               *Workflow
+	WorkflowStatus         string
+	Labels                 []string
+	Fields                 []FieldValue
+	Checklist              []ChecklistItem
+	BlockedBy              []Blocker
+	WatcherIDs             []string
+	ApprovalChain          []ApprovalStage
+	ApprovalStage          int
+	Approvals              []ApprovalDecision
+	ApprovalMessages       []ApprovalMessage
+	ApprovalStageStartedAt time.Time
+	ApprovalEscalated      bool
+	DuplicateOfID          string
+	DuplicateOfTitle       string
+	RejectionReason        string
+	HoldQuestion           string
+	HoldReply              string
+	OnHoldSince            time.Time
+	OnHoldDuration         time.Duration
+	HoldPeriods            []HoldPeriod
+	HoldMessage            *MessageRef
+	Notification           *MessageRef
+	SLAState               SLAState
+	SLABreaches            []SLABreach
+	AcceptedAt             time.Time
+	ResolvedAt             time.Time
+	SentReminders          []SentReminder
+	RemindersSnoozedUntil  time.Time
+	ExpiryWarnedAt         time.Time
+	RoutedFromChannelID    string
+	RoutingReason          string
+	Transfers              []QueueTransfer
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+type MessageRef struct {
+	ChannelID string
+	Ts        string
 }
 
 func NewRequest(requestId string, title string, createdById string, recipient *RequestRecipient) (Request, error) {
@@ -90,24 +151,32 @@ func (r *Request) Accept(userId string) error {
 		return errors.New("request creator cannot accept their own request")
 	}
 
+	now := time.Now()
 	r.Status = RequestAccepted
 	r.AcceptedByID = userId
-	r.UpdatedAt = time.Now()
+	r.AcceptedAt = now
+	r.UpdatedAt = now
 	return nil
 }
 
 func (r *Request) Reject(reason string) error {
-	if r.Status != RequestPending && r.Status != RequestAccepted {
-		return errors.New("request can only be rejected when in pending or accepted status")
+	if r.Status != RequestPending && r.Status != RequestAccepted && r.Status != RequestOnHold {
+		return errors.New("request can only be rejected when in pending, accepted or on hold status")
 	}
 
 	if reason == "" {
 		return errors.New("rejection reason is required")
 	}
 
+	now := time.Now()
+	if r.Status == RequestOnHold {
+		r.endHold(now)
+	}
+
 	r.Status = RequestRejected
 	r.RejectionReason = reason
-	r.UpdatedAt = time.Now()
+	r.ResolvedAt = now
+	r.UpdatedAt = now
 	return nil
 }
 
@@ -116,41 +185,177 @@ func (r *Request) Complete() error {
 		return errors.New("request can only be completed when in accepted status")
 	}
 
+	if open := r.OpenRequiredItems(); len(open) > 0 {
+		return fmt.Errorf("request has %d required checklist item(s) still open", len(open))
+	}
+
+	now := time.Now()
 	r.Status = RequestCompleted
+	r.ResolvedAt = now
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) PutOnHold(question string) error {
+	if r.Status != RequestAccepted {
+		return errors.New("request can only be put on hold when in accepted status")
+	}
+
+	if question == "" {
+		return errors.New("a question for the requester is required")
+	}
+
+	now := time.Now()
+	r.Status = RequestOnHold
+	r.HoldQuestion = question
+	r.HoldReply = ""
+	r.HoldMessage = nil
+	r.OnHoldSince = now
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) Resume(reply string) error {
+	if r.Status != RequestOnHold {
+		return errors.New("request can only be resumed when in on hold status")
+	}
+
+	now := time.Now()
+	r.endHold(now)
+	r.Status = RequestAccepted
+	r.HoldReply = reply
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Request) endHold(at time.Time) {
+	if !r.OnHoldSince.IsZero() && at.After(r.OnHoldSince) {
+		r.OnHoldDuration += at.Sub(r.OnHoldSince)
+		r.HoldPeriods = append(r.HoldPeriods, HoldPeriod{Start: r.OnHoldSince, End: at})
+	}
+	r.OnHoldSince = time.Time{}
+}
+
+func (r *Request) TimeOnHold(at time.Time) time.Duration {
+	total := r.OnHoldDuration
+	if r.Status == RequestOnHold && !r.OnHoldSince.IsZero() && at.After(r.OnHoldSince) {
+		total += at.Sub(r.OnHoldSince)
+	}
+	return total
+}
+
+func (r *Request) ActiveDuration(at time.Time) time.Duration {
+	return at.Sub(r.CreatedAt) - r.TimeOnHold(at)
+}
+
+func (r *Request) SetLabels(labels []string, queue *Queue) error {
+	if len(labels) > 0 && (r.Recipient.Type != RequestRecipientQueue || queue == nil || queue.ID != r.Recipient.ID) {
+		return errors.New("labels can only be applied to requests in a queue")
+	}
+
+	applied := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = NormalizeLabel(label)
+		if seen[label] {
+			continue
+		}
+		if !queue.HasLabel(label) {
+			return fmt.Errorf("label %q is not defined on queue %s", label, queue.Name)
+		}
+		seen[label] = true
+		applied = append(applied, label)
+	}
+
+	r.Labels = applied
 	r.UpdatedAt = time.Now()
 	return nil
 }
 
-func (r *Request) CanBeRespondedToBy(userId string, queueAdminIds []string, queueMemberIds []string) bool {
-	if r.CreatedByID == userId {
+func (r *Request) HasLabel(label string) bool {
+	label = NormalizeLabel(label)
+	for _, existing := range r.Labels {
+		if existing == label {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Request) CanBeCompletedBy(userId string) bool {
+	if r.Status != RequestAccepted {
+		return false
+	}
+	return r.IsAssignee(userId) || r.CreatedByID == userId
+}
+
+func (r *Request) CanBePutOnHoldBy(userId string) bool {
+	if r.Status != RequestAccepted {
 		return false
 	}
+	return r.IsAssignee(userId)
+}
+
+func (r *Request) AddCollaborator(userId string) error {
+	if r.Status != RequestAccepted && r.Status != RequestOnHold {
+		return errors.New("collaborators can only join an accepted or on hold request")
+	}
+
+	if userId == "" {
+		return errors.New("collaborator user ID is required")
+	}
+
+	if r.CreatedByID == userId {
+		return errors.New("request creator cannot collaborate on their own request")
+	}
 
-	switch r.Recipient.Type {
-	case RequestRecipientUser:
-		return r.Recipient.ID == userId
-	case RequestRecipientQueue:
-		for _, adminId := range queueAdminIds {
-			if adminId == userId {
-				return true
-			}
+	if r.IsAssignee(userId) {
+		return errors.New("user is already working on this request")
+	}
+
+	r.CollaboratorIDs = append(r.CollaboratorIDs, userId)
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) RemoveCollaborator(userId string) error {
+	for i, collaboratorId := range r.CollaboratorIDs {
+		if collaboratorId == userId {
+			r.CollaboratorIDs = append(r.CollaboratorIDs[:i], r.CollaboratorIDs[i+1:]...)
+			r.UpdatedAt = time.Now()
+			return nil
 		}
-		for _, memberId := range queueMemberIds {
-			if memberId == userId {
-				return true
-			}
+	}
+
+	return errors.New("user is not a collaborator on this request")
+}
+
+func (r *Request) IsCollaborator(userId string) bool {
+	for _, collaboratorId := range r.CollaboratorIDs {
+		if collaboratorId == userId {
+			return true
 		}
-		return false
-	case RequestRecipientChannel:
-		return true
-	default:
+	}
+	return false
+}
+
+func (r *Request) IsAssignee(userId string) bool {
+	if userId == "" {
 		return false
 	}
+	return r.AcceptedByID == userId || r.IsCollaborator(userId)
 }
 
-func (r *Request) CanBeCompletedBy(userId string) bool {
-	if r.Status != RequestAccepted {
+func (r *Request) AssigneeIDs() []string {
+	if r.AcceptedByID == "" {
+		return append([]string{}, r.CollaboratorIDs...)
+	}
+	return append([]string{r.AcceptedByID}, r.CollaboratorIDs...)
+}
+
+func (r *Request) CanBeResumedBy(userId string) bool {
+	if r.Status != RequestOnHold {
 		return false
 	}
-	return r.AcceptedByID == userId || r.CreatedByID == userId
+	return r.CreatedByID == userId
 }
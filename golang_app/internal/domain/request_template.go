@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+type RequestTemplate struct {
+	Key           string
+	Name          string
+	Title         string
+	Description   string
+	RecipientType RequestRecipientType
+	RecipientID   string
+}
+
+func NewRequestTemplate(name, title, description string, recipient *RequestRecipient) (RequestTemplate, error) {
+	template := RequestTemplate{
+		Key:         TemplateKey(name),
+		Name:        strings.TrimSpace(name),
+		Title:       strings.TrimSpace(title),
+		Description: description,
+	}
+
+	if recipient != nil {
+		template.RecipientType = recipient.Type
+		template.RecipientID = recipient.ID
+	}
+
+	if err := template.validate(); err != nil {
+		return RequestTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func TemplateKey(name string) string {
+	return IntakeFieldKey(name)
+}
+
+func (t RequestTemplate) validate() error {
+	if t.Key == "" || t.Name == "" {
+		return errors.New("template name is required")
+	}
+
+	if t.Title == "" {
+		return errors.New("template title is required")
+	}
+
+	if t.RecipientType != "" && (!t.RecipientType.Valid() || t.RecipientID == "") {
+		return fmt.Errorf("template %q has an invalid recipient", t.Name)
+	}
+
+	return nil
+}
+
+func (t RequestTemplate) Recipient(queueId string) *RequestRecipient {
+	if t.RecipientType == "" {
+		return &RequestRecipient{ID: queueId, Type: RequestRecipientQueue}
+	}
+	return &RequestRecipient{ID: t.RecipientID, Type: t.RecipientType}
+}
+
+func (t RequestTemplate) Placeholders() []string {
+	return Placeholders(t.Title, t.Description)
+}
+
+func Placeholders(texts ...string) []string {
+	seen := map[string]bool{}
+	placeholders := []string{}
+
+	for _, text := range texts {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+			name := match[1]
+			if !seen[name] {
+				seen[name] = true
+				placeholders = append(placeholders, name)
+			}
+		}
+	}
+
+	return placeholders
+}
+
+func FillPlaceholders(text string, values map[string]string) (string, error) {
+	var missing []string
+
+	filled := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		value := strings.TrimSpace(values[name])
+		if value == "" {
+			missing = append(missing, name)
+			return match
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing values for: %s", strings.Join(missing, ", "))
+	}
+
+	return filled, nil
+}
+
+func (q *Queue) AddTemplate(template RequestTemplate) error {
+	if err := template.validate(); err != nil {
+		return err
+	}
+
+	if _, ok := q.Template(template.Key); ok {
+		return fmt.Errorf("template %q already exists on this queue", template.Name)
+	}
+
+	q.Templates = append(q.Templates, template)
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+func (q *Queue) RemoveTemplate(name string) error {
+	key := TemplateKey(name)
+	for i, template := range q.Templates {
+		if template.Key == key {
+			q.Templates = append(q.Templates[:i], q.Templates[i+1:]...)
+			q.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	return errors.New("template does not exist on this queue")
+}
+
+func (q *Queue) Template(name string) (*RequestTemplate, bool) {
+	key := TemplateKey(name)
+	for i := range q.Templates {
+		if q.Templates[i].Key == key {
+			return &q.Templates[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"testing"
+
+	"request/internal/domain"
+)
+
+func TestRequestTemplates(t *testing.T) {
+	t.Run("NewRequestTemplate", func(t *testing.T) {
+		t.Run("should require a name and title", func(t *testing.T) {
+			if _, err := domain.NewRequestTemplate(" ", "Title", "", nil); err == nil {
+				t.Error("Expected an error for a missing name")
+			}
+			if _, err := domain.NewRequestTemplate("Access", " ", "", nil); err == nil {
+				t.Error("Expected an error for a missing title")
+			}
+		})
+
+		t.Run("should default the recipient to the queue", func(t *testing.T) {
+			template, err := domain.NewRequestTemplate("Access", "Access to {{system}}", "", nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			recipient := template.Recipient("queue-1")
+			if recipient.Type != domain.RequestRecipientQueue || recipient.ID != "queue-1" {
+				t.Errorf("Expected the queue as recipient, got %+v", recipient)
+			}
+		})
+
+		t.Run("should keep an explicit recipient", func(t *testing.T) {
+			template, err := domain.NewRequestTemplate("Access", "Access", "", &domain.RequestRecipient{ID: "U1", Type: domain.RequestRecipientUser})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			recipient := template.Recipient("queue-1")
+			if recipient.Type != domain.RequestRecipientUser || recipient.ID != "U1" {
+				t.Errorf("Expected the user as recipient, got %+v", recipient)
+			}
+		})
+	})
+
+	t.Run("Placeholders", func(t *testing.T) {
+		t.Run("should list unique placeholders in order of appearance", func(t *testing.T) {
+			template, _ := domain.NewRequestTemplate("Access", "Access to {{system}} for {{ who }}", "Please grant {{who}} access to {{system}} by {{date}}", nil)
+
+			placeholders := template.Placeholders()
+			if len(placeholders) != 3 || placeholders[0] != "system" || placeholders[1] != "who" || placeholders[2] != "date" {
+				t.Errorf("Unexpected placeholders: %v", placeholders)
+			}
+		})
+	})
+
+	t.Run("FillPlaceholders", func(t *testing.T) {
+		t.Run("should substitute every placeholder", func(t *testing.T) {
+			filled, err := domain.FillPlaceholders("Access to {{system}} for {{ who }}", map[string]string{"system": "Payments", "who": "Sam"})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if filled != "Access to Payments for Sam" {
+				t.Errorf("Unexpected text: %q", filled)
+			}
+		})
+
+		t.Run("should reject missing values", func(t *testing.T) {
+			if _, err := domain.FillPlaceholders("Access to {{system}}", map[string]string{"system": " "}); err == nil {
+				t.Error("Expected an error for a blank value")
+			}
+		})
+	})
+
+	t.Run("Queue templates", func(t *testing.T) {
+		t.Run("should reject duplicate template names", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "IT", "admin")
+			template, _ := domain.NewRequestTemplate("Access", "Access", "", nil)
+
+			if err := q.AddTemplate(template); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if err := q.AddTemplate(template); err == nil {
+				t.Error("Expected an error for a duplicate template")
+			}
+		})
+
+		t.Run("should find and remove templates by name", func(t *testing.T) {
+			q := domain.NewQueue("queue-1", "IT", "admin")
+			template, _ := domain.NewRequestTemplate("New Laptop", "Laptop for {{who}}", "", nil)
+			q.AddTemplate(template)
+
+			if _, ok := q.Template("new laptop"); !ok {
+				t.Error("Expected to find the template by name")
+			}
+			if err := q.RemoveTemplate("New Laptop"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(q.Templates) != 0 {
+				t.Errorf("Expected no templates, got %d", len(q.Templates))
+			}
+		})
+	})
+}
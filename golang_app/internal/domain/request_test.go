@@ -0,0 +1,245 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newAcceptedRequest(t *testing.T) *domain.Request {
+	t.Helper()
+
+	r, err := domain.NewRequest("req-1", "Need access", "creator", &domain.RequestRecipient{
+		ID:   "recipient",
+		Type: domain.RequestRecipientUser,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if err := r.Accept("recipient"); err != nil {
+		t.Fatalf("Failed to accept request: %v", err)
+	}
+
+	return &r
+}
+
+func TestRequestHold(t *testing.T) {
+	t.Run("PutOnHold", func(t *testing.T) {
+		t.Run("should move an accepted request on hold with the question", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+
+			if err := r.PutOnHold("Which environment?"); err != nil {
+				t.Fatalf("Failed to put request on hold: %v", err)
+			}
+
+			if r.Status != domain.RequestOnHold {
+				t.Fatalf("Expected status %s, got %s", domain.RequestOnHold, r.Status)
+			}
+			if r.HoldQuestion != "Which environment?" {
+				t.Fatalf("Expected hold question to be recorded, got %q", r.HoldQuestion)
+			}
+			if r.OnHoldSince.IsZero() {
+				t.Fatalf("Expected OnHoldSince to be set")
+			}
+		})
+
+		t.Run("should require a question", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+
+			if err := r.PutOnHold(""); err == nil {
+				t.Fatalf("Expected an error when no question is supplied")
+			}
+		})
+
+		t.Run("should not allow a pending request to be put on hold", func(t *testing.T) {
+			r, _ := domain.NewRequest("req-2", "Pending", "creator", &domain.RequestRecipient{ID: "recipient", Type: domain.RequestRecipientUser})
+
+			if err := r.PutOnHold("Anything?"); err == nil {
+				t.Fatalf("Expected an error when putting a pending request on hold")
+			}
+		})
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		t.Run("should return the request to accepted and record the reply", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+			_ = r.PutOnHold("Which environment?")
+
+			if err := r.Resume("Production"); err != nil {
+				t.Fatalf("Failed to resume request: %v", err)
+			}
+
+			if r.Status != domain.RequestAccepted {
+				t.Fatalf("Expected status %s, got %s", domain.RequestAccepted, r.Status)
+			}
+			if r.HoldReply != "Production" {
+				t.Fatalf("Expected hold reply to be recorded, got %q", r.HoldReply)
+			}
+			if !r.OnHoldSince.IsZero() {
+				t.Fatalf("Expected OnHoldSince to be cleared")
+			}
+		})
+
+		t.Run("should fail when the request is not on hold", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+
+			if err := r.Resume("reply"); err == nil {
+				t.Fatalf("Expected an error when resuming a request that is not on hold")
+			}
+		})
+	})
+
+	t.Run("TimeOnHold", func(t *testing.T) {
+		t.Run("should accumulate completed holds and include the current one", func(t *testing.T) {
+			now := time.Now()
+			r := newAcceptedRequest(t)
+			r.CreatedAt = now.Add(-10 * time.Hour)
+			r.OnHoldDuration = 2 * time.Hour
+			r.Status = domain.RequestOnHold
+			r.OnHoldSince = now.Add(-3 * time.Hour)
+
+			if got := r.TimeOnHold(now); got != 5*time.Hour {
+				t.Fatalf("Expected 5h on hold, got %s", got)
+			}
+			if got := r.ActiveDuration(now); got != 5*time.Hour {
+				t.Fatalf("Expected 5h active, got %s", got)
+			}
+		})
+	})
+
+	t.Run("Authorization", func(t *testing.T) {
+		t.Run("should only allow the acceptor to put a request on hold", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+
+			if !r.CanBePutOnHoldBy("recipient") {
+				t.Fatalf("Expected acceptor to be able to put the request on hold")
+			}
+			if r.CanBePutOnHoldBy("creator") {
+				t.Fatalf("Expected creator not to be able to put the request on hold")
+			}
+		})
+
+		t.Run("should only allow the creator to resume a request", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+			_ = r.PutOnHold("Which environment?")
+
+			if !r.CanBeResumedBy("creator") {
+				t.Fatalf("Expected creator to be able to resume the request")
+			}
+			if r.CanBeResumedBy("recipient") {
+				t.Fatalf("Expected acceptor not to be able to resume the request")
+			}
+		})
+	})
+}
+
+func TestRequestCollaborators(t *testing.T) {
+	t.Run("AddCollaborator", func(t *testing.T) {
+		t.Run("should add a collaborator to an accepted request", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+
+			if err := r.AddCollaborator("helper"); err != nil {
+				t.Fatalf("Failed to add collaborator: %v", err)
+			}
+
+			if !r.IsCollaborator("helper") || !r.IsAssignee("helper") {
+				t.Fatalf("Expected helper to be a collaborator and assignee")
+			}
+		})
+
+		t.Run("should not add the acceptor, the creator or an existing collaborator", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+			_ = r.AddCollaborator("helper")
+
+			for _, userId := range []string{"recipient", "creator", "helper"} {
+				if err := r.AddCollaborator(userId); err == nil {
+					t.Fatalf("Expected an error when adding %s as a collaborator", userId)
+				}
+			}
+		})
+
+		t.Run("should not add a collaborator to a pending request", func(t *testing.T) {
+			r, _ := domain.NewRequest("req-2", "Pending", "creator", &domain.RequestRecipient{ID: "recipient", Type: domain.RequestRecipientUser})
+
+			if err := r.AddCollaborator("helper"); err == nil {
+				t.Fatalf("Expected an error when adding a collaborator to a pending request")
+			}
+		})
+	})
+
+	t.Run("CanBeCompletedBy", func(t *testing.T) {
+		t.Run("should allow collaborators to complete the request", func(t *testing.T) {
+			r := newAcceptedRequest(t)
+			_ = r.AddCollaborator("helper")
+
+			if !r.CanBeCompletedBy("helper") {
+				t.Fatalf("Expected collaborator to be able to complete the request")
+			}
+			if r.CanBeCompletedBy("stranger") {
+				t.Fatalf("Expected a stranger not to be able to complete the request")
+			}
+		})
+	})
+
+	t.Run("CanJoin", func(t *testing.T) {
+		t.Run("should allow queue members but not outsiders to join", func(t *testing.T) {
+			r, _ := domain.NewRequest("req-3", "Queue request", "creator", &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue})
+			_ = r.Accept("member-1")
+			q := domain.NewQueue("queue-1", "Queue", "admin")
+			_ = q.AddMember("member-1")
+			_ = q.AddMember("member-2")
+
+			if !domain.NewAuthorizationContext(&r, &q, "member-2").CanJoin() {
+				t.Fatalf("Expected a queue member to be able to join")
+			}
+			if domain.NewAuthorizationContext(&r, &q, "member-1").CanJoin() {
+				t.Fatalf("Expected the acceptor not to be able to join")
+			}
+			if domain.NewAuthorizationContext(&r, &q, "outsider").CanJoin() {
+				t.Fatalf("Expected an outsider not to be able to join")
+			}
+		})
+	})
+}
+
+func TestRequestLabels(t *testing.T) {
+	newQueueRequest := func(t *testing.T) (*domain.Request, *domain.Queue) {
+		t.Helper()
+		q := domain.NewQueue("queue-1", "Platform", "admin")
+		_ = q.AddLabel("Access")
+		_ = q.AddLabel("bug")
+		r, _ := domain.NewRequest("req-1", "Need access", "creator", &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue})
+		return &r, &q
+	}
+
+	t.Run("should apply normalised labels defined on the queue", func(t *testing.T) {
+		r, q := newQueueRequest(t)
+
+		if err := r.SetLabels([]string{" ACCESS ", "bug", "access"}, q); err != nil {
+			t.Fatalf("Failed to set labels: %v", err)
+		}
+
+		if len(r.Labels) != 2 || !r.HasLabel("access") || !r.HasLabel("bug") {
+			t.Fatalf("Expected labels [access bug], got %v", r.Labels)
+		}
+	})
+
+	t.Run("should reject labels that the queue does not define", func(t *testing.T) {
+		r, q := newQueueRequest(t)
+
+		if err := r.SetLabels([]string{"hardware"}, q); err == nil {
+			t.Fatalf("Expected an error when applying an undefined label")
+		}
+	})
+
+	t.Run("should reject labels on requests that are not in the queue", func(t *testing.T) {
+		_, q := newQueueRequest(t)
+		r, _ := domain.NewRequest("req-2", "Direct", "creator", &domain.RequestRecipient{ID: "user", Type: domain.RequestRecipientUser})
+
+		if err := r.SetLabels([]string{"bug"}, q); err == nil {
+			t.Fatalf("Expected an error when labelling a request outside the queue")
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const MaxRoutingRules = 5
+
+// RoutingRule moves requests sent to ChannelID into the queue that owns the rule.
+type RoutingRule struct {
+	ChannelID string
+	Match     string
+	UserGroup string
+}
+
+func NewRoutingRule(channelId, match, userGroup string) (RoutingRule, error) {
+	if channelId == "" {
+		return RoutingRule{}, errors.New("routing rules need a channel")
+	}
+
+	rule := RoutingRule{
+		ChannelID: channelId,
+		Match:     strings.TrimSpace(match),
+		UserGroup: strings.TrimPrefix(strings.TrimSpace(userGroup), "@"),
+	}
+
+	if _, err := rule.pattern(); err != nil {
+		return RoutingRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (q *Queue) SetRoutingRules(rules []RoutingRule) error {
+	if len(rules) > MaxRoutingRules {
+		return fmt.Errorf("a queue can have at most %d routing rules", MaxRoutingRules)
+	}
+
+	for _, rule := range rules {
+		if rule.ChannelID == q.ChannelId {
+			return errors.New("a queue can't route requests from its own channel")
+		}
+	}
+
+	q.RoutingRules = rules
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+// pattern compiles Match case-insensitively; keywords only match whole words.
+func (rule RoutingRule) pattern() (*regexp.Regexp, error) {
+	if rule.Match == "" {
+		return nil, nil
+	}
+
+	if expr, ok := strings.CutPrefix(rule.Match, "/"); ok && strings.HasSuffix(expr, "/") && len(expr) > 1 {
+		pattern, err := regexp.Compile("(?i)" + strings.TrimSuffix(expr, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid routing pattern %s: %w", rule.Match, err)
+		}
+		return pattern, nil
+	}
+
+	keywords := []string{}
+	for _, keyword := range strings.Split(rule.Match, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, regexp.QuoteMeta(keyword))
+		}
+	}
+	if len(keywords) == 0 {
+		return nil, fmt.Errorf("invalid routing keywords %q", rule.Match)
+	}
+
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(keywords, "|") + `)\b`), nil
+}
+
+// RoutingCandidate is a request on its way to a channel.
+type RoutingCandidate struct {
+	ChannelID   string
+	CreatorID   string
+	Title       string
+	Description string
+}
+
+type RoutingMatch struct {
+	Queue  *Queue
+	Rule   RoutingRule
+	Reason string
+}
+
+// Matches reports whether the rule applies to the candidate, and why.
+func (rule RoutingRule) Matches(candidate RoutingCandidate, inGroup func(userGroup string) bool) (string, bool) {
+	if rule.ChannelID != candidate.ChannelID {
+		return "", false
+	}
+
+	reasons := []string{fmt.Sprintf("it was sent to <#%s>", rule.ChannelID)}
+
+	pattern, err := rule.pattern()
+	if err != nil {
+		return "", false
+	}
+	if pattern != nil {
+		found := pattern.FindString(candidate.Title)
+		if found == "" {
+			found = pattern.FindString(candidate.Description)
+		}
+		if found == "" {
+			return "", false
+		}
+		reasons = append(reasons, fmt.Sprintf("mentions \"%s\"", found))
+	}
+
+	if rule.UserGroup != "" {
+		if !inGroup(rule.UserGroup) {
+			return "", false
+		}
+		reasons = append(reasons, fmt.Sprintf("the requester is in %s", userGroupText(rule.UserGroup)))
+	}
+
+	return joinReasons(reasons), true
+}
+
+// Route picks the first matching rule, oldest queue first, skipping queues with required intake fields.
+func Route(queues []*Queue, candidate RoutingCandidate, inGroup func(userGroup string) bool) (RoutingMatch, bool) {
+	for _, queue := range routingOrder(queues) {
+		if queue.hasRequiredIntakeFields() {
+			continue
+		}
+
+		for _, rule := range queue.RoutingRules {
+			if reason, ok := rule.Matches(candidate, inGroup); ok {
+				return RoutingMatch{Queue: queue, Rule: rule, Reason: reason}, true
+			}
+		}
+	}
+	return RoutingMatch{}, false
+}
+
+// RoutingOverlap is another queue routing requests from the same channel.
+type RoutingOverlap struct {
+	ChannelID  string
+	Queue      *Queue
+	TriedFirst bool
+}
+
+func (q *Queue) RoutingOverlaps(queues []*Queue) []RoutingOverlap {
+	overlaps := []RoutingOverlap{}
+	seen := map[string]bool{}
+	for _, rule := range q.RoutingRules {
+		if seen[rule.ChannelID] {
+			continue
+		}
+		seen[rule.ChannelID] = true
+
+		for _, other := range routingOrder(queues) {
+			if other.ID == q.ID || other.hasRequiredIntakeFields() || !other.routesFrom(rule.ChannelID) {
+				continue
+			}
+			overlaps = append(overlaps, RoutingOverlap{ChannelID: rule.ChannelID, Queue: other, TriedFirst: routesBefore(other, q)})
+		}
+	}
+	return overlaps
+}
+
+func (q *Queue) routesFrom(channelId string) bool {
+	for _, rule := range q.RoutingRules {
+		if rule.ChannelID == channelId {
+			return true
+		}
+	}
+	return false
+}
+
+func routingOrder(queues []*Queue) []*Queue {
+	ordered := append([]*Queue{}, queues...)
+	sort.SliceStable(ordered, func(i, j int) bool { return routesBefore(ordered[i], ordered[j]) })
+	return ordered
+}
+
+func routesBefore(a, b *Queue) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
+
+// RouteTo turns a channel request into a request for the matched queue.
+func (r *Request) RouteTo(match RoutingMatch) error {
+	if r.Recipient == nil || r.Recipient.Type != RequestRecipientChannel {
+		return errors.New("only channel requests can be routed")
+	}
+
+	r.RoutedFromChannelID = r.Recipient.ID
+	r.RoutingReason = match.Reason
+	r.Recipient = &RequestRecipient{ID: match.Queue.ID, Type: RequestRecipientQueue}
+	return nil
+}
+
+func (q *Queue) hasRequiredIntakeFields() bool {
+	for _, field := range q.IntakeFields {
+		if field.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func userGroupText(userGroup string) string {
+	if strings.HasPrefix(userGroup, "S") && strings.ToUpper(userGroup) == userGroup {
+		return fmt.Sprintf("<!subteam^%s>", userGroup)
+	}
+	return "@" + userGroup
+}
+
+func joinReasons(reasons []string) string {
+	if len(reasons) == 1 {
+		return reasons[0]
+	}
+	return strings.Join(reasons[:len(reasons)-1], ", ") + " and " + reasons[len(reasons)-1]
+}
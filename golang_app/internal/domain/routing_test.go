@@ -0,0 +1,230 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newRoutingQueue(t *testing.T, id string, rules ...domain.RoutingRule) *domain.Queue {
+	t.Helper()
+
+	q := domain.NewQueue(id, "Queue "+id, "admin")
+	if err := q.SetRoutingRules(rules); err != nil {
+		t.Fatalf("Failed to set routing rules: %v", err)
+	}
+	return &q
+}
+
+func newRoutingRule(t *testing.T, channelId, match, userGroup string) domain.RoutingRule {
+	t.Helper()
+
+	rule, err := domain.NewRoutingRule(channelId, match, userGroup)
+	if err != nil {
+		t.Fatalf("Failed to create routing rule: %v", err)
+	}
+	return rule
+}
+
+func noGroups(string) bool { return false }
+
+func TestNewRoutingRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel string
+		match   string
+		wantErr bool
+	}{
+		{"should accept keywords", "C1", "deploy, release", false},
+		{"should accept a regular expression", "C1", `/db-\d+/`, false},
+		{"should accept a rule without conditions", "C1", "", false},
+		{"should reject an invalid regular expression", "C1", "/db-(/", true},
+		{"should reject keywords that are only commas", "C1", " , ", true},
+		{"should reject a rule without a channel", "", "deploy", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewRoutingRule(tt.channel, tt.match, "")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("should strip the @ from user group handles", func(t *testing.T) {
+		rule := newRoutingRule(t, "C1", "", " @platform ")
+		if rule.UserGroup != "platform" {
+			t.Errorf("Expected platform, got %q", rule.UserGroup)
+		}
+	})
+}
+
+func TestSetRoutingRules(t *testing.T) {
+	t.Run("should reject rules for the queue's own channel", func(t *testing.T) {
+		q := domain.NewQueue("queue-1", "Support", "admin")
+		q.ChannelId = "C1"
+
+		if err := q.SetRoutingRules([]domain.RoutingRule{newRoutingRule(t, "C1", "", "")}); err == nil {
+			t.Error("Expected an error for the queue's own channel")
+		}
+	})
+
+	t.Run("should reject too many rules", func(t *testing.T) {
+		q := domain.NewQueue("queue-1", "Support", "admin")
+		rules := make([]domain.RoutingRule, domain.MaxRoutingRules+1)
+		for i := range rules {
+			rules[i] = newRoutingRule(t, "C1", "", "")
+		}
+
+		if err := q.SetRoutingRules(rules); err == nil {
+			t.Error("Expected an error for too many rules")
+		}
+	})
+}
+
+func TestRoutingRuleMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		match     string
+		candidate domain.RoutingCandidate
+		want      bool
+	}{
+		{"should match a keyword in the title", "deploy, release", domain.RoutingCandidate{ChannelID: "C1", Title: "Deploy is stuck"}, true},
+		{"should match a keyword in the description", "deploy", domain.RoutingCandidate{ChannelID: "C1", Title: "Help", Description: "the deploy failed"}, true},
+		{"should only match whole words", "db", domain.RoutingCandidate{ChannelID: "C1", Title: "Some feedback"}, false},
+		{"should match a regular expression", `/db-\d+/`, domain.RoutingCandidate{ChannelID: "C1", Title: "DB-42 is down"}, true},
+		{"should not match other channels", "deploy", domain.RoutingCandidate{ChannelID: "C2", Title: "Deploy is stuck"}, false},
+		{"should match everything in the channel without conditions", "", domain.RoutingCandidate{ChannelID: "C1", Title: "Anything"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := newRoutingRule(t, "C1", tt.match, "").Matches(tt.candidate, noGroups)
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("should require the requester to be in the user group", func(t *testing.T) {
+		rule := newRoutingRule(t, "C1", "deploy", "platform")
+		candidate := domain.RoutingCandidate{ChannelID: "C1", CreatorID: "alice", Title: "Deploy is stuck"}
+
+		if _, ok := rule.Matches(candidate, noGroups); ok {
+			t.Error("Expected no match outside the user group")
+		}
+
+		reason, ok := rule.Matches(candidate, func(userGroup string) bool { return userGroup == "platform" })
+		if !ok {
+			t.Fatal("Expected a match inside the user group")
+		}
+		if want := `it was sent to <#C1>, mentions "Deploy" and the requester is in @platform`; reason != want {
+			t.Errorf("Expected reason %q, got %q", want, reason)
+		}
+	})
+}
+
+func TestRoute(t *testing.T) {
+	candidate := domain.RoutingCandidate{ChannelID: "C1", Title: "Deploy is stuck"}
+
+	t.Run("should pick the first matching queue", func(t *testing.T) {
+		queues := []*domain.Queue{
+			newRoutingQueue(t, "queue-1", newRoutingRule(t, "C1", "billing", "")),
+			newRoutingQueue(t, "queue-2", newRoutingRule(t, "C1", "deploy", "")),
+			newRoutingQueue(t, "queue-3", newRoutingRule(t, "C1", "", "")),
+		}
+
+		match, ok := domain.Route(queues, candidate, noGroups)
+		if !ok || match.Queue.ID != "queue-2" {
+			t.Errorf("Expected queue-2, got %+v %v", match.Queue, ok)
+		}
+	})
+
+	t.Run("should try older queues first whatever order they are listed in", func(t *testing.T) {
+		older := newRoutingQueue(t, "queue-2", newRoutingRule(t, "C1", "deploy", ""))
+		older.CreatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		newer := newRoutingQueue(t, "queue-1", newRoutingRule(t, "C1", "", ""))
+		newer.CreatedAt = older.CreatedAt.Add(time.Hour)
+
+		match, ok := domain.Route([]*domain.Queue{newer, older}, candidate, noGroups)
+		if !ok || match.Queue.ID != "queue-2" {
+			t.Errorf("Expected the older queue-2, got %+v %v", match.Queue, ok)
+		}
+	})
+
+	t.Run("should skip queues with required intake fields", func(t *testing.T) {
+		strict := newRoutingQueue(t, "queue-1", newRoutingRule(t, "C1", "deploy", ""))
+		strict.IntakeFields = []domain.IntakeField{{Label: "Service", Type: domain.IntakeFieldText, Required: true}}
+
+		if _, ok := domain.Route([]*domain.Queue{strict}, candidate, noGroups); ok {
+			t.Error("Expected no match for a queue with required fields")
+		}
+	})
+
+	t.Run("should not route when nothing matches", func(t *testing.T) {
+		queues := []*domain.Queue{newRoutingQueue(t, "queue-1", newRoutingRule(t, "C2", "", ""))}
+
+		if _, ok := domain.Route(queues, candidate, noGroups); ok {
+			t.Error("Expected no match")
+		}
+	})
+}
+
+func TestRoutingOverlaps(t *testing.T) {
+	older := newRoutingQueue(t, "queue-1", newRoutingRule(t, "C1", "billing", ""))
+	older.CreatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	queue := newRoutingQueue(t, "queue-2", newRoutingRule(t, "C1", "deploy", ""), newRoutingRule(t, "C1", "release", ""), newRoutingRule(t, "C2", "", ""))
+	queue.CreatedAt = older.CreatedAt.Add(time.Hour)
+	newer := newRoutingQueue(t, "queue-3", newRoutingRule(t, "C2", "", ""))
+	newer.CreatedAt = queue.CreatedAt.Add(time.Hour)
+	unrelated := newRoutingQueue(t, "queue-4", newRoutingRule(t, "C3", "", ""))
+
+	overlaps := queue.RoutingOverlaps([]*domain.Queue{newer, unrelated, queue, older})
+
+	want := []struct {
+		channelId  string
+		queueId    string
+		triedFirst bool
+	}{
+		{"C1", "queue-1", true},
+		{"C2", "queue-3", false},
+	}
+	if len(overlaps) != len(want) {
+		t.Fatalf("Expected %d overlaps, got %+v", len(want), overlaps)
+	}
+	for i, w := range want {
+		if got := overlaps[i]; got.ChannelID != w.channelId || got.Queue.ID != w.queueId || got.TriedFirst != w.triedFirst {
+			t.Errorf("Expected %+v, got %s %s %v", w, got.ChannelID, got.Queue.ID, got.TriedFirst)
+		}
+	}
+}
+
+func TestRequestRouteTo(t *testing.T) {
+	t.Run("should turn a channel request into a queue request", func(t *testing.T) {
+		r, err := domain.NewRequest("req-1", "Deploy is stuck", "alice", &domain.RequestRecipient{ID: "C1", Type: domain.RequestRecipientChannel})
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		queue := newRoutingQueue(t, "queue-1")
+		if err := r.RouteTo(domain.RoutingMatch{Queue: queue, Reason: "it was sent to <#C1>"}); err != nil {
+			t.Fatalf("Failed to route request: %v", err)
+		}
+
+		if r.Recipient.Type != domain.RequestRecipientQueue || r.Recipient.ID != "queue-1" {
+			t.Errorf("Expected the queue as recipient, got %+v", r.Recipient)
+		}
+		if r.RoutedFromChannelID != "C1" || !strings.Contains(r.RoutingReason, "<#C1>") {
+			t.Errorf("Expected the routing to be recorded, got %q %q", r.RoutedFromChannelID, r.RoutingReason)
+		}
+	})
+
+	t.Run("should only route channel requests", func(t *testing.T) {
+		if err := newQueuedRequest(t, "alice").RouteTo(domain.RoutingMatch{Queue: newRoutingQueue(t, "queue-2")}); err == nil {
+			t.Error("Expected an error for a queue request")
+		}
+	})
+}
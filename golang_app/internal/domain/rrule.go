@@ -0,0 +1,404 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a cron schedule or an iCalendar recurrence rule.
+type Recurrence interface {
+	Next(after time.Time) time.Time
+	Location() *time.Location
+}
+
+// ParseRecurrence reads an RRULE or a cron expression; rules without a DTSTART begin at start.
+func ParseRecurrence(expression string, start time.Time) (Recurrence, error) {
+	upper := strings.ToUpper(strings.TrimSpace(expression))
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") || strings.HasPrefix(upper, "DTSTART") {
+		return ParseRecurrenceRule(expression, start)
+	}
+	return ParseCronSchedule(expression)
+}
+
+type RecurrenceFrequency string
+
+const (
+	FrequencyDaily   RecurrenceFrequency = "DAILY"
+	FrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	FrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	FrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+type ruleWeekday struct {
+	Weekday time.Weekday
+	// N is the nth such weekday of the month (or year), counted from the end
+	// when negative. Zero means every such weekday.
+	N int
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// RecurrenceRule supports FREQ, INTERVAL, UNTIL, BYMONTH, BYMONTHDAY, BYDAY, BYHOUR and BYMINUTE.
+type RecurrenceRule struct {
+	Expression string
+	frequency  RecurrenceFrequency
+	interval   int
+	start      time.Time
+	until      time.Time
+	location   *time.Location
+	months     []int
+	monthDays  []int
+	weekdays   []ruleWeekday
+	hours      []int
+	minutes    []int
+}
+
+func ParseRecurrenceRule(expression string, start time.Time) (*RecurrenceRule, error) {
+	rule := &RecurrenceRule{
+		Expression: strings.TrimSpace(expression),
+		interval:   1,
+		location:   time.UTC,
+	}
+
+	var ruleSpec string
+	var dtstart string
+	for _, part := range strings.Fields(rule.Expression) {
+		upper := strings.ToUpper(part)
+		switch {
+		case strings.HasPrefix(upper, "DTSTART"):
+			dtstart = part
+		case strings.HasPrefix(upper, "RRULE:"):
+			ruleSpec = part[len("RRULE:"):]
+		case strings.HasPrefix(upper, "FREQ="):
+			ruleSpec = part
+		default:
+			return nil, fmt.Errorf("unexpected %q in recurrence rule", part)
+		}
+	}
+
+	if ruleSpec == "" {
+		return nil, errors.New("recurrence rule needs an RRULE with a FREQ")
+	}
+
+	if dtstart != "" {
+		if err := rule.parseStart(dtstart); err != nil {
+			return nil, err
+		}
+	} else {
+		rule.start = start.UTC().Truncate(time.Minute)
+	}
+
+	if err := rule.parseRule(ruleSpec); err != nil {
+		return nil, err
+	}
+
+	if len(rule.hours) == 0 {
+		rule.hours = []int{rule.start.Hour()}
+	}
+	if len(rule.minutes) == 0 {
+		// A rule that picks its hours runs on the hour unless it says
+		// otherwise, rather than at the minute it happened to be created.
+		rule.minutes = []int{rule.start.Minute()}
+		if dtstart == "" && strings.Contains(strings.ToUpper(ruleSpec), "BYHOUR=") {
+			rule.minutes = []int{0}
+		}
+	}
+
+	return rule, nil
+}
+
+func (r *RecurrenceRule) parseStart(dtstart string) error {
+	params, value, ok := strings.Cut(dtstart, ":")
+	if !ok {
+		return fmt.Errorf("invalid DTSTART %q", dtstart)
+	}
+
+	for _, param := range strings.Split(params, ";")[1:] {
+		name, paramValue, _ := strings.Cut(param, "=")
+		if strings.EqualFold(name, "TZID") {
+			loc, err := time.LoadLocation(paramValue)
+			if err != nil {
+				return fmt.Errorf("unknown time zone %q", paramValue)
+			}
+			r.location = loc
+		}
+	}
+
+	start, err := parseRuleTime(value, r.location)
+	if err != nil {
+		return fmt.Errorf("invalid DTSTART %q", value)
+	}
+	r.start = start.Truncate(time.Minute)
+	return nil
+}
+
+func parseRuleTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.ToUpper(value)
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	if len(value) == len("20060102") {
+		return time.ParseInLocation("20060102", value, loc)
+	}
+	return time.ParseInLocation("20060102T150405", value, loc)
+}
+
+func (r *RecurrenceRule) parseRule(spec string) error {
+	for _, part := range strings.Split(spec, ";") {
+		if part == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.frequency = RecurrenceFrequency(strings.ToUpper(value))
+			switch r.frequency {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+			default:
+				return fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err != nil || r.interval < 1 {
+				return fmt.Errorf("invalid INTERVAL %q", value)
+			}
+		case "UNTIL":
+			r.until, err = parseRuleTime(value, r.location)
+			if err != nil {
+				return fmt.Errorf("invalid UNTIL %q", value)
+			}
+			if len(value) == len("20060102") {
+				r.until = r.until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		case "BYMONTH":
+			r.months, err = parseRuleNumbers(value, 1, 12, false)
+		case "BYMONTHDAY":
+			r.monthDays, err = parseRuleNumbers(value, 1, 31, true)
+		case "BYHOUR":
+			r.hours, err = parseRuleNumbers(value, 0, 23, false)
+		case "BYMINUTE":
+			r.minutes, err = parseRuleNumbers(value, 0, 59, false)
+		case "BYDAY":
+			r.weekdays, err = parseRuleWeekdays(value)
+		case "WKST":
+			// Weeks always start on Monday.
+		case "COUNT":
+			return errors.New("COUNT is not supported, use UNTIL instead")
+		default:
+			return fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", strings.ToUpper(name), err)
+		}
+	}
+
+	if r.frequency == "" {
+		return errors.New("recurrence rule needs a FREQ")
+	}
+
+	if r.frequency == FrequencyDaily || r.frequency == FrequencyWeekly {
+		for _, weekday := range r.weekdays {
+			if weekday.N != 0 {
+				return fmt.Errorf("numbered weekdays need a MONTHLY or YEARLY frequency")
+			}
+		}
+	}
+
+	sort.Ints(r.hours)
+	sort.Ints(r.minutes)
+	return nil
+}
+
+func parseRuleNumbers(value string, min, max int, allowNegative bool) ([]int, error) {
+	numbers := []int{}
+	for _, part := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", part)
+		}
+		if allowNegative && n < 0 {
+			n = -n
+			if n < min || n > max {
+				return nil, fmt.Errorf("%q is out of range", part)
+			}
+			numbers = append(numbers, -n)
+			continue
+		}
+		if n < min || n > max {
+			return nil, fmt.Errorf("%q is out of range", part)
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, nil
+}
+
+func parseRuleWeekdays(value string) ([]ruleWeekday, error) {
+	weekdays := []ruleWeekday{}
+	for _, part := range strings.Split(strings.ToUpper(value), ",") {
+		if len(part) < 2 {
+			return nil, fmt.Errorf("%q is not a weekday", part)
+		}
+
+		weekday, ok := rruleWeekdays[part[len(part)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("%q is not a weekday", part)
+		}
+
+		n := 0
+		if prefix := part[:len(part)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("%q is not a weekday", part)
+			}
+		}
+		weekdays = append(weekdays, ruleWeekday{Weekday: weekday, N: n})
+	}
+	return weekdays, nil
+}
+
+func (r *RecurrenceRule) Location() *time.Location {
+	return r.location
+}
+
+// Next returns the first occurrence after the given time, or the zero time once the rule has ended.
+func (r *RecurrenceRule) Next(after time.Time) time.Time {
+	from := after.In(r.location)
+	if from.Before(r.start) {
+		from = r.start.Add(-time.Nanosecond).In(r.location)
+	}
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, r.location)
+	for i := 0; i <= 5*366; i++ {
+		date := day.AddDate(0, 0, i)
+		if !r.matchesDay(date) {
+			continue
+		}
+
+		for _, hour := range r.hours {
+			for _, minute := range r.minutes {
+				candidate := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, r.location)
+				if !candidate.After(from) {
+					continue
+				}
+				if !r.until.IsZero() && candidate.After(r.until) {
+					return time.Time{}
+				}
+				return candidate
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+func (r *RecurrenceRule) matchesDay(date time.Time) bool {
+	start := r.start.In(r.location)
+
+	switch r.frequency {
+	case FrequencyDaily:
+		if civilDaysBetween(start, date)%r.interval != 0 {
+			return false
+		}
+	case FrequencyWeekly:
+		if civilDaysBetween(startOfWeek(start), startOfWeek(date))/7%r.interval != 0 {
+			return false
+		}
+	case FrequencyMonthly:
+		months := (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+	case FrequencyYearly:
+		if (date.Year()-start.Year())%r.interval != 0 {
+			return false
+		}
+	}
+
+	if len(r.months) > 0 && !slices.Contains(r.months, int(date.Month())) {
+		return false
+	}
+
+	if len(r.monthDays) == 0 && len(r.weekdays) == 0 {
+		switch r.frequency {
+		case FrequencyWeekly:
+			return date.Weekday() == start.Weekday()
+		case FrequencyMonthly:
+			return date.Day() == start.Day()
+		case FrequencyYearly:
+			return (len(r.months) > 0 || date.Month() == start.Month()) && date.Day() == start.Day()
+		default:
+			return true
+		}
+	}
+
+	if len(r.monthDays) > 0 && !r.matchesMonthDay(date) {
+		return false
+	}
+	if len(r.weekdays) > 0 && !r.matchesWeekday(date) {
+		return false
+	}
+	return true
+}
+
+func (r *RecurrenceRule) matchesMonthDay(date time.Time) bool {
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, day := range r.monthDays {
+		if day > 0 && date.Day() == day {
+			return true
+		}
+		if day < 0 && date.Day() == daysInMonth+day+1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RecurrenceRule) matchesWeekday(date time.Time) bool {
+	// Numbered weekdays count within the month, or within the year for
+	// yearly rules that don't pick their months.
+	index, total := date.Day(), time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if r.frequency == FrequencyYearly && len(r.months) == 0 {
+		index, total = date.YearDay(), time.Date(date.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+
+	for _, weekday := range r.weekdays {
+		if date.Weekday() != weekday.Weekday {
+			continue
+		}
+		switch {
+		case weekday.N == 0:
+			return true
+		case weekday.N > 0 && (index-1)/7+1 == weekday.N:
+			return true
+		case weekday.N < 0 && (total-index)/7+1 == -weekday.N:
+			return true
+		}
+	}
+	return false
+}
+
+func civilDaysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a) / (24 * time.Hour))
+}
+
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
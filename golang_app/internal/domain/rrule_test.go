@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			expression string
+		}{
+			{"should require a frequency", "RRULE:INTERVAL=2"},
+			{"should reject unsupported frequencies", "FREQ=HOURLY"},
+			{"should reject COUNT", "FREQ=DAILY;COUNT=3"},
+			{"should reject a zero interval", "FREQ=DAILY;INTERVAL=0"},
+			{"should reject days out of range", "FREQ=MONTHLY;BYMONTHDAY=32"},
+			{"should reject unknown weekdays", "FREQ=WEEKLY;BYDAY=XX"},
+			{"should reject numbered weekdays in weekly rules", "FREQ=WEEKLY;BYDAY=1MO"},
+			{"should reject unknown time zones", "DTSTART;TZID=Mars/Olympus:20251103T090000 RRULE:FREQ=DAILY"},
+			{"should reject unknown parts", "FREQ=DAILY;BYSETPOS=1"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.ParseRecurrenceRule(tt.expression, utc(11, 1, 0, 0)); err == nil {
+					t.Errorf("Expected an error for %q", tt.expression)
+				}
+			})
+		}
+	})
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	start := utc(11, 1, 0, 0)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load time zone: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		want       time.Time
+	}{
+		{"should run daily on the hour", "FREQ=DAILY;BYHOUR=9", utc(11, 17, 10, 0), utc(11, 18, 9, 0)},
+		{"should run on the given weekdays", "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=9", utc(11, 17, 10, 0), utc(11, 19, 9, 0)},
+		{"should skip weeks by interval", "DTSTART:20251103T090000Z RRULE:FREQ=WEEKLY;INTERVAL=2", utc(11, 17, 9, 0), utc(12, 1, 9, 0)},
+		{"should run on the first monday of the month", "FREQ=MONTHLY;BYDAY=1MO;BYHOUR=9", utc(11, 17, 10, 0), utc(12, 1, 9, 0)},
+		{"should run on the last friday of the month", "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17", utc(11, 17, 10, 0), utc(11, 28, 17, 0)},
+		{"should run on the last day of the month", "FREQ=MONTHLY;BYMONTHDAY=-1;BYHOUR=9", utc(11, 17, 10, 0), utc(11, 30, 9, 0)},
+		{"should run quarterly", "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1;BYHOUR=9", utc(11, 17, 10, 0), time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"should default yearly rules to the start date", "DTSTART:20250315T090000Z RRULE:FREQ=YEARLY", utc(11, 17, 10, 0), time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{"should run in the start time zone", "DTSTART;TZID=America/New_York:20251103T090000 RRULE:FREQ=DAILY", utc(11, 17, 15, 0), time.Date(2025, 11, 18, 9, 0, 0, 0, newYork)},
+		{"should wait for the start", "DTSTART:20251201T090000Z RRULE:FREQ=DAILY", utc(11, 17, 10, 0), utc(12, 1, 9, 0)},
+		{"should stop after UNTIL", "FREQ=DAILY;BYHOUR=9;UNTIL=20251118", utc(11, 18, 10, 0), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := domain.ParseRecurrenceRule(tt.expression, start)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tt.expression, err)
+			}
+
+			if got := rule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Expected next run at %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	t.Run("should read cron expressions", func(t *testing.T) {
+		recurrence, err := domain.ParseRecurrence("0 9 * * 1", utc(11, 1, 0, 0))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		if _, ok := recurrence.(*domain.CronSchedule); !ok {
+			t.Errorf("Expected a cron schedule, got %T", recurrence)
+		}
+	})
+
+	t.Run("should read recurrence rules", func(t *testing.T) {
+		recurrence, err := domain.ParseRecurrence("FREQ=WEEKLY;BYDAY=MO", utc(11, 1, 0, 0))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		if _, ok := recurrence.(*domain.RecurrenceRule); !ok {
+			t.Errorf("Expected a recurrence rule, got %T", recurrence)
+		}
+	})
+}
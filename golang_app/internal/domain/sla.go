@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	DefaultSLAAtRiskPercent = 80
+	SLAReportingDays        = 30
+)
+
+type SLATarget string
+
+const (
+	SLATargetAccept   SLATarget = "accept"
+	SLATargetComplete SLATarget = "complete"
+)
+
+type SLAState string
+
+const (
+	SLAOnTrack  SLAState = "on_track"
+	SLAAtRisk   SLAState = "at_risk"
+	SLABreached SLAState = "breached"
+)
+
+// SLAPolicy holds a queue's targets in business time. A zero target is not
+// tracked. Requests are at risk once AtRiskPercent of a target has elapsed.
+type SLAPolicy struct {
+	AcceptWithin   time.Duration
+	CompleteWithin time.Duration
+	AtRiskPercent  int
+}
+
+type SLAClock struct {
+	Target  SLATarget
+	Limit   time.Duration
+	Elapsed time.Duration
+	Stopped bool
+	State   SLAState
+}
+
+type SLABreach struct {
+	Target     SLATarget
+	BreachedAt time.Time
+}
+
+type HoldPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+func NewSLAPolicy(acceptWithin, completeWithin time.Duration, atRiskPercent int) (*SLAPolicy, error) {
+	if acceptWithin < 0 || completeWithin < 0 {
+		return nil, errors.New("SLA targets cannot be negative")
+	}
+
+	if acceptWithin == 0 && completeWithin == 0 {
+		return nil, errors.New("an SLA policy needs a time-to-accept or time-to-complete target")
+	}
+
+	if atRiskPercent == 0 {
+		atRiskPercent = DefaultSLAAtRiskPercent
+	}
+	if atRiskPercent < 1 || atRiskPercent > 99 {
+		return nil, errors.New("the at-risk threshold must be between 1 and 99 percent")
+	}
+
+	return &SLAPolicy{
+		AcceptWithin:   acceptWithin,
+		CompleteWithin: completeWithin,
+		AtRiskPercent:  atRiskPercent,
+	}, nil
+}
+
+func (p *SLAPolicy) state(elapsed, limit time.Duration) SLAState {
+	if elapsed > limit {
+		return SLABreached
+	}
+	if elapsed >= limit*time.Duration(p.AtRiskPercent)/100 {
+		return SLAAtRisk
+	}
+	return SLAOnTrack
+}
+
+func (q *Queue) SetSLAPolicy(policy *SLAPolicy) {
+	q.SLA = policy
+	q.UpdatedAt = time.Now()
+}
+
+// SLAClocks measures a request of this queue against its SLA policy. The
+// accept clock stops when the request is accepted or resolved, the complete
+// clock when it is resolved, and neither counts time spent on hold. Clocks
+// without a recorded stop time are skipped once the request has moved on.
+func (q *Queue) SLAClocks(r *Request, at time.Time) []SLAClock {
+	if q.SLA == nil || !r.tracksSLA() {
+		return nil
+	}
+
+	clocks := []SLAClock{}
+
+	if q.SLA.AcceptWithin > 0 {
+		switch {
+		case r.Status == RequestPending:
+			clocks = append(clocks, q.slaClock(r, SLATargetAccept, q.SLA.AcceptWithin, at, false))
+		case !r.AcceptedAt.IsZero():
+			clocks = append(clocks, q.slaClock(r, SLATargetAccept, q.SLA.AcceptWithin, r.AcceptedAt, true))
+		case !r.ResolvedAt.IsZero():
+			clocks = append(clocks, q.slaClock(r, SLATargetAccept, q.SLA.AcceptWithin, r.ResolvedAt, true))
+		}
+	}
+
+	if q.SLA.CompleteWithin > 0 {
+		switch {
+		case r.IsOpen():
+			clocks = append(clocks, q.slaClock(r, SLATargetComplete, q.SLA.CompleteWithin, at, false))
+		case !r.ResolvedAt.IsZero():
+			clocks = append(clocks, q.slaClock(r, SLATargetComplete, q.SLA.CompleteWithin, r.ResolvedAt, true))
+		}
+	}
+
+	return clocks
+}
+
+func (q *Queue) slaClock(r *Request, target SLATarget, limit time.Duration, end time.Time, stopped bool) SLAClock {
+	elapsed := q.BusinessTimeBetween(r.CreatedAt, end)
+	for _, hold := range r.holdPeriods(end) {
+		elapsed -= q.BusinessTimeBetween(hold.Start, hold.End)
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return SLAClock{
+		Target:  target,
+		Limit:   limit,
+		Elapsed: elapsed,
+		Stopped: stopped,
+		State:   q.SLA.state(elapsed, limit),
+	}
+}
+
+func (r *Request) tracksSLA() bool {
+	switch r.Status {
+	case RequestPending, RequestAccepted, RequestOnHold, RequestCompleted, RequestRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Request) holdPeriods(end time.Time) []HoldPeriod {
+	periods := []HoldPeriod{}
+	for _, hold := range r.HoldPeriods {
+		if hold.End.After(end) {
+			hold.End = end
+		}
+		if hold.End.After(hold.Start) {
+			periods = append(periods, hold)
+		}
+	}
+	if r.Status == RequestOnHold && !r.OnHoldSince.IsZero() && end.After(r.OnHoldSince) {
+		periods = append(periods, HoldPeriod{Start: r.OnHoldSince, End: end})
+	}
+	return periods
+}
+
+// ApplySLA records the worst state of the given clocks on the request and
+// returns the targets that breached for the first time.
+func (r *Request) ApplySLA(clocks []SLAClock, at time.Time) []SLATarget {
+	state := SLAState("")
+	breached := []SLATarget{}
+
+	for _, clock := range clocks {
+		switch {
+		case clock.State == SLABreached:
+			state = SLABreached
+			if !r.HasBreachedSLA(clock.Target) {
+				r.SLABreaches = append(r.SLABreaches, SLABreach{Target: clock.Target, BreachedAt: at})
+				breached = append(breached, clock.Target)
+			}
+		case clock.State == SLAAtRisk && !clock.Stopped && state != SLABreached:
+			state = SLAAtRisk
+		case state == "":
+			state = SLAOnTrack
+		}
+	}
+
+	r.SLAState = state
+	return breached
+}
+
+func (r *Request) HasBreachedSLA(target SLATarget) bool {
+	for _, breach := range r.SLABreaches {
+		if breach.Target == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newSLAQueue(t *testing.T, acceptWithin, completeWithin time.Duration) *domain.Queue {
+	t.Helper()
+
+	policy, err := domain.NewSLAPolicy(acceptWithin, completeWithin, 0)
+	if err != nil {
+		t.Fatalf("Failed to create SLA policy: %v", err)
+	}
+
+	q := domain.NewQueue("queue-1", "Ops", "admin")
+	q.SetSLAPolicy(policy)
+	return &q
+}
+
+func newSLARequest(status domain.RequestStatus, createdAt time.Time) *domain.Request {
+	return &domain.Request{
+		ID:          "request-1",
+		Title:       "Fix the printer",
+		CreatedByID: "requester",
+		Recipient:   &domain.RequestRecipient{ID: "queue-1", Type: domain.RequestRecipientQueue},
+		Status:      status,
+		CreatedAt:   createdAt,
+	}
+}
+
+func clockFor(clocks []domain.SLAClock, target domain.SLATarget) (domain.SLAClock, bool) {
+	for _, clock := range clocks {
+		if clock.Target == target {
+			return clock, true
+		}
+	}
+	return domain.SLAClock{}, false
+}
+
+func TestSLAPolicy(t *testing.T) {
+	t.Run("NewSLAPolicy", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			acceptWithin   time.Duration
+			completeWithin time.Duration
+			atRiskPercent  int
+		}{
+			{"should require a target", 0, 0, 0},
+			{"should reject negative targets", -time.Hour, 4 * time.Hour, 0},
+			{"should reject an at-risk threshold of 100 percent", 4 * time.Hour, 0, 100},
+			{"should reject a negative at-risk threshold", 4 * time.Hour, 0, -5},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.NewSLAPolicy(tt.acceptWithin, tt.completeWithin, tt.atRiskPercent); err == nil {
+					t.Error("Expected an error")
+				}
+			})
+		}
+
+		t.Run("should default the at-risk threshold", func(t *testing.T) {
+			policy, err := domain.NewSLAPolicy(4*time.Hour, 0, 0)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if policy.AtRiskPercent != domain.DefaultSLAAtRiskPercent {
+				t.Errorf("Expected %d, got %d", domain.DefaultSLAAtRiskPercent, policy.AtRiskPercent)
+			}
+		})
+	})
+}
+
+func TestQueueSLAClocks(t *testing.T) {
+	createdAt := utc(11, 17, 9, 0)
+
+	t.Run("should report the state of a pending request", func(t *testing.T) {
+		tests := []struct {
+			name string
+			at   time.Time
+			want domain.SLAState
+		}{
+			{"should be on track early on", utc(11, 17, 10, 0), domain.SLAOnTrack},
+			{"should be at risk after 80 percent of the target", utc(11, 17, 12, 12), domain.SLAAtRisk},
+			{"should not be breached exactly at the target", utc(11, 17, 13, 0), domain.SLAAtRisk},
+			{"should be breached after the target", utc(11, 17, 13, 1), domain.SLABreached},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				clocks := newSLAQueue(t, 4*time.Hour, 0).SLAClocks(newSLARequest(domain.RequestPending, createdAt), tt.at)
+
+				clock, ok := clockFor(clocks, domain.SLATargetAccept)
+				if !ok || clock.State != tt.want {
+					t.Errorf("Expected %s, got %+v", tt.want, clocks)
+				}
+			})
+		}
+	})
+
+	t.Run("should stop the accept clock when the request is accepted", func(t *testing.T) {
+		r := newSLARequest(domain.RequestAccepted, createdAt)
+		r.AcceptedAt = utc(11, 17, 10, 0)
+
+		clock, ok := clockFor(newSLAQueue(t, 4*time.Hour, 0).SLAClocks(r, utc(11, 20, 0, 0)), domain.SLATargetAccept)
+		if !ok || !clock.Stopped || clock.Elapsed != time.Hour || clock.State != domain.SLAOnTrack {
+			t.Errorf("Unexpected clock: %+v", clock)
+		}
+	})
+
+	t.Run("should stop the complete clock when the request is resolved", func(t *testing.T) {
+		r := newSLARequest(domain.RequestCompleted, createdAt)
+		r.AcceptedAt = utc(11, 17, 10, 0)
+		r.ResolvedAt = utc(11, 18, 10, 0)
+
+		clock, ok := clockFor(newSLAQueue(t, 0, 24*time.Hour).SLAClocks(r, utc(11, 25, 0, 0)), domain.SLATargetComplete)
+		if !ok || !clock.Stopped || clock.State != domain.SLABreached {
+			t.Errorf("Unexpected clock: %+v", clock)
+		}
+	})
+
+	t.Run("should not count time on hold", func(t *testing.T) {
+		r := newSLARequest(domain.RequestOnHold, createdAt)
+		r.AcceptedAt = createdAt
+		r.HoldPeriods = []domain.HoldPeriod{{Start: utc(11, 17, 10, 0), End: utc(11, 17, 12, 0)}}
+		r.OnHoldSince = utc(11, 17, 13, 0)
+
+		clock, _ := clockFor(newSLAQueue(t, 0, 24*time.Hour).SLAClocks(r, utc(11, 17, 15, 0)), domain.SLATargetComplete)
+		if clock.Elapsed != 2*time.Hour {
+			t.Errorf("Expected 2h, got %s", clock.Elapsed)
+		}
+	})
+
+	t.Run("should only count business time", func(t *testing.T) {
+		q := newSLAQueue(t, 4*time.Hour, 0)
+		q.SetBusinessHours(newLondonCalendar(t))
+
+		clock, _ := clockFor(q.SLAClocks(newSLARequest(domain.RequestPending, london(t, 11, 21, 16, 0)), london(t, 11, 24, 10, 0)), domain.SLATargetAccept)
+		if clock.Elapsed != 2*time.Hour+30*time.Minute || clock.State != domain.SLAOnTrack {
+			t.Errorf("Expected 2h30m on track over the weekend, got %+v", clock)
+		}
+	})
+
+	t.Run("should not track requests outside the queue's hands", func(t *testing.T) {
+		q := newSLAQueue(t, 4*time.Hour, 24*time.Hour)
+
+		for _, status := range []domain.RequestStatus{domain.RequestAwaitingApproval, domain.RequestDenied, domain.RequestDuplicate} {
+			if clocks := q.SLAClocks(newSLARequest(status, createdAt), utc(11, 25, 0, 0)); len(clocks) > 0 {
+				t.Errorf("Expected no clocks for %s, got %+v", status, clocks)
+			}
+		}
+	})
+
+	t.Run("should skip clocks without a recorded stop time", func(t *testing.T) {
+		r := newSLARequest(domain.RequestCompleted, createdAt)
+
+		if clocks := newSLAQueue(t, 4*time.Hour, 24*time.Hour).SLAClocks(r, utc(11, 25, 0, 0)); len(clocks) > 0 {
+			t.Errorf("Expected no clocks, got %+v", clocks)
+		}
+	})
+}
+
+func TestRequestApplySLA(t *testing.T) {
+	createdAt := utc(11, 17, 9, 0)
+
+	t.Run("should record each breach once", func(t *testing.T) {
+		q := newSLAQueue(t, 4*time.Hour, 0)
+		r := newSLARequest(domain.RequestPending, createdAt)
+
+		breached := r.ApplySLA(q.SLAClocks(r, utc(11, 17, 14, 0)), utc(11, 17, 14, 0))
+		if len(breached) != 1 || breached[0] != domain.SLATargetAccept || r.SLAState != domain.SLABreached {
+			t.Fatalf("Expected a new accept breach, got %v (%s)", breached, r.SLAState)
+		}
+
+		if breached := r.ApplySLA(q.SLAClocks(r, utc(11, 17, 15, 0)), utc(11, 17, 15, 0)); len(breached) != 0 {
+			t.Errorf("Expected no new breaches, got %v", breached)
+		}
+		if len(r.SLABreaches) != 1 || !r.SLABreaches[0].BreachedAt.Equal(utc(11, 17, 14, 0)) {
+			t.Errorf("Unexpected breaches: %+v", r.SLABreaches)
+		}
+	})
+
+	t.Run("should not flag stopped clocks as at risk", func(t *testing.T) {
+		q := newSLAQueue(t, 4*time.Hour, 0)
+		r := newSLARequest(domain.RequestAccepted, createdAt)
+		r.AcceptedAt = utc(11, 17, 12, 30)
+
+		r.ApplySLA(q.SLAClocks(r, utc(11, 18, 0, 0)), utc(11, 18, 0, 0))
+		if r.SLAState != domain.SLAOnTrack {
+			t.Errorf("Expected on track, got %s", r.SLAState)
+		}
+	})
+
+	t.Run("should let the worst clock win", func(t *testing.T) {
+		q := newSLAQueue(t, 4*time.Hour, 5*time.Hour)
+		r := newSLARequest(domain.RequestPending, createdAt)
+
+		r.ApplySLA(q.SLAClocks(r, utc(11, 17, 13, 30)), utc(11, 17, 13, 30))
+		if r.SLAState != domain.SLABreached {
+			t.Errorf("Expected breached, got %s", r.SLAState)
+		}
+	})
+}
+
+func TestRequestTransitionTimestamps(t *testing.T) {
+	t.Run("should record when a request is accepted and resolved", func(t *testing.T) {
+		r := newSLARequest(domain.RequestPending, time.Now())
+
+		if err := r.Accept("responder"); err != nil {
+			t.Fatalf("Failed to accept: %v", err)
+		}
+		if r.AcceptedAt.IsZero() {
+			t.Error("Expected AcceptedAt to be set")
+		}
+
+		if err := r.Complete(); err != nil {
+			t.Fatalf("Failed to complete: %v", err)
+		}
+		if r.ResolvedAt.IsZero() {
+			t.Error("Expected ResolvedAt to be set")
+		}
+	})
+
+	t.Run("should record hold periods", func(t *testing.T) {
+		r := newSLARequest(domain.RequestPending, time.Now())
+		r.Accept("responder")
+		r.PutOnHold("Which printer?")
+		r.Resume("The one on floor 2")
+
+		if len(r.HoldPeriods) != 1 {
+			t.Errorf("Expected one hold period, got %+v", r.HoldPeriods)
+		}
+	})
+
+	t.Run("should clear the acceptance when the assignee declines", func(t *testing.T) {
+		r := newSLARequest(domain.RequestPending, time.Now())
+		r.Accept("responder")
+
+		if err := r.DeclineAssignment("responder"); err != nil {
+			t.Fatalf("Failed to decline: %v", err)
+		}
+		if !r.AcceptedAt.IsZero() {
+			t.Error("Expected AcceptedAt to be cleared")
+		}
+	})
+}
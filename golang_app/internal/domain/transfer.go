@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QueueTransfer keeps queue names so cards still read well after a rename or delete.
+type QueueTransfer struct {
+	FromQueueID   string
+	FromQueueName string
+	ToQueueID     string
+	ToQueueName   string
+	ToChannelID   string
+	ByID          string
+	Reason        string
+	At            time.Time
+}
+
+// TransferTo moves an open request to another queue, dropping what that queue doesn't define.
+func (r *Request) TransferTo(from, to *Queue, userId, reason string, at time.Time) error {
+	if r.Recipient == nil || r.Recipient.Type != RequestRecipientQueue || from == nil || r.Recipient.ID != from.ID {
+		return errors.New("only requests in a queue can be transferred")
+	}
+
+	if !r.IsOpen() {
+		return fmt.Errorf("request is already %s and cannot be transferred", r.Status)
+	}
+
+	if to == nil || to.ID == from.ID {
+		return errors.New("choose a different queue to transfer the request to")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("a reason for the transfer is required")
+	}
+
+	fields := []FieldValue{}
+	for _, field := range to.IntakeFields {
+		value, ok := r.fieldValue(field.Key)
+		if !ok && field.Required {
+			return fmt.Errorf("%s requires %s, which this request doesn't have", to.Name, field.Label)
+		}
+		if ok {
+			fields = append(fields, value)
+		}
+	}
+
+	labels := []string{}
+	for _, label := range r.Labels {
+		if to.HasLabel(label) {
+			labels = append(labels, label)
+		}
+	}
+
+	moved := *r
+	moved.HoldPeriods = append([]HoldPeriod{}, r.HoldPeriods...)
+
+	if to.RequiresApproval() {
+		moved.resetAcceptance(at)
+		moved.Workflow = nil
+		moved.WorkflowStatus = ""
+		moved.Approvals = nil
+		moved.ApprovalMessages = nil
+
+		if err := moved.UseWorkflow(to.Workflow); err != nil {
+			return err
+		}
+		if err := moved.RequireApproval(to.ApprovalChain, to.AdminIds); err != nil {
+			return fmt.Errorf("%s requires approval: %w", to.Name, err)
+		}
+	} else if r.HasWorkflow() || to.Workflow != nil || (r.AcceptedByID != "" && !to.Decide(r.AcceptedByID, PermissionAccept).Allowed) {
+		moved.resetAcceptance(at)
+		moved.Workflow = to.Workflow
+		moved.WorkflowStatus = ""
+		if to.Workflow != nil {
+			moved.WorkflowStatus = to.Workflow.Initial().Key
+		}
+	}
+
+	collaboratorIds := []string{}
+	for _, collaboratorId := range moved.CollaboratorIDs {
+		if to.Decide(collaboratorId, PermissionAccept).Allowed {
+			collaboratorIds = append(collaboratorIds, collaboratorId)
+		}
+	}
+
+	moved.Fields = fields
+	moved.Labels = labels
+	moved.CollaboratorIDs = collaboratorIds
+	moved.DeclinedByIDs = nil
+	moved.SentReminders = nil
+	moved.Recipient = &RequestRecipient{ID: to.ID, Type: RequestRecipientQueue}
+	moved.Transfers = append(append([]QueueTransfer{}, r.Transfers...), QueueTransfer{
+		FromQueueID:   from.ID,
+		FromQueueName: from.Name,
+		ToQueueID:     to.ID,
+		ToQueueName:   to.Name,
+		ToChannelID:   to.ChannelId,
+		ByID:          userId,
+		Reason:        reason,
+		At:            at,
+	})
+	moved.UpdatedAt = at
+
+	*r = moved
+	return nil
+}
+
+// LastTransfer returns the most recent transfer, if the request was ever moved.
+func (r *Request) LastTransfer() (QueueTransfer, bool) {
+	if len(r.Transfers) == 0 {
+		return QueueTransfer{}, false
+	}
+	return r.Transfers[len(r.Transfers)-1], true
+}
+
+func (r *Request) resetAcceptance(at time.Time) {
+	if r.Status == RequestOnHold {
+		r.endHold(at)
+	}
+
+	r.Status = RequestPending
+	r.AcceptedByID = ""
+	r.AcceptedAt = time.Time{}
+	r.CollaboratorIDs = nil
+	r.HoldQuestion = ""
+	r.HoldMessage = nil
+}
+
+func (r *Request) fieldValue(key string) (FieldValue, bool) {
+	for _, field := range r.Fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return FieldValue{}, false
+}
@@ -0,0 +1,209 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newTransferQueues(t *testing.T) (*domain.Queue, *domain.Queue) {
+	t.Helper()
+
+	from := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob")
+	if err := from.AddLabel("billing"); err != nil {
+		t.Fatalf("Failed to add label: %v", err)
+	}
+	if err := from.AddLabel("urgent"); err != nil {
+		t.Fatalf("Failed to add label: %v", err)
+	}
+
+	q := domain.NewQueue("queue-2", "Billing", "admin")
+	q.ChannelId = "C-billing"
+	if err := q.AddMember("alice"); err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+	if err := q.AddLabel("urgent"); err != nil {
+		t.Fatalf("Failed to add label: %v", err)
+	}
+
+	return from, &q
+}
+
+func TestRequestTransferTo(t *testing.T) {
+	at := time.Date(2025, 12, 3, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should move the request and record the transfer", func(t *testing.T) {
+		from, to := newTransferQueues(t)
+		r := newQueuedRequest(t, "requester")
+		r.Labels = []string{"billing", "urgent"}
+
+		if err := r.TransferTo(from, to, "bob", " Wrong team ", at); err != nil {
+			t.Fatalf("Failed to transfer: %v", err)
+		}
+
+		if r.Recipient.ID != "queue-2" || r.Recipient.Type != domain.RequestRecipientQueue {
+			t.Errorf("Expected the new queue as recipient, got %+v", r.Recipient)
+		}
+		if len(r.Labels) != 1 || r.Labels[0] != "urgent" {
+			t.Errorf("Expected only labels the new queue has, got %v", r.Labels)
+		}
+
+		transfer, ok := r.LastTransfer()
+		if !ok {
+			t.Fatal("Expected the transfer to be recorded")
+		}
+		want := domain.QueueTransfer{
+			FromQueueID: "queue-1", FromQueueName: "Support", ToQueueID: "queue-2", ToQueueName: "Billing",
+			ToChannelID: "C-billing", ByID: "bob", Reason: "Wrong team", At: at,
+		}
+		if transfer != want {
+			t.Errorf("Expected %+v, got %+v", want, transfer)
+		}
+	})
+
+	t.Run("should keep the assignee when they are a member of the new queue", func(t *testing.T) {
+		from, to := newTransferQueues(t)
+		r := newQueuedRequest(t, "requester")
+		if err := r.Accept("alice"); err != nil {
+			t.Fatalf("Failed to accept: %v", err)
+		}
+
+		if err := r.TransferTo(from, to, "alice", "Wrong team", at); err != nil {
+			t.Fatalf("Failed to transfer: %v", err)
+		}
+
+		if r.Status != domain.RequestAccepted || r.AcceptedByID != "alice" {
+			t.Errorf("Expected alice to keep the request, got %s by %q", r.Status, r.AcceptedByID)
+		}
+	})
+
+	t.Run("should reset acceptance when the assignee isn't a member of the new queue", func(t *testing.T) {
+		from, to := newTransferQueues(t)
+		r := newQueuedRequest(t, "requester")
+		if err := r.Accept("bob"); err != nil {
+			t.Fatalf("Failed to accept: %v", err)
+		}
+		if err := r.PutOnHold("Which invoice?"); err != nil {
+			t.Fatalf("Failed to put on hold: %v", err)
+		}
+
+		if err := r.TransferTo(from, to, "bob", "Wrong team", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("Failed to transfer: %v", err)
+		}
+
+		if r.Status != domain.RequestPending || r.AcceptedByID != "" || !r.AcceptedAt.IsZero() {
+			t.Errorf("Expected the request back in pending, got %s by %q", r.Status, r.AcceptedByID)
+		}
+		if len(r.HoldPeriods) != 1 {
+			t.Errorf("Expected the hold to be closed, got %v", r.HoldPeriods)
+		}
+	})
+
+	t.Run("should put the request in front of the new queue's approvers", func(t *testing.T) {
+		from, to := newTransferQueues(t)
+		stage, err := domain.NewApprovalStage("Finance", []string{"carol"}, domain.QuorumAny, 0, 0, "")
+		if err != nil {
+			t.Fatalf("Failed to create approval stage: %v", err)
+		}
+		if err := to.SetApprovalChain([]domain.ApprovalStage{stage}); err != nil {
+			t.Fatalf("Failed to set approval chain: %v", err)
+		}
+
+		r := newQueuedRequest(t, "requester")
+		r.Approvals = []domain.ApprovalDecision{{Stage: 0, ApproverID: "dave", Approved: true}}
+		if err := r.Accept("alice"); err != nil {
+			t.Fatalf("Failed to accept: %v", err)
+		}
+
+		if err := r.TransferTo(from, to, "alice", "Needs sign-off", at); err != nil {
+			t.Fatalf("Failed to transfer: %v", err)
+		}
+
+		if r.Status != domain.RequestAwaitingApproval || r.AcceptedByID != "" {
+			t.Errorf("Expected the request to await approval, got %s by %q", r.Status, r.AcceptedByID)
+		}
+		if len(r.Approvals) != 0 || !r.IsApprover("carol") {
+			t.Errorf("Expected carol to approve from scratch, got approvals %v and approvers %v", r.Approvals, r.CurrentApproverIDs())
+		}
+	})
+
+	t.Run("should refuse a transfer only the requester could approve in the new queue", func(t *testing.T) {
+		from, to := newTransferQueues(t)
+		stage, err := domain.NewApprovalStage("Finance", []string{"requester"}, domain.QuorumAny, 0, 0, "")
+		if err != nil {
+			t.Fatalf("Failed to create approval stage: %v", err)
+		}
+		if err := to.SetApprovalChain([]domain.ApprovalStage{stage}); err != nil {
+			t.Fatalf("Failed to set approval chain: %v", err)
+		}
+		to.AdminIds = []string{"requester"}
+
+		r := newQueuedRequest(t, "requester")
+		if err := r.TransferTo(from, to, "alice", "Needs sign-off", at); !errors.Is(err, domain.ErrNoApprover) {
+			t.Fatalf("Expected ErrNoApprover, got %v", err)
+		}
+		if r.Recipient.ID != "queue-1" || r.Status != domain.RequestPending {
+			t.Errorf("Expected the request to stay put, got %q %s", r.Recipient.ID, r.Status)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		mutate func(r *domain.Request, to *domain.Queue)
+		reason string
+	}{
+		{"should require a reason", func(r *domain.Request, to *domain.Queue) {}, " "},
+		{"should reject closed requests", func(r *domain.Request, to *domain.Queue) { r.Status = domain.RequestCompleted }, "Wrong team"},
+		{"should reject a missing required field", func(r *domain.Request, to *domain.Queue) {
+			to.IntakeFields = []domain.IntakeField{{Key: "invoice", Label: "Invoice", Type: domain.IntakeFieldText, Required: true}}
+		}, "Wrong team"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := newTransferQueues(t)
+			r := newQueuedRequest(t, "requester")
+			tt.mutate(r, to)
+
+			if err := r.TransferTo(from, to, "bob", tt.reason, at); err == nil {
+				t.Error("Expected an error")
+			}
+			if r.Recipient.ID != "queue-1" {
+				t.Errorf("Expected the request to stay put, got %q", r.Recipient.ID)
+			}
+		})
+	}
+
+	t.Run("should reject transferring into the same queue", func(t *testing.T) {
+		from, _ := newTransferQueues(t)
+
+		if err := newQueuedRequest(t, "requester").TransferTo(from, from, "bob", "Wrong team", at); err == nil {
+			t.Error("Expected an error for the same queue")
+		}
+	})
+}
+
+func TestCanTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorId string
+		want    bool
+	}{
+		{"should allow admins", "admin", true},
+		{"should allow members", "alice", true},
+		{"should not allow outsiders", "mallory", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, _ := newTransferQueues(t)
+			authCtx := domain.NewAuthorizationContext(newQueuedRequest(t, "requester"), from, tt.actorId)
+
+			if got := authCtx.CanTransfer(); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
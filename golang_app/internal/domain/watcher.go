@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrAlreadyFollowing = errors.New("user already receives updates for this request")
+
+func (r *Request) AddWatcher(userId string) error {
+	if userId == "" {
+		return errors.New("watcher user ID is required")
+	}
+
+	if r.CreatedByID == userId || r.IsAssignee(userId) || r.IsWatcher(userId) {
+		return ErrAlreadyFollowing
+	}
+
+	r.WatcherIDs = append(r.WatcherIDs, userId)
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) RemoveWatcher(userId string) error {
+	for i, watcherId := range r.WatcherIDs {
+		if watcherId == userId {
+			r.WatcherIDs = append(r.WatcherIDs[:i], r.WatcherIDs[i+1:]...)
+			r.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	return errors.New("user is not watching this request")
+}
+
+func (r *Request) NotifiableWatcherIDs() []string {
+	watchers := []string{}
+	for _, watcherId := range r.WatcherIDs {
+		if watcherId != r.CreatedByID && !r.IsAssignee(watcherId) {
+			watchers = append(watchers, watcherId)
+		}
+	}
+	return watchers
+}
+
+func (r *Request) IsWatcher(userId string) bool {
+	for _, watcherId := range r.WatcherIDs {
+		if watcherId == userId {
+			return true
+		}
+	}
+	return false
+}
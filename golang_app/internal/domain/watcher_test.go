@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"request/internal/domain"
+)
+
+func TestRequestWatchers(t *testing.T) {
+	t.Run("AddWatcher", func(t *testing.T) {
+		t.Run("should add a user once", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+
+			if err := r.AddWatcher("manager"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if err := r.AddWatcher("manager"); !errors.Is(err, domain.ErrAlreadyFollowing) {
+				t.Errorf("Expected ErrAlreadyFollowing, got %v", err)
+			}
+
+			if len(r.WatcherIDs) != 1 || !r.IsWatcher("manager") {
+				t.Errorf("Expected a single watcher, got %v", r.WatcherIDs)
+			}
+		})
+
+		t.Run("should not add the creator or an assignee", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+			r.Accept("recipient")
+
+			for _, userId := range []string{"creator", "recipient"} {
+				if err := r.AddWatcher(userId); !errors.Is(err, domain.ErrAlreadyFollowing) {
+					t.Errorf("Expected ErrAlreadyFollowing for %s, got %v", userId, err)
+				}
+			}
+		})
+	})
+
+	t.Run("RemoveWatcher", func(t *testing.T) {
+		t.Run("should remove an existing watcher", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+			r.AddWatcher("manager")
+			r.AddWatcher("teammate")
+
+			if err := r.RemoveWatcher("manager"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.IsWatcher("manager") || !r.IsWatcher("teammate") {
+				t.Errorf("Unexpected watchers: %v", r.WatcherIDs)
+			}
+		})
+
+		t.Run("should fail for a user who is not watching", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+
+			if err := r.RemoveWatcher("manager"); err == nil {
+				t.Error("Expected an error when removing a non-watcher")
+			}
+		})
+	})
+
+	t.Run("NotifiableWatcherIDs", func(t *testing.T) {
+		t.Run("should skip watchers who later became assignees", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "VPN access")
+			r.AddWatcher("recipient")
+			r.AddWatcher("manager")
+			r.Accept("recipient")
+
+			watchers := r.NotifiableWatcherIDs()
+			if len(watchers) != 1 || watchers[0] != "manager" {
+				t.Errorf("Expected only manager, got %v", watchers)
+			}
+		})
+	})
+}
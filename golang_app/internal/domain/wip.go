@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrWIPLimitReached = errors.New("work in progress limit reached")
+
+// WIPLimits caps how much work a queue takes on at once. A zero limit means
+// no limit.
+type WIPLimits struct {
+	PerMember int
+	PerQueue  int
+}
+
+// NewWIPLimits returns nil when both limits are zero, which turns them off.
+func NewWIPLimits(perMember, perQueue int) (*WIPLimits, error) {
+	if perMember < 0 || perQueue < 0 {
+		return nil, errors.New("work in progress limits can't be negative")
+	}
+
+	if perMember == 0 && perQueue == 0 {
+		return nil, nil
+	}
+
+	if perQueue > 0 && perMember > perQueue {
+		return nil, errors.New("the per-member limit can't be higher than the queue limit")
+	}
+
+	return &WIPLimits{PerMember: perMember, PerQueue: perQueue}, nil
+}
+
+func (q *Queue) SetWIPLimits(limits *WIPLimits) {
+	q.WIP = limits
+	q.UpdatedAt = time.Now()
+}
+
+// InProgressStatuses are the statuses that count towards work in progress.
+func InProgressStatuses() []RequestStatus {
+	return []RequestStatus{RequestAccepted, RequestOnHold}
+}
+
+func (r *Request) IsInProgress() bool {
+	for _, status := range InProgressStatuses() {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// WIPLoad is the work in progress in a queue: how many requests are accepted
+// or on hold, in total and per acceptor.
+type WIPLoad struct {
+	InProgress int
+	ByMember   map[string]int
+}
+
+// NewWIPLoad counts the in progress requests among the given ones, so it can
+// be handed every open request of a queue.
+func NewWIPLoad(requests []*Request) WIPLoad {
+	load := WIPLoad{ByMember: map[string]int{}}
+	for _, request := range requests {
+		if !request.IsInProgress() {
+			continue
+		}
+
+		load.InProgress++
+		if request.AcceptedByID != "" {
+			load.ByMember[request.AcceptedByID]++
+		}
+	}
+	return load
+}
+
+// CheckWIPLimit returns ErrWIPLimitReached when the queue, or the user within
+// it, can't take on another request.
+func (q *Queue) CheckWIPLimit(userId string, load WIPLoad) error {
+	if q.WIP == nil {
+		return nil
+	}
+
+	if q.WIP.PerQueue > 0 && load.InProgress >= q.WIP.PerQueue {
+		return fmt.Errorf("%w: %s already has %d requests in progress, its limit is %d",
+			ErrWIPLimitReached, q.Name, load.InProgress, q.WIP.PerQueue)
+	}
+
+	if q.WIP.PerMember > 0 && load.ByMember[userId] >= q.WIP.PerMember {
+		return fmt.Errorf("%w: <@%s> already has %d accepted requests in %s, the limit is %d",
+			ErrWIPLimitReached, userId, load.ByMember[userId], q.Name, q.WIP.PerMember)
+	}
+
+	return nil
+}
+
+// CanOverrideWIPLimit reports whether the user may take on, or hand out, work
+// beyond the queue's limits. Only queue admins can.
+func (q *Queue) CanOverrideWIPLimit(userId string) bool {
+	return q.Decide(userId, PermissionEditQueue).Allowed
+}
@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"request/internal/domain"
+)
+
+func newWIPRequest(t *testing.T, id string, status domain.RequestStatus, acceptedById string) *domain.Request {
+	t.Helper()
+
+	r := newQueuedRequest(t, "requester")
+	r.ID = id
+	r.Status = status
+	r.AcceptedByID = acceptedById
+	return r
+}
+
+func TestNewWIPLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		perMember int
+		perQueue  int
+		wantNil   bool
+		wantErr   bool
+	}{
+		{"should turn limits off when both are zero", 0, 0, true, false},
+		{"should allow only a member limit", 3, 0, false, false},
+		{"should allow only a queue limit", 0, 10, false, false},
+		{"should reject negative limits", -1, 10, true, true},
+		{"should reject a member limit above the queue limit", 5, 3, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits, err := domain.NewWIPLimits(tt.perMember, tt.perQueue)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if (limits == nil) != tt.wantNil {
+				t.Errorf("Expected nil limits %v, got %+v", tt.wantNil, limits)
+			}
+		})
+	}
+}
+
+func TestNewWIPLoad(t *testing.T) {
+	t.Run("should only count accepted and on hold requests", func(t *testing.T) {
+		load := domain.NewWIPLoad([]*domain.Request{
+			newWIPRequest(t, "req-1", domain.RequestPending, ""),
+			newWIPRequest(t, "req-2", domain.RequestAccepted, "alice"),
+			newWIPRequest(t, "req-3", domain.RequestOnHold, "alice"),
+			newWIPRequest(t, "req-4", domain.RequestAccepted, "bob"),
+			newWIPRequest(t, "req-5", domain.RequestCompleted, "bob"),
+		})
+
+		if load.InProgress != 3 || load.ByMember["alice"] != 2 || load.ByMember["bob"] != 1 {
+			t.Errorf("Unexpected load: %+v", load)
+		}
+	})
+}
+
+func TestCheckWIPLimit(t *testing.T) {
+	load := domain.WIPLoad{InProgress: 4, ByMember: map[string]int{"alice": 2, "bob": 1}}
+
+	tests := []struct {
+		name    string
+		limits  *domain.WIPLimits
+		userId  string
+		wantErr bool
+	}{
+		{"should allow anything without limits", nil, "alice", false},
+		{"should stop members at their limit", &domain.WIPLimits{PerMember: 2}, "alice", true},
+		{"should allow members under their limit", &domain.WIPLimits{PerMember: 2}, "bob", false},
+		{"should stop everyone when the queue is at its limit", &domain.WIPLimits{PerQueue: 4}, "bob", true},
+		{"should allow members when the queue has room", &domain.WIPLimits{PerMember: 3, PerQueue: 5}, "alice", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newAssigningQueue(t, domain.AssignmentNone, "alice", "bob")
+			q.SetWIPLimits(tt.limits)
+
+			err := q.CheckWIPLimit(tt.userId, load)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, domain.ErrWIPLimitReached) {
+				t.Errorf("Expected ErrWIPLimitReached, got %v", err)
+			}
+		})
+	}
+
+	t.Run("should only let queue admins override", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentNone, "alice")
+
+		if !q.CanOverrideWIPLimit("admin") || q.CanOverrideWIPLimit("alice") {
+			t.Error("Expected only the admin to override")
+		}
+	})
+}
+
+func TestNextAssigneeWithWIPLimits(t *testing.T) {
+	t.Run("should skip members at their limit", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentRoundRobin, "alice", "bob", "carol")
+		q.SetWIPLimits(&domain.WIPLimits{PerMember: 2})
+		load := domain.WIPLoad{InProgress: 2, ByMember: map[string]int{"alice": 2}}
+
+		got, ok := q.NextAssignee(newQueuedRequest(t, "requester"), time.Now(), nil, load, nil)
+		if !ok || got != "bob" {
+			t.Errorf("Expected bob, got %q %v", got, ok)
+		}
+	})
+
+	t.Run("should not assign when the queue is at its limit", func(t *testing.T) {
+		q := newAssigningQueue(t, domain.AssignmentRoundRobin, "alice", "bob")
+		q.SetWIPLimits(&domain.WIPLimits{PerQueue: 2})
+		load := domain.WIPLoad{InProgress: 2, ByMember: map[string]int{"carol": 2}}
+
+		if got, ok := q.NextAssignee(newQueuedRequest(t, "requester"), time.Now(), nil, load, nil); ok {
+			t.Errorf("Expected no assignee, got %q", got)
+		}
+	})
+}
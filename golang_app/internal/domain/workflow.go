@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type StatusCategory string
+
+const (
+	StatusCategoryOpen       StatusCategory = "open"
+	StatusCategoryInProgress StatusCategory = "in_progress"
+	StatusCategoryDone       StatusCategory = "done"
+)
+
+func (c StatusCategory) Valid() bool {
+	switch c {
+	case StatusCategoryOpen, StatusCategoryInProgress, StatusCategoryDone:
+		return true
+	default:
+		return false
+	}
+}
+
+var ErrTransitionNotAllowed = errors.New("transition is not allowed by the workflow")
+
+type WorkflowStatus struct {
+	Key      string
+	Name     string
+	Category StatusCategory
+	Next     []string
+}
+
+type Workflow struct {
+	Statuses []WorkflowStatus
+}
+
+func NewWorkflowStatus(name string, category StatusCategory, next []string) WorkflowStatus {
+	status := WorkflowStatus{
+		Key:      WorkflowStatusKey(name),
+		Name:     strings.TrimSpace(name),
+		Category: category,
+	}
+
+	for _, nextName := range next {
+		status.Next = append(status.Next, WorkflowStatusKey(nextName))
+	}
+
+	return status
+}
+
+func WorkflowStatusKey(name string) string {
+	return IntakeFieldKey(name)
+}
+
+func NewWorkflow(statuses []WorkflowStatus) (*Workflow, error) {
+	if len(statuses) == 0 {
+		return nil, errors.New("workflow needs at least one status")
+	}
+
+	if statuses[0].Category != StatusCategoryOpen {
+		return nil, fmt.Errorf("the first status %q must be in the open category", statuses[0].Name)
+	}
+
+	seen := make(map[string]bool, len(statuses))
+	hasDone := false
+	for _, status := range statuses {
+		if status.Key == "" || status.Name == "" {
+			return nil, errors.New("workflow status name is required")
+		}
+		if !status.Category.Valid() {
+			return nil, fmt.Errorf("workflow status %q has an invalid category %q", status.Name, status.Category)
+		}
+		if seen[status.Key] {
+			return nil, fmt.Errorf("workflow status %q is defined more than once", status.Name)
+		}
+		seen[status.Key] = true
+		hasDone = hasDone || status.Category == StatusCategoryDone
+	}
+
+	if !hasDone {
+		return nil, errors.New("workflow needs at least one status in the done category")
+	}
+
+	for _, status := range statuses {
+		if status.Category == StatusCategoryDone && len(status.Next) > 0 {
+			return nil, fmt.Errorf("done status %q cannot have further transitions", status.Name)
+		}
+		for _, next := range status.Next {
+			if !seen[next] {
+				return nil, fmt.Errorf("status %q transitions to unknown status %q", status.Name, next)
+			}
+			if next == status.Key {
+				return nil, fmt.Errorf("status %q cannot transition to itself", status.Name)
+			}
+		}
+	}
+
+	return &Workflow{Statuses: statuses}, nil
+}
+
+func (w *Workflow) Initial() WorkflowStatus {
+	return w.Statuses[0]
+}
+
+func (w *Workflow) Status(key string) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Key == key {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+func (w *Workflow) CanTransition(from, to string) bool {
+	status, ok := w.Status(from)
+	if !ok {
+		return false
+	}
+
+	for _, next := range status.Next {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Workflow) NextStatuses(from string) []WorkflowStatus {
+	status, ok := w.Status(from)
+	if !ok {
+		return nil
+	}
+
+	statuses := make([]WorkflowStatus, 0, len(status.Next))
+	for _, next := range status.Next {
+		if nextStatus, ok := w.Status(next); ok {
+			statuses = append(statuses, nextStatus)
+		}
+	}
+	return statuses
+}
+
+func (q *Queue) SetWorkflow(workflow *Workflow) {
+	q.Workflow = workflow
+	q.UpdatedAt = time.Now()
+}
+
+func (r *Request) UseWorkflow(workflow *Workflow) error {
+	if r.Status != RequestPending || r.WorkflowStatus != "" {
+		return errors.New("a workflow can only be assigned to a new request")
+	}
+
+	if workflow == nil {
+		return nil
+	}
+
+	r.Workflow = workflow
+	r.WorkflowStatus = workflow.Initial().Key
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Request) HasWorkflow() bool {
+	return r.Workflow != nil
+}
+
+func (r *Request) CurrentWorkflowStatus() (WorkflowStatus, bool) {
+	if r.Workflow == nil {
+		return WorkflowStatus{}, false
+	}
+	return r.Workflow.Status(r.WorkflowStatus)
+}
+
+func (r *Request) AvailableTransitions() []WorkflowStatus {
+	if r.Workflow == nil || (r.Status != RequestPending && r.Status != RequestAccepted) {
+		return nil
+	}
+	return r.Workflow.NextStatuses(r.WorkflowStatus)
+}
+
+func (r *Request) Transition(to string, userId string) error {
+	if r.Workflow == nil {
+		return errors.New("request does not follow a custom workflow")
+	}
+
+	if r.Status != RequestPending && r.Status != RequestAccepted {
+		return errors.New("request can only change workflow status when in pending or accepted status")
+	}
+
+	if !r.Workflow.CanTransition(r.WorkflowStatus, to) {
+		return fmt.Errorf("%w: %s to %s", ErrTransitionNotAllowed, r.WorkflowStatus, to)
+	}
+
+	target, _ := r.Workflow.Status(to)
+
+	switch target.Category {
+	case StatusCategoryOpen:
+		r.Status = RequestPending
+		r.AcceptedByID = ""
+		r.AcceptedAt = time.Time{}
+		r.CollaboratorIDs = nil
+
+	case StatusCategoryInProgress:
+		if r.Status == RequestPending {
+			if err := r.Accept(userId); err != nil {
+				return err
+			}
+		}
+
+	case StatusCategoryDone:
+		if open := r.OpenRequiredItems(); len(open) > 0 {
+			return fmt.Errorf("request has %d required checklist item(s) still open", len(open))
+		}
+		if r.Status == RequestPending {
+			if err := r.Accept(userId); err != nil {
+				return err
+			}
+		}
+		if err := r.Complete(); err != nil {
+			return err
+		}
+	}
+
+	r.WorkflowStatus = to
+	r.UpdatedAt = time.Now()
+	return nil
+}
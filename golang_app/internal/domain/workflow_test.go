@@ -0,0 +1,170 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"request/internal/domain"
+)
+
+func newSecurityWorkflow(t *testing.T) *domain.Workflow {
+	t.Helper()
+
+	workflow, err := domain.NewWorkflow([]domain.WorkflowStatus{
+		domain.NewWorkflowStatus("Triage", domain.StatusCategoryOpen, []string{"Investigating", "Won't fix"}),
+		domain.NewWorkflowStatus("Investigating", domain.StatusCategoryInProgress, []string{"Fix deployed", "Triage"}),
+		domain.NewWorkflowStatus("Fix deployed", domain.StatusCategoryInProgress, []string{"Verified", "Investigating"}),
+		domain.NewWorkflowStatus("Verified", domain.StatusCategoryDone, nil),
+		domain.NewWorkflowStatus("Won't fix", domain.StatusCategoryDone, nil),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create workflow: %v", err)
+	}
+
+	return workflow
+}
+
+func TestWorkflow(t *testing.T) {
+	t.Run("NewWorkflow", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			statuses []domain.WorkflowStatus
+		}{
+			{"should require at least one status", nil},
+			{"should require the first status to be open", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Doing", domain.StatusCategoryInProgress, []string{"Done"}),
+				domain.NewWorkflowStatus("Done", domain.StatusCategoryDone, nil),
+			}},
+			{"should require a done status", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Todo", domain.StatusCategoryOpen, []string{"Doing"}),
+				domain.NewWorkflowStatus("Doing", domain.StatusCategoryInProgress, nil),
+			}},
+			{"should reject unknown categories", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Todo", domain.StatusCategoryOpen, []string{"Done"}),
+				domain.NewWorkflowStatus("Done", domain.StatusCategory("finished"), nil),
+			}},
+			{"should reject duplicate statuses", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Todo", domain.StatusCategoryOpen, []string{"Done"}),
+				domain.NewWorkflowStatus("todo", domain.StatusCategoryOpen, nil),
+				domain.NewWorkflowStatus("Done", domain.StatusCategoryDone, nil),
+			}},
+			{"should reject transitions to unknown statuses", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Todo", domain.StatusCategoryOpen, []string{"Shipped"}),
+				domain.NewWorkflowStatus("Done", domain.StatusCategoryDone, nil),
+			}},
+			{"should reject transitions out of a done status", []domain.WorkflowStatus{
+				domain.NewWorkflowStatus("Todo", domain.StatusCategoryOpen, []string{"Done"}),
+				domain.NewWorkflowStatus("Done", domain.StatusCategoryDone, []string{"Todo"}),
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := domain.NewWorkflow(tt.statuses); err == nil {
+					t.Error("Expected an error for an invalid workflow")
+				}
+			})
+		}
+
+		t.Run("should key statuses and transitions by name", func(t *testing.T) {
+			workflow := newSecurityWorkflow(t)
+
+			if workflow.Initial().Key != "triage" {
+				t.Errorf("Expected triage as the initial status, got %s", workflow.Initial().Key)
+			}
+
+			if !workflow.CanTransition("triage", "won_t_fix") || workflow.CanTransition("triage", "verified") {
+				t.Error("Unexpected transitions from triage")
+			}
+		})
+	})
+
+	t.Run("Transition", func(t *testing.T) {
+		t.Run("should start a new request in the initial status", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			r.UseWorkflow(newSecurityWorkflow(t))
+
+			if r.WorkflowStatus != "triage" || len(r.AvailableTransitions()) != 2 {
+				t.Errorf("Unexpected workflow state: %s %v", r.WorkflowStatus, r.AvailableTransitions())
+			}
+		})
+
+		t.Run("should accept the request when moving into progress", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			r.UseWorkflow(newSecurityWorkflow(t))
+
+			if err := r.Transition("investigating", "responder"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.Status != domain.RequestAccepted || r.AcceptedByID != "responder" || r.WorkflowStatus != "investigating" {
+				t.Errorf("Unexpected state: %s %s %s", r.Status, r.AcceptedByID, r.WorkflowStatus)
+			}
+		})
+
+		t.Run("should complete the request when reaching a done status", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			r.UseWorkflow(newSecurityWorkflow(t))
+			r.Transition("investigating", "responder")
+			r.Transition("fix_deployed", "responder")
+
+			if err := r.Transition("verified", "responder"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.Status != domain.RequestCompleted || r.AvailableTransitions() != nil {
+				t.Errorf("Expected a completed request without transitions, got %s", r.Status)
+			}
+		})
+
+		t.Run("should release the assignee when moving back to an open status", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			r.UseWorkflow(newSecurityWorkflow(t))
+			r.Transition("investigating", "responder")
+
+			if err := r.Transition("triage", "responder"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if r.Status != domain.RequestPending || r.AcceptedByID != "" {
+				t.Errorf("Expected a pending unassigned request, got %s %s", r.Status, r.AcceptedByID)
+			}
+		})
+
+		t.Run("should reject transitions the workflow does not allow", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			r.UseWorkflow(newSecurityWorkflow(t))
+
+			if err := r.Transition("verified", "responder"); !errors.Is(err, domain.ErrTransitionNotAllowed) {
+				t.Errorf("Expected ErrTransitionNotAllowed, got %v", err)
+			}
+
+			if r.WorkflowStatus != "triage" || r.Status != domain.RequestPending {
+				t.Errorf("Expected the request to be unchanged, got %s %s", r.WorkflowStatus, r.Status)
+			}
+		})
+
+		t.Run("should not finish while required checklist items are open", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+			item, _ := domain.NewChecklistItem("1", "Write regression test", true)
+			r.SetChecklist([]domain.ChecklistItem{item})
+			r.UseWorkflow(newSecurityWorkflow(t))
+
+			if err := r.Transition("won_t_fix", "responder"); err == nil {
+				t.Error("Expected an error while a required item is open")
+			}
+
+			if r.Status != domain.RequestPending || r.AcceptedByID != "" {
+				t.Errorf("Expected the request to be unchanged, got %s %s", r.Status, r.AcceptedByID)
+			}
+		})
+
+		t.Run("should fail for requests without a workflow", func(t *testing.T) {
+			r := newPendingRequest(t, "a", "XSS in login")
+
+			if err := r.Transition("investigating", "responder"); err == nil {
+				t.Error("Expected an error without a workflow")
+			}
+		})
+	})
+}
@@ -0,0 +1,6 @@
+package domain
+
+type WorkspaceSettings struct {
+	OpenChannelRequests bool
+	RequestExpiry       *ExpiryPolicy
+}